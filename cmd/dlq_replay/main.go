@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os/signal"
+	"syscall"
+
+	kafkaConsumerCommon "delivery/internal/adapters/in/kafka/common"
+	"delivery/internal/config"
+	"delivery/internal/config/env"
+
+	"github.com/labstack/gommon/log"
+)
+
+// Возвращает сообщения из dead-letter топика в исходный топик, например:
+//
+//	go run ./cmd/dlq_replay -topic basket.confirmed
+var (
+	configPath string
+	topic      string
+)
+
+func init() {
+	flag.StringVar(&configPath, "config", "../../deploy/env/.env.local", "path to config file")
+	flag.StringVar(&topic, "topic", "", "source topic, messages are read from its dead-letter topic")
+	flag.Parse()
+}
+
+func main() {
+	if topic == "" {
+		log.Fatalf("topic is required")
+	}
+
+	if err := config.Load(configPath); err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	kafkaConfig, err := env.NewKafkaCfgSearcher().Get()
+	if err != nil {
+		log.Fatalf("failed to get kafka config: %v", err)
+	}
+
+	replayer, err := kafkaConsumerCommon.NewDeadLetterReplayer(
+		[]string{kafkaConfig.Host},
+		kafkaConfig.ConsumerGroup+".dlq-replay",
+		kafkaConfig.DeadLetterTopicSuffix,
+	)
+	if err != nil {
+		log.Fatalf("failed to create dead-letter replayer: %v", err)
+	}
+	defer replayer.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	replayed, err := replayer.Replay(ctx, topic)
+	log.Infof("replayed %d messages from %s", replayed, kafkaConsumerCommon.DeadLetterTopic(topic, kafkaConfig.DeadLetterTopicSuffix))
+	if err != nil {
+		log.Fatalf("failed to replay messages: %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists outbox (
+    id uuid primary key,
+    name text not null,
+    payload jsonb not null,
+    occurred_at_utc timestamp not null,
+    processed_at_utc timestamp
+);
+
+create index if not exists idx_outbox_not_processed on outbox(occurred_at_utc) where processed_at_utc is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists outbox;
+-- +goose StatementEnd
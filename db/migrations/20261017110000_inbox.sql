@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists inbox (
+    event_id text primary key,
+    event_type text not null,
+    processed_at_utc timestamp not null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists inbox;
+-- +goose StatementEnd
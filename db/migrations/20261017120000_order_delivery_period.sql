@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table "order"
+    add column if not exists delivery_period_from timestamp,
+    add column if not exists delivery_period_to timestamp,
+    add column if not exists delivery_at_risk boolean not null default false;
+
+create index if not exists idx_order_delivery_period_to on "order"(delivery_period_to)
+    where delivery_period_to is not null and not delivery_at_risk;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_order_delivery_period_to;
+
+alter table "order"
+    drop column if exists delivery_at_risk,
+    drop column if exists delivery_period_to,
+    drop column if exists delivery_period_from;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists dispatch_decision (
+    id uuid primary key,
+    order_id uuid not null,
+    strategy text not null,
+    courier_id uuid,
+    candidates jsonb not null,
+    decided_at_utc timestamp not null
+);
+
+create index if not exists idx_dispatch_decision_order_id on dispatch_decision(order_id, decided_at_utc);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists dispatch_decision;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Существующие курьеры остаются доступными для назначения
+alter table courier
+    add column if not exists work_status text not null default 'Online';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table courier
+    drop column if exists work_status;
+-- +goose StatementEnd
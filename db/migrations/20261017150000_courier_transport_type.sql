@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Существующие курьеры создавались с одной сумкой, что соответствует шаблону Foot
+alter table courier
+    add column if not exists transport_type text not null default 'Foot';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table courier
+    drop column if exists transport_type;
+-- +goose StatementEnd
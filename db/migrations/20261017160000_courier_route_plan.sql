@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Маршрут курьеров с уже взятыми заказами строится заново при следующем перемещении
+alter table courier
+    add column if not exists route_plan jsonb not null default '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table courier
+    drop column if exists route_plan;
+-- +goose StatementEnd
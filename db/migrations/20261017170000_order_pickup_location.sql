@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- У существующих заказов точки забора нет: курьер везет их сразу клиенту
+alter table "order"
+    add column if not exists pickup_location point null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table "order"
+    drop column if exists pickup_location;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists order_status_history (
+    id bigserial primary key,
+    order_id uuid not null,
+    from_status text not null,
+    to_status text not null,
+    courier_id uuid,
+    command text not null,
+    changed_at_utc timestamp not null
+);
+
+create index if not exists idx_order_status_history_order_id on order_status_history(order_id, changed_at_utc);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists order_status_history;
+-- +goose StatementEnd
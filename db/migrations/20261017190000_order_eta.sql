@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists order_eta (
+    order_id uuid primary key,
+    courier_id uuid not null,
+    moves bigint not null,
+    duration_seconds bigint not null,
+    arrival_at_utc timestamp not null,
+    calculated_at_utc timestamp not null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists order_eta;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table outbox add column if not exists attempts int not null default 0;
+alter table outbox add column if not exists last_error text not null default '';
+alter table outbox add column if not exists next_attempt_at_utc timestamp;
+alter table outbox add column if not exists failed_at_utc timestamp;
+
+drop index if exists idx_outbox_not_processed;
+create index if not exists idx_outbox_not_processed on outbox(occurred_at_utc) where processed_at_utc is null and failed_at_utc is null;
+create index if not exists idx_outbox_processed on outbox(processed_at_utc) where processed_at_utc is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_outbox_processed;
+drop index if exists idx_outbox_not_processed;
+create index if not exists idx_outbox_not_processed on outbox(occurred_at_utc) where processed_at_utc is null;
+
+alter table outbox drop column if exists failed_at_utc;
+alter table outbox drop column if exists next_attempt_at_utc;
+alter table outbox drop column if exists last_error;
+alter table outbox drop column if exists attempts;
+-- +goose StatementEnd
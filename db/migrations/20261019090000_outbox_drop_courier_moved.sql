@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- CourierMoved больше не пишется в outbox и не регистрируется в EventRegistry, старые сообщения не раскодируются
+delete from outbox where name = 'CourierMoved';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Удаленные сообщения не восстанавливаются
+select 1;
+-- +goose StatementEnd
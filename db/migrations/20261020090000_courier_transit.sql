@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Дорога, которую курьер не успел проехать за одно перемещение: узел, к которому он едет, и сколько осталось
+alter table courier
+    add column if not exists transit_to point,
+    add column if not exists transit_left bigint not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table courier
+    drop column if exists transit_to,
+    drop column if exists transit_left;
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"delivery/internal/pkg/errs"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ErrorHandlingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, handleError(info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+func handleError(method string, err error) error {
+	// Ошибка уже содержит gRPC статус
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	// Validation errors -> InvalidArgument
+	if errors.Is(err, errs.ErrValueIsInvalid) ||
+		errors.Is(err, errs.ErrValueIsRequired) ||
+		errors.Is(err, errs.ErrCommandIsInvalid) ||
+		errors.Is(err, errs.ErrQueryIsInvalid) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Missing objects -> NotFound
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	// Business logic conflicts -> Aborted
+	if errors.Is(err, errs.ErrVersionIsInvalid) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	// Duplicate objects -> AlreadyExists
+	if errors.Is(err, errs.ErrObjectAlreadyExists) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	// Internal server errors -> Internal
+	log.Printf("grpc %s: %v", method, err)
+	return status.Error(codes.Internal, "Internal server error")
+}
@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func callWithError(err error) error {
+	info := &grpc.UnaryServerInfo{FullMethod: "/delivery.Delivery/GetOrder"}
+	handler := func(context.Context, any) (any, error) {
+		return nil, err
+	}
+
+	_, result := ErrorHandlingInterceptor()(context.Background(), nil, info, handler)
+	return result
+}
+
+func TestErrorHandlingInterceptor_MapsDomainErrorsToStatusCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "invalid value", err: errs.NewValueIsInvalidError("id"), code: codes.InvalidArgument},
+		{name: "required value", err: errs.NewValueIsRequiredError("address.street"), code: codes.InvalidArgument},
+		{name: "invalid command", err: errs.NewCommandIsInvalidError("CreateOrderCommand"), code: codes.InvalidArgument},
+		{name: "invalid query", err: errs.NewQueryIsInvalidError("GetOrderQuery"), code: codes.InvalidArgument},
+		{name: "not found", err: errs.NewObjectNotFoundError("order", "id"), code: codes.NotFound},
+		{name: "version conflict", err: errs.NewVersionIsInvalidError("version", errors.New("stale")), code: codes.Aborted},
+		{name: "already exists", err: errs.NewObjectAlreadyExistsError("order", "id"), code: codes.AlreadyExists},
+		{name: "unknown", err: errors.New("db is down"), code: codes.Internal},
+		{name: "status passthrough", err: status.Error(codes.Unavailable, "geo"), code: codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := callWithError(tt.err)
+
+			// Assert
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestErrorHandlingInterceptor_HidesInternalErrorDetails(t *testing.T) {
+	// Act
+	err := callWithError(errors.New("password=secret"))
+
+	// Assert
+	assert.Equal(t, "Internal server error", status.Convert(err).Message())
+}
+
+func TestErrorHandlingInterceptor_PassesSuccessfulResponse(t *testing.T) {
+	// Arrange
+	info := &grpc.UnaryServerInfo{FullMethod: "/delivery.Delivery/GetOrders"}
+	handler := func(context.Context, any) (any, error) {
+		return "ok", nil
+	}
+
+	// Act
+	resp, err := ErrorHandlingInterceptor()(context.Background(), nil, info, handler)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
@@ -0,0 +1,233 @@
+package v1
+
+import (
+	"context"
+
+	"delivery/internal/core/application/usecases/commands/add_storage_place"
+	"delivery/internal/core/application/usecases/commands/create_courier"
+	"delivery/internal/core/application/usecases/commands/create_order"
+	"delivery/internal/core/application/usecases/queries/get_all_couriers"
+	"delivery/internal/core/application/usecases/queries/get_all_uncompleted_orders"
+	"delivery/internal/core/application/usecases/queries/get_order"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/servers/deliverysrv/deliverypb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ deliverypb.DeliveryServer = (*DeliveryService)(nil)
+
+type DeliveryService struct {
+	deliverypb.UnimplementedDeliveryServer
+
+	getAllCouriersHandler          get_all_couriers.GetAllCouriersHandler
+	createCourierHandler           create_courier.CreateCourierHandler
+	addStoragePlaceHandler         add_storage_place.AddStoragePlaceHandler
+	getAllUncompletedOrdersHandler get_all_uncompleted_orders.GetAllUncompletedOrdersHandler
+	createOrderHandler             create_order.CreateOrderHandler
+	getOrderHandler                get_order.GetOrderHandler
+}
+
+func NewDeliveryService(
+	getAllCouriersHandler get_all_couriers.GetAllCouriersHandler,
+	createCourierHandler create_courier.CreateCourierHandler,
+	addStoragePlaceHandler add_storage_place.AddStoragePlaceHandler,
+	getAllUncompletedOrdersHandler get_all_uncompleted_orders.GetAllUncompletedOrdersHandler,
+	createOrderHandler create_order.CreateOrderHandler,
+	getOrderHandler get_order.GetOrderHandler,
+) *DeliveryService {
+	return &DeliveryService{
+		getAllCouriersHandler:          getAllCouriersHandler,
+		createCourierHandler:           createCourierHandler,
+		addStoragePlaceHandler:         addStoragePlaceHandler,
+		getAllUncompletedOrdersHandler: getAllUncompletedOrdersHandler,
+		createOrderHandler:             createOrderHandler,
+		getOrderHandler:                getOrderHandler,
+	}
+}
+
+func (d *DeliveryService) CreateCourier(ctx context.Context, req *deliverypb.CreateCourierRequest) (*deliverypb.CreateCourierReply, error) {
+	command, err := create_courier.NewCreateCourierCommand(req.GetName(), req.GetTransportType(), req.GetSpeed())
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.createCourierHandler.Handle(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliverypb.CreateCourierReply{}, nil
+}
+
+func (d *DeliveryService) GetCouriers(ctx context.Context, _ *deliverypb.GetCouriersRequest) (*deliverypb.GetCouriersReply, error) {
+	query := get_all_couriers.NewGetAllCouriersQuery()
+
+	response, err := d.getAllCouriersHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	couriers := make([]*deliverypb.Courier, len(response.Couriers))
+	for i, courierDTO := range response.Couriers {
+		couriers[i] = &deliverypb.Courier{
+			Id:            courierDTO.ID.String(),
+			Name:          courierDTO.Name,
+			Location:      &deliverypb.Location{X: courierDTO.Location.X, Y: courierDTO.Location.Y},
+			TransportType: courierDTO.TransportType,
+			WorkStatus:    courierDTO.WorkStatus,
+		}
+	}
+
+	return &deliverypb.GetCouriersReply{Couriers: couriers}, nil
+}
+
+func (d *DeliveryService) AddStoragePlace(ctx context.Context, req *deliverypb.AddStoragePlaceRequest) (*deliverypb.AddStoragePlaceReply, error) {
+	courierID, err := parseID("courierId", req.GetCourierId())
+	if err != nil {
+		return nil, err
+	}
+
+	command, err := add_storage_place.NewAddStoragePlaceCommand(courierID, req.GetName(), req.GetTotalVolume())
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.addStoragePlaceHandler.Handle(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliverypb.AddStoragePlaceReply{}, nil
+}
+
+func (d *DeliveryService) CreateOrder(ctx context.Context, req *deliverypb.CreateOrderRequest) (*deliverypb.CreateOrderReply, error) {
+	orderID := uuid.New()
+	if req.GetId() != "" {
+		var err error
+		orderID, err = parseID("id", req.GetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	address, err := order.NewAddress(
+		req.GetAddress().GetCountry(),
+		req.GetAddress().GetCity(),
+		req.GetAddress().GetStreet(),
+		req.GetAddress().GetHouse(),
+		req.GetAddress().GetApartment(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	command, err := create_order.NewCreateOrderCommand(orderID, address, req.GetVolume(), order.DeliveryPeriod{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.createOrderHandler.Handle(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	// Команда ничего не возвращает, координаты, определенные по адресу, читаем запросом
+	query, err := get_order.NewGetOrderQuery(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.getOrderHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliverypb.CreateOrderReply{
+		Id:       orderID.String(),
+		Location: &deliverypb.Location{X: response.Order.Location.X, Y: response.Order.Location.Y},
+	}, nil
+}
+
+func (d *DeliveryService) GetOrders(ctx context.Context, _ *deliverypb.GetOrdersRequest) (*deliverypb.GetOrdersReply, error) {
+	query := get_all_uncompleted_orders.NewGetAllUncompletedOrdersQuery()
+
+	response, err := d.getAllUncompletedOrdersHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*deliverypb.Order, len(response.Orders))
+	for i, orderDTO := range response.Orders {
+		orders[i] = &deliverypb.Order{
+			Id:       orderDTO.ID.String(),
+			Location: &deliverypb.Location{X: orderDTO.Location.X, Y: orderDTO.Location.Y},
+		}
+
+		if orderDTO.EtaMoves != nil {
+			orders[i].Eta = &deliverypb.OrderEta{
+				Moves:     *orderDTO.EtaMoves,
+				Seconds:   *orderDTO.EtaSeconds,
+				ArrivalAt: timestamppb.New(*orderDTO.EtaArrivalAt),
+			}
+		}
+	}
+
+	return &deliverypb.GetOrdersReply{Orders: orders}, nil
+}
+
+func (d *DeliveryService) GetOrder(ctx context.Context, req *deliverypb.GetOrderRequest) (*deliverypb.GetOrderReply, error) {
+	orderID, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := get_order.NewGetOrderQuery(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.getOrderHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	orderDTO := response.Order
+	reply := &deliverypb.GetOrderReply{
+		Id:       orderDTO.ID.String(),
+		Status:   orderDTO.Status,
+		Location: &deliverypb.Location{X: orderDTO.Location.X, Y: orderDTO.Location.Y},
+	}
+
+	if orderDTO.PickupLocation != nil {
+		reply.PickupLocation = &deliverypb.Location{X: orderDTO.PickupLocation.X, Y: orderDTO.PickupLocation.Y}
+	}
+
+	if orderDTO.CourierID != nil && orderDTO.CourierName != nil && orderDTO.CourierLocation != nil {
+		reply.Courier = &deliverypb.AssignedCourier{
+			Id:       orderDTO.CourierID.String(),
+			Name:     *orderDTO.CourierName,
+			Location: &deliverypb.Location{X: orderDTO.CourierLocation.X, Y: orderDTO.CourierLocation.Y},
+		}
+	}
+
+	if orderDTO.EtaMoves != nil {
+		reply.Eta = &deliverypb.OrderEta{
+			Moves:     *orderDTO.EtaMoves,
+			Seconds:   *orderDTO.EtaSeconds,
+			ArrivalAt: timestamppb.New(*orderDTO.EtaArrivalAt),
+		}
+	}
+
+	return reply, nil
+}
+
+func parseID(paramName string, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, errs.NewValueIsInvalidErrorWithCause(paramName, err)
+	}
+	return id, nil
+}
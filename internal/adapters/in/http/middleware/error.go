@@ -25,6 +25,7 @@ func ErrorHandlingMiddleware() echo.MiddlewareFunc {
 func handleError(ctx echo.Context, err error) error {
 	// Validation errors -> 400 Bad Request
 	if errors.Is(err, errs.ErrValueIsInvalid) ||
+		errors.Is(err, errs.ErrValueIsRequired) ||
 		errors.Is(err, errs.ErrCommandIsInvalid) ||
 		errors.Is(err, errs.ErrQueryIsInvalid) {
 		return ctx.JSON(http.StatusBadRequest, servers.Error{
@@ -33,8 +34,17 @@ func handleError(ctx echo.Context, err error) error {
 		})
 	}
 
+	// Missing objects -> 404 Not Found
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return ctx.JSON(http.StatusNotFound, servers.Error{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	}
+
 	// Business logic conflicts -> 409 Conflict
-	if errors.Is(err, errs.ErrVersionIsInvalid) {
+	if errors.Is(err, errs.ErrVersionIsInvalid) ||
+		errors.Is(err, errs.ErrObjectAlreadyExists) {
 		return ctx.JSON(http.StatusConflict, servers.Error{
 			Code:    http.StatusConflict,
 			Message: err.Error(),
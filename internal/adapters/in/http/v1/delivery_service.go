@@ -4,10 +4,19 @@ import (
 	"log"
 	"net/http"
 
+	"delivery/internal/core/application/usecases/commands/add_storage_place"
 	"delivery/internal/core/application/usecases/commands/create_courier"
 	"delivery/internal/core/application/usecases/commands/create_order"
+	"delivery/internal/core/application/usecases/commands/end_courier_shift"
+	"delivery/internal/core/application/usecases/commands/start_courier_shift"
+	"delivery/internal/core/application/usecases/commands/take_courier_break"
 	"delivery/internal/core/application/usecases/queries/get_all_couriers"
 	"delivery/internal/core/application/usecases/queries/get_all_uncompleted_orders"
+	"delivery/internal/core/application/usecases/queries/get_courier"
+	"delivery/internal/core/application/usecases/queries/get_order"
+	"delivery/internal/core/application/usecases/queries/get_order_dispatch_decisions"
+	"delivery/internal/core/application/usecases/queries/get_order_status_history"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/generated/servers"
 
 	"github.com/google/uuid"
@@ -15,23 +24,50 @@ import (
 )
 
 type DeliveryService struct {
-	getAllCouriersHandler          get_all_couriers.GetAllCouriersHandler
-	createCourierHandler           create_courier.CreateCourierHandler
-	getAllUncompletedOrdersHandler get_all_uncompleted_orders.GetAllUncompletedOrdersHandler
-	createOrderHandler             create_order.CreateOrderHandler
+	getAllCouriersHandler            get_all_couriers.GetAllCouriersHandler
+	getCourierHandler                get_courier.GetCourierHandler
+	createCourierHandler             create_courier.CreateCourierHandler
+	addStoragePlaceHandler           add_storage_place.AddStoragePlaceHandler
+	getAllUncompletedOrdersHandler   get_all_uncompleted_orders.GetAllUncompletedOrdersHandler
+	createOrderHandler               create_order.CreateOrderHandler
+	getOrderHandler                  get_order.GetOrderHandler
+	getOrderDispatchDecisionsHandler get_order_dispatch_decisions.GetOrderDispatchDecisionsHandler
+	getOrderStatusHistoryHandler     get_order_status_history.GetOrderStatusHistoryHandler
+	startCourierShiftHandler         start_courier_shift.StartCourierShiftHandler
+	endCourierShiftHandler           end_courier_shift.EndCourierShiftHandler
+	takeCourierBreakHandler          take_courier_break.TakeCourierBreakHandler
+	trackingSubscriber               TrackingSubscriber
 }
 
 func NewDeliveryService(
 	getAllCouriersHandler get_all_couriers.GetAllCouriersHandler,
+	getCourierHandler get_courier.GetCourierHandler,
 	createCourierHandler create_courier.CreateCourierHandler,
+	addStoragePlaceHandler add_storage_place.AddStoragePlaceHandler,
 	getAllUncompletedOrdersHandler get_all_uncompleted_orders.GetAllUncompletedOrdersHandler,
 	createOrderHandler create_order.CreateOrderHandler,
+	getOrderHandler get_order.GetOrderHandler,
+	getOrderDispatchDecisionsHandler get_order_dispatch_decisions.GetOrderDispatchDecisionsHandler,
+	getOrderStatusHistoryHandler get_order_status_history.GetOrderStatusHistoryHandler,
+	startCourierShiftHandler start_courier_shift.StartCourierShiftHandler,
+	endCourierShiftHandler end_courier_shift.EndCourierShiftHandler,
+	takeCourierBreakHandler take_courier_break.TakeCourierBreakHandler,
+	trackingSubscriber TrackingSubscriber,
 ) *DeliveryService {
 	return &DeliveryService{
-		getAllCouriersHandler:          getAllCouriersHandler,
-		createCourierHandler:           createCourierHandler,
-		getAllUncompletedOrdersHandler: getAllUncompletedOrdersHandler,
-		createOrderHandler:             createOrderHandler,
+		getAllCouriersHandler:            getAllCouriersHandler,
+		getCourierHandler:                getCourierHandler,
+		createCourierHandler:             createCourierHandler,
+		addStoragePlaceHandler:           addStoragePlaceHandler,
+		getAllUncompletedOrdersHandler:   getAllUncompletedOrdersHandler,
+		createOrderHandler:               createOrderHandler,
+		getOrderHandler:                  getOrderHandler,
+		getOrderDispatchDecisionsHandler: getOrderDispatchDecisionsHandler,
+		getOrderStatusHistoryHandler:     getOrderStatusHistoryHandler,
+		startCourierShiftHandler:         startCourierShiftHandler,
+		endCourierShiftHandler:           endCourierShiftHandler,
+		takeCourierBreakHandler:          takeCourierBreakHandler,
+		trackingSubscriber:               trackingSubscriber,
 	}
 }
 
@@ -44,7 +80,17 @@ func (d *DeliveryService) CreateCourier(ctx echo.Context) error {
 		})
 	}
 
-	command, err := create_courier.NewCreateCourierCommand(newCourier.Name, int64(newCourier.Speed))
+	var speed int64
+	if newCourier.Speed != nil {
+		speed = int64(*newCourier.Speed)
+	}
+
+	var transportType string
+	if newCourier.TransportType != nil {
+		transportType = string(*newCourier.TransportType)
+	}
+
+	command, err := create_courier.NewCreateCourierCommand(newCourier.Name, transportType, speed)
 	if err != nil {
 		return err
 	}
@@ -74,15 +120,135 @@ func (d *DeliveryService) GetCouriers(ctx echo.Context) error {
 				X: int(courierDTO.Location.X),
 				Y: int(courierDTO.Location.Y),
 			},
+			TransportType: servers.TransportType(courierDTO.TransportType),
+			WorkStatus:    servers.CourierWorkStatus(courierDTO.WorkStatus),
 		}
 	}
 
 	return ctx.JSON(http.StatusOK, couriers)
 }
 
+func (d *DeliveryService) GetCourier(ctx echo.Context, id uuid.UUID) error {
+	query, err := get_courier.NewGetCourierQuery(id)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.getCourierHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	courierDTO := response.Courier
+	storagePlaces := make([]servers.StoragePlace, len(courierDTO.StoragePlaces))
+	for i, storagePlaceDTO := range courierDTO.StoragePlaces {
+		storagePlaces[i] = servers.StoragePlace{
+			Id:             storagePlaceDTO.ID,
+			Name:           storagePlaceDTO.Name,
+			TotalVolume:    storagePlaceDTO.TotalVolume,
+			OccupiedVolume: storagePlaceDTO.OccupiedVolume,
+			OrderId:        storagePlaceDTO.OrderID,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, servers.CourierDetails{
+		Id:   courierDTO.ID,
+		Name: courierDTO.Name,
+		Location: servers.Location{
+			X: int(courierDTO.Location.X),
+			Y: int(courierDTO.Location.Y),
+		},
+		Speed:         courierDTO.Speed,
+		TransportType: servers.TransportType(courierDTO.TransportType),
+		WorkStatus:    servers.CourierDetailsWorkStatus(courierDTO.WorkStatus),
+		StoragePlaces: storagePlaces,
+	})
+}
+
+func (d *DeliveryService) AddStoragePlace(ctx echo.Context, id uuid.UUID) error {
+	var newStoragePlace servers.NewStoragePlace
+	if err := ctx.Bind(&newStoragePlace); err != nil {
+		return ctx.JSON(http.StatusBadRequest, servers.Error{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	command, err := add_storage_place.NewAddStoragePlaceCommand(id, newStoragePlace.Name, newStoragePlace.TotalVolume)
+	if err != nil {
+		return err
+	}
+
+	err = d.addStoragePlaceHandler.Handle(ctx.Request().Context(), command)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+func (d *DeliveryService) StartCourierShift(ctx echo.Context, id uuid.UUID) error {
+	command, err := start_courier_shift.NewStartCourierShiftCommand(id)
+	if err != nil {
+		return err
+	}
+
+	err = d.startCourierShiftHandler.Handle(ctx.Request().Context(), command)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (d *DeliveryService) EndCourierShift(ctx echo.Context, id uuid.UUID) error {
+	command, err := end_courier_shift.NewEndCourierShiftCommand(id)
+	if err != nil {
+		return err
+	}
+
+	err = d.endCourierShiftHandler.Handle(ctx.Request().Context(), command)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (d *DeliveryService) TakeCourierBreak(ctx echo.Context, id uuid.UUID) error {
+	command, err := take_courier_break.NewTakeCourierBreakCommand(id)
+	if err != nil {
+		return err
+	}
+
+	err = d.takeCourierBreakHandler.Handle(ctx.Request().Context(), command)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 func (d *DeliveryService) CreateOrder(ctx echo.Context) error {
+	var newOrder servers.NewOrder
+	if err := ctx.Bind(&newOrder); err != nil {
+		return ctx.JSON(http.StatusBadRequest, servers.Error{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	address, err := mapAddress(newOrder.Address)
+	if err != nil {
+		return err
+	}
+
 	orderID := uuid.New()
-	command, err := create_order.NewCreateOrderCommand(orderID, "default_street", 1)
+	if newOrder.Id != nil {
+		orderID = *newOrder.Id
+	}
+
+	command, err := create_order.NewCreateOrderCommand(orderID, address, newOrder.Volume, order.DeliveryPeriod{})
 	if err != nil {
 		return err
 	}
@@ -93,7 +259,33 @@ func (d *DeliveryService) CreateOrder(ctx echo.Context) error {
 		return err
 	}
 
-	return ctx.NoContent(http.StatusCreated)
+	// Команда ничего не возвращает, координаты, определенные по адресу, читаем запросом
+	query, err := get_order.NewGetOrderQuery(orderID)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.getOrderHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, servers.CreatedOrder{
+		Id: response.Order.ID,
+		Location: servers.Location{
+			X: int(response.Order.Location.X),
+			Y: int(response.Order.Location.Y),
+		},
+	})
+}
+
+func mapAddress(address servers.Address) (order.Address, error) {
+	apartment := ""
+	if address.Apartment != nil {
+		apartment = *address.Apartment
+	}
+
+	return order.NewAddress(address.Country, address.City, address.Street, address.House, apartment)
 }
 
 func (d *DeliveryService) GetOrders(ctx echo.Context) error {
@@ -113,7 +305,129 @@ func (d *DeliveryService) GetOrders(ctx echo.Context) error {
 				Y: int(orderDTO.Location.Y),
 			},
 		}
+
+		if orderDTO.EtaMoves != nil {
+			orders[i].Eta = &servers.OrderEta{
+				Moves:     *orderDTO.EtaMoves,
+				Seconds:   *orderDTO.EtaSeconds,
+				ArrivalAt: *orderDTO.EtaArrivalAt,
+			}
+		}
 	}
 
 	return ctx.JSON(http.StatusOK, orders)
 }
+
+func (d *DeliveryService) GetOrder(ctx echo.Context, id uuid.UUID) error {
+	query, err := get_order.NewGetOrderQuery(id)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.getOrderHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	orderDTO := response.Order
+	orderDetails := servers.OrderDetails{
+		Id:     orderDTO.ID,
+		Status: orderDTO.Status,
+		Location: servers.Location{
+			X: int(orderDTO.Location.X),
+			Y: int(orderDTO.Location.Y),
+		},
+	}
+
+	if orderDTO.PickupLocation != nil {
+		orderDetails.PickupLocation = &servers.Location{
+			X: int(orderDTO.PickupLocation.X),
+			Y: int(orderDTO.PickupLocation.Y),
+		}
+	}
+
+	if orderDTO.CourierID != nil && orderDTO.CourierName != nil && orderDTO.CourierLocation != nil {
+		orderDetails.Courier = &servers.AssignedCourier{
+			Id:   *orderDTO.CourierID,
+			Name: *orderDTO.CourierName,
+			Location: servers.Location{
+				X: int(orderDTO.CourierLocation.X),
+				Y: int(orderDTO.CourierLocation.Y),
+			},
+		}
+	}
+
+	if orderDTO.EtaMoves != nil {
+		orderDetails.Eta = &servers.OrderEta{
+			Moves:     *orderDTO.EtaMoves,
+			Seconds:   *orderDTO.EtaSeconds,
+			ArrivalAt: *orderDTO.EtaArrivalAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, orderDetails)
+}
+
+func (d *DeliveryService) GetOrderDispatchDecisions(ctx echo.Context, id uuid.UUID) error {
+	query, err := get_order_dispatch_decisions.NewGetOrderDispatchDecisionsQuery(id)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.getOrderDispatchDecisionsHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	decisions := make([]servers.DispatchDecision, len(response.Decisions))
+	for i, decisionDTO := range response.Decisions {
+		candidates := make([]servers.DispatchCandidate, len(decisionDTO.Candidates))
+		for j, candidateDTO := range decisionDTO.Candidates {
+			candidates[j] = servers.DispatchCandidate{
+				CourierId:      candidateDTO.CourierID,
+				TimeToLocation: candidateDTO.TimeToLocation,
+			}
+
+			if candidateDTO.RejectionReason != "" {
+				rejectionReason := candidateDTO.RejectionReason
+				candidates[j].RejectionReason = &rejectionReason
+			}
+		}
+
+		decisions[i] = servers.DispatchDecision{
+			Id:         decisionDTO.ID,
+			OrderId:    decisionDTO.OrderID,
+			Strategy:   decisionDTO.Strategy,
+			CourierId:  decisionDTO.CourierID,
+			DecidedAt:  decisionDTO.DecidedAt,
+			Candidates: candidates,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, decisions)
+}
+
+func (d *DeliveryService) GetOrderStatusHistory(ctx echo.Context, id uuid.UUID) error {
+	query, err := get_order_status_history.NewGetOrderStatusHistoryQuery(id)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.getOrderStatusHistoryHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	changes := make([]servers.OrderStatusChange, len(response.Changes))
+	for i, changeDTO := range response.Changes {
+		changes[i] = servers.OrderStatusChange{
+			FromStatus: changeDTO.FromStatus,
+			ToStatus:   changeDTO.ToStatus,
+			CourierId:  changeDTO.CourierID,
+			Command:    changeDTO.Command,
+			ChangedAt:  changeDTO.ChangedAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, changes)
+}
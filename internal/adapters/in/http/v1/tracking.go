@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"delivery/internal/adapters/out/tracking"
+	"delivery/internal/core/application/usecases/queries/get_order"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Комментарий-пинг не дает прокси закрыть соединение, пока изменений нет
+const trackingKeepAliveInterval = 15 * time.Second
+
+type TrackingSubscriber interface {
+	Subscribe(filter tracking.Filter) (<-chan tracking.Update, func())
+}
+
+func (d *DeliveryService) StreamTracking(ctx echo.Context, params servers.StreamTrackingParams) error {
+	if params.OrderId != nil && params.CourierId != nil {
+		return errs.NewValueIsInvalidErrorWithCause("orderId", errors.New("нельзя указать одновременно orderId и courierId"))
+	}
+
+	filter := tracking.Filter{CourierID: params.CourierId}
+	if params.OrderId != nil {
+		query, err := get_order.NewGetOrderQuery(*params.OrderId)
+		if err != nil {
+			return err
+		}
+
+		response, err := d.getOrderHandler.Handle(ctx.Request().Context(), query)
+		if err != nil {
+			return err
+		}
+
+		filter.OrderID = params.OrderId
+		status := order.Status(response.Order.Status)
+		if status == order.StatusAssigned || status == order.StatusPickedUp {
+			filter.CourierID = response.Order.CourierID
+		}
+	}
+
+	updates, unsubscribe := d.trackingSubscriber.Subscribe(filter)
+	defer unsubscribe()
+
+	// У HTTP сервера общий WriteTimeout, поток должен жить, пока клиент подключен
+	responseController := http.NewResponseController(ctx.Response().Writer)
+	if err := responseController.SetWriteDeadline(time.Time{}); err != nil {
+		return err
+	}
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	header.Set(echo.HeaderConnection, "keep-alive")
+	ctx.Response().WriteHeader(http.StatusOK)
+	ctx.Response().Flush()
+
+	keepAlive := time.NewTicker(trackingKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(ctx.Response(), ": ping\n\n"); err != nil {
+				return nil
+			}
+			ctx.Response().Flush()
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(toTrackingUpdate(update))
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(ctx.Response(), "event: %s\ndata: %s\n\n", update.Type, data); err != nil {
+				return nil
+			}
+			ctx.Response().Flush()
+		}
+	}
+}
+
+func toTrackingUpdate(update tracking.Update) servers.TrackingUpdate {
+	trackingUpdate := servers.TrackingUpdate{
+		Type:       servers.TrackingUpdateType(update.Type),
+		CourierId:  update.CourierID,
+		OrderId:    update.OrderID,
+		OccurredAt: update.OccurredAt,
+	}
+
+	switch update.Type {
+	case tracking.UpdateTypeCourierLocation:
+		trackingUpdate.Location = &servers.Location{
+			X: int(update.X),
+			Y: int(update.Y),
+		}
+	case tracking.UpdateTypeOrderStatus:
+		fromStatus, toStatus := update.FromStatus, update.ToStatus
+		trackingUpdate.FromStatus = &fromStatus
+		trackingUpdate.ToStatus = &toStatus
+	}
+
+	return trackingUpdate
+}
@@ -0,0 +1,50 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"delivery/internal/adapters/in/kafka/common"
+	"delivery/internal/core/application/usecases/commands/cancel_order"
+	"delivery/internal/generated/queues/basketpb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type BasketCancelledEventHandler struct {
+	cancelOrderHandler cancel_order.CancelOrderHandler
+}
+
+func (h *BasketCancelledEventHandler) Handle(ctx context.Context, event *basketpb.BasketCancelledIntegrationEvent) error {
+	// Идентификатор заказа совпадает с идентификатором корзины
+	orderID, err := uuid.Parse(event.GetBasketId())
+	if err != nil {
+		return fmt.Errorf("%w: invalid basket_id: %v", common.ErrIncorrectMessage, err)
+	}
+
+	cmd, err := cancel_order.NewCancelOrderCommand(orderID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", common.ErrIncorrectMessage, err)
+	}
+
+	err = h.cancelOrderHandler.Handle(ctx, cmd)
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		// Корзина отменена до оформления заказа - отменять нечего, повтор ничего не изменит
+		log.Printf("BasketCancelledEventHandler: order %s not found, event %s is acknowledged", orderID, event.GetEventId())
+		return nil
+	}
+	if errors.Is(err, errs.ErrValueIsInvalid) {
+		// Забранный или доставленный заказ уже не отменить, повтор ничего не изменит
+		log.Printf("BasketCancelledEventHandler: order %s cannot be cancelled, event %s is acknowledged: %v", orderID, event.GetEventId(), err)
+		return nil
+	}
+
+	return err
+}
+
+func NewBasketCancelledEventHandler(cancelOrderHandler cancel_order.CancelOrderHandler) *BasketCancelledEventHandler {
+	return &BasketCancelledEventHandler{cancelOrderHandler: cancelOrderHandler}
+}
@@ -0,0 +1,113 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/adapters/in/kafka/common"
+	"delivery/internal/core/application/usecases/commands/cancel_order"
+	"delivery/internal/generated/queues/basketpb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCancelOrderHandler struct {
+	commands []cancel_order.CancelOrderCommand
+	err      error
+}
+
+func (f *fakeCancelOrderHandler) Handle(_ context.Context, command cancel_order.CancelOrderCommand) error {
+	f.commands = append(f.commands, command)
+	return f.err
+}
+
+func TestBasketCancelledEventHandler_Handle_CancelsOrderWithBasketID(t *testing.T) {
+	// Arrange
+	cancelOrderHandler := &fakeCancelOrderHandler{}
+	handler := NewBasketCancelledEventHandler(cancelOrderHandler)
+	event := &basketpb.BasketCancelledIntegrationEvent{
+		EventId:  uuid.NewString(),
+		BasketId: uuid.NewString(),
+		Reason:   "Покупатель передумал",
+	}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, cancelOrderHandler.commands, 1)
+	assert.Equal(t, event.GetBasketId(), cancelOrderHandler.commands[0].OrderID().String())
+}
+
+func TestBasketCancelledEventHandler_Handle_InvalidBasketID(t *testing.T) {
+	// Arrange
+	cancelOrderHandler := &fakeCancelOrderHandler{}
+	handler := NewBasketCancelledEventHandler(cancelOrderHandler)
+	event := &basketpb.BasketCancelledIntegrationEvent{
+		EventId:  uuid.NewString(),
+		BasketId: "not-a-uuid",
+	}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.ErrorIs(t, err, common.ErrIncorrectMessage)
+	assert.Empty(t, cancelOrderHandler.commands)
+}
+
+func TestBasketCancelledEventHandler_Handle_AcknowledgesUnknownOrder(t *testing.T) {
+	// Arrange
+	basketID := uuid.New()
+	cancelOrderHandler := &fakeCancelOrderHandler{err: errs.NewObjectNotFoundError("order", basketID)}
+	handler := NewBasketCancelledEventHandler(cancelOrderHandler)
+	event := &basketpb.BasketCancelledIntegrationEvent{
+		EventId:  uuid.NewString(),
+		BasketId: basketID.String(),
+	}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, cancelOrderHandler.commands, 1)
+}
+
+func TestBasketCancelledEventHandler_Handle_AcknowledgesOrderThatCannotBeCancelled(t *testing.T) {
+	// Arrange
+	cancelOrderHandler := &fakeCancelOrderHandler{err: errs.NewValueIsInvalidErrorWithCause("status", errors.New("order is completed"))}
+	handler := NewBasketCancelledEventHandler(cancelOrderHandler)
+	event := &basketpb.BasketCancelledIntegrationEvent{
+		EventId:  uuid.NewString(),
+		BasketId: uuid.NewString(),
+	}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, cancelOrderHandler.commands, 1)
+}
+
+func TestBasketCancelledEventHandler_Handle_ReturnsOtherErrors(t *testing.T) {
+	// Arrange
+	cancelOrderHandler := &fakeCancelOrderHandler{err: errors.New("database is unavailable")}
+	handler := NewBasketCancelledEventHandler(cancelOrderHandler)
+	event := &basketpb.BasketCancelledIntegrationEvent{
+		EventId:  uuid.NewString(),
+		BasketId: uuid.NewString(),
+	}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, common.ErrIncorrectMessage)
+}
@@ -2,13 +2,18 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 
 	"delivery/internal/adapters/in/kafka/common"
 	"delivery/internal/core/application/usecases/commands/create_order"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/generated/queues/basketpb"
+	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type BasketConfirmedEventHandler struct {
@@ -16,18 +21,61 @@ type BasketConfirmedEventHandler struct {
 }
 
 func (h *BasketConfirmedEventHandler) Handle(ctx context.Context, event *basketpb.BasketConfirmedIntegrationEvent) error {
-	orderID := uuid.New()
+	// Идентификатор заказа совпадает с идентификатором корзины,
+	// поэтому повторная доставка сообщения не создаст второй заказ
+	orderID, err := uuid.Parse(event.GetBasketId())
+	if err != nil {
+		return fmt.Errorf("%w: invalid basket_id: %v", common.ErrIncorrectMessage, err)
+	}
+
+	deliveryPeriod, err := h.mapDeliveryPeriod(event.GetOccurredAt(), event.GetDeliveryPeriod())
+	if err != nil {
+		return fmt.Errorf("%w: invalid delivery_period: %v", common.ErrIncorrectMessage, err)
+	}
+
+	address, err := order.NewAddress(
+		event.GetAddress().GetCountry(),
+		event.GetAddress().GetCity(),
+		event.GetAddress().GetStreet(),
+		event.GetAddress().GetHouse(),
+		event.GetAddress().GetApartment(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: invalid address: %v", common.ErrIncorrectMessage, err)
+	}
 
 	cmd, err := create_order.NewCreateOrderCommand(
 		orderID,
-		event.Address.Street,
-		int64(event.Volume),
+		address,
+		int64(event.GetVolume()),
+		deliveryPeriod,
 	)
 	if err != nil {
 		return fmt.Errorf("%w: %v", common.ErrIncorrectMessage, err)
 	}
 
-	return h.createOrderHandler.Handle(ctx, cmd)
+	err = h.createOrderHandler.Handle(ctx, cmd)
+	if errors.Is(err, errs.ErrObjectAlreadyExists) {
+		// Заказ по этой корзине уже создан - повтор сообщения ничего не меняет
+		log.Printf("BasketConfirmedEventHandler: order %s already exists, event %s is acknowledged", orderID, event.GetEventId())
+		return nil
+	}
+
+	return err
+}
+
+// mapDeliveryPeriod - корзина передает окно доставки в часах суток, пустое окно означает доставку без ограничений.
+// Окно привязывается к моменту подтверждения корзины, чтобы повторная доставка сообщения дала то же окно.
+func (h *BasketConfirmedEventHandler) mapDeliveryPeriod(occurredAt *timestamppb.Timestamp, deliveryPeriod *basketpb.DeliveryPeriod) (order.DeliveryPeriod, error) {
+	if deliveryPeriod.GetFrom() == 0 && deliveryPeriod.GetTo() == 0 {
+		return order.DeliveryPeriod{}, nil
+	}
+
+	if occurredAt == nil {
+		return order.DeliveryPeriod{}, errs.NewValueIsRequiredError("occurred_at")
+	}
+
+	return order.NewDeliveryPeriodForHours(occurredAt.AsTime(), int64(deliveryPeriod.GetFrom()), int64(deliveryPeriod.GetTo()))
 }
 
 func NewBasketConfirmedEventHandler(createOrderHandler create_order.CreateOrderHandler) *BasketConfirmedEventHandler {
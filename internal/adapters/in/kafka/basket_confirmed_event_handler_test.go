@@ -0,0 +1,298 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/in/kafka/common"
+	"delivery/internal/core/application/usecases/commands/create_order"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/generated/queues/basketpb"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/inbox"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeCreateOrderHandler struct {
+	commands []create_order.CreateOrderCommand
+	err      error
+}
+
+func (f *fakeCreateOrderHandler) Handle(_ context.Context, command create_order.CreateOrderCommand) error {
+	f.commands = append(f.commands, command)
+	return f.err
+}
+
+type fakeInboxRepo struct {
+	messages map[string]*inbox.Message
+}
+
+func (f *fakeInboxRepo) Add(_ context.Context, message *inbox.Message) error {
+	f.messages[message.EventID] = message
+	return nil
+}
+
+func (f *fakeInboxRepo) Exists(_ context.Context, eventID string) (bool, error) {
+	_, ok := f.messages[eventID]
+	return ok, nil
+}
+
+func TestBasketConfirmedEventHandler_Handle_OrderIDIsDerivedFromBasketID(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, createOrderHandler.commands, 1)
+	assert.Equal(t, event.GetBasketId(), createOrderHandler.commands[0].OrderID().String())
+}
+
+func TestBasketConfirmedEventHandler_Handle_InvalidBasketID(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), "not-a-uuid")
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.ErrorIs(t, err, common.ErrIncorrectMessage)
+	assert.Empty(t, createOrderHandler.commands)
+}
+
+func TestBasketConfirmedEventHandler_Handle_FullAddressIsPassedToCommand(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	address := createOrderHandler.commands[0].Address()
+	assert.Equal(t, "Россия", address.Country())
+	assert.Equal(t, "Москва", address.City())
+	assert.Equal(t, "Несуществующая", address.Street())
+	assert.Equal(t, "1", address.House())
+	assert.Equal(t, "12", address.Apartment())
+}
+
+func TestBasketConfirmedEventHandler_Handle_IncompleteAddress(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+	event.Address.House = ""
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.ErrorIs(t, err, common.ErrIncorrectMessage)
+	assert.Empty(t, createOrderHandler.commands)
+}
+
+func TestBasketConfirmedEventHandler_Handle_AcknowledgesExistingOrder(t *testing.T) {
+	// Arrange
+	basketID := uuid.NewString()
+	createOrderHandler := &fakeCreateOrderHandler{err: errs.NewObjectAlreadyExistsError("order", basketID)}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), basketID)
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, createOrderHandler.commands, 1)
+}
+
+func TestBasketConfirmedEventHandler_Handle_DeliveryPeriodIsPassedToCommand(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+	event.DeliveryPeriod = &basketpb.DeliveryPeriod{From: 9, To: 12}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, createOrderHandler.commands, 1)
+
+	deliveryPeriod := createOrderHandler.commands[0].DeliveryPeriod()
+	assert.True(t, deliveryPeriod.IsSet())
+	assert.Equal(t, 9, deliveryPeriod.From().Hour())
+	assert.Equal(t, 12, deliveryPeriod.To().Hour())
+}
+
+func TestBasketConfirmedEventHandler_Handle_DeliveryPeriodIsAnchoredToEventTime(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+	event.OccurredAt = timestamppb.New(time.Date(2026, 10, 17, 13, 0, 0, 0, time.UTC))
+	event.DeliveryPeriod = &basketpb.DeliveryPeriod{From: 9, To: 12}
+
+	// Act
+	firstErr := handler.Handle(context.Background(), event)
+	secondErr := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.NoError(t, secondErr)
+	assert.Len(t, createOrderHandler.commands, 2)
+
+	// Окно на момент подтверждения корзины уже прошло, поэтому переносится на следующий день
+	deliveryPeriod := createOrderHandler.commands[0].DeliveryPeriod()
+	assert.Equal(t, time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), deliveryPeriod.From())
+	assert.Equal(t, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), deliveryPeriod.To())
+	assert.True(t, deliveryPeriod.Equals(createOrderHandler.commands[1].DeliveryPeriod()))
+}
+
+func TestBasketConfirmedEventHandler_Handle_DeliveryPeriodCrossingMidnight(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+	event.OccurredAt = timestamppb.New(time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC))
+	event.DeliveryPeriod = &basketpb.DeliveryPeriod{From: 22, To: 2}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, createOrderHandler.commands, 1)
+
+	deliveryPeriod := createOrderHandler.commands[0].DeliveryPeriod()
+	assert.Equal(t, time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC), deliveryPeriod.From())
+	assert.Equal(t, time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC), deliveryPeriod.To())
+}
+
+func TestBasketConfirmedEventHandler_Handle_DeliveryPeriodWithoutEventTime(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+	event.OccurredAt = nil
+	event.DeliveryPeriod = &basketpb.DeliveryPeriod{From: 9, To: 12}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.ErrorIs(t, err, common.ErrIncorrectMessage)
+	assert.Empty(t, createOrderHandler.commands)
+}
+
+func TestBasketConfirmedEventHandler_Handle_InvalidDeliveryPeriod(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := NewBasketConfirmedEventHandler(createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+	event.DeliveryPeriod = &basketpb.DeliveryPeriod{From: 9, To: 9}
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.ErrorIs(t, err, common.ErrIncorrectMessage)
+	assert.Empty(t, createOrderHandler.commands)
+}
+
+func TestBasketConfirmedEventHandler_Handle_ReplayedMessageCreatesOrderOnce(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := newInboxBasketConfirmedEventHandler(t, createOrderHandler)
+	event := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+
+	// Act
+	firstErr := handler.Handle(context.Background(), event)
+	secondErr := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.NoError(t, secondErr)
+	assert.Len(t, createOrderHandler.commands, 1)
+}
+
+func TestBasketConfirmedEventHandler_Handle_DifferentEventsCreateDifferentOrders(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := newInboxBasketConfirmedEventHandler(t, createOrderHandler)
+	firstEvent := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+	secondEvent := newBasketConfirmedEvent(uuid.NewString(), uuid.NewString())
+
+	// Act
+	firstErr := handler.Handle(context.Background(), firstEvent)
+	secondErr := handler.Handle(context.Background(), secondEvent)
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.NoError(t, secondErr)
+	assert.Len(t, createOrderHandler.commands, 2)
+}
+
+func TestBasketConfirmedEventHandler_Handle_EventWithoutEventIDIsIncorrect(t *testing.T) {
+	// Arrange
+	createOrderHandler := &fakeCreateOrderHandler{}
+	handler := common.NewInboxEventHandler[*basketpb.BasketConfirmedIntegrationEvent](
+		mocks.NewUnitOfWorkFactory(t),
+		NewBasketConfirmedEventHandler(createOrderHandler),
+	)
+	event := newBasketConfirmedEvent("", uuid.NewString())
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.ErrorIs(t, err, common.ErrIncorrectMessage)
+	assert.Empty(t, createOrderHandler.commands)
+}
+
+// Helper functions
+
+func newInboxBasketConfirmedEventHandler(t *testing.T, createOrderHandler *fakeCreateOrderHandler) *common.InboxEventHandler[*basketpb.BasketConfirmedIntegrationEvent] {
+	t.Helper()
+
+	inboxRepo := &fakeInboxRepo{messages: make(map[string]*inbox.Message)}
+
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().InboxRepo().Return(inboxRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(mockUoW)
+
+	return common.NewInboxEventHandler[*basketpb.BasketConfirmedIntegrationEvent](
+		mockUoWFactory,
+		NewBasketConfirmedEventHandler(createOrderHandler),
+	)
+}
+
+func newBasketConfirmedEvent(eventID string, basketID string) *basketpb.BasketConfirmedIntegrationEvent {
+	return &basketpb.BasketConfirmedIntegrationEvent{
+		EventId:    eventID,
+		BasketId:   basketID,
+		OccurredAt: timestamppb.Now(),
+		Address:    &basketpb.Address{Country: "Россия", City: "Москва", Street: "Несуществующая", House: "1", Apartment: "12"},
+		Volume:     5,
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/retry"
 
 	"github.com/IBM/sarama"
 	"google.golang.org/protobuf/proto"
@@ -24,15 +26,21 @@ type KafkaConsumer[TEvent proto.Message] struct {
 	topic         string
 	consumerGroup sarama.ConsumerGroup
 	domainHandler EventHandler[TEvent]
+	retryPolicy   retry.Policy
+	deadLetters   DeadLetterSender
 	ctx           context.Context
 	cancel        context.CancelFunc
 }
 
+// NewKafkaConsumerGroup - сообщение, которое не удалось обработать за retryPolicy.MaxAttempts попыток,
+// уходит в deadLetters. Некорректные сообщения уходят туда сразу, без повторов.
 func NewKafkaConsumerGroup[TEvent proto.Message](
 	brokers []string,
 	group string,
 	topic string,
 	domainHandler EventHandler[TEvent],
+	retryPolicy retry.Policy,
+	deadLetters DeadLetterSender,
 ) (*KafkaConsumer[TEvent], error) {
 	if len(brokers) == 0 {
 		return nil, errs.NewValueIsRequiredError("brokers")
@@ -43,6 +51,9 @@ func NewKafkaConsumerGroup[TEvent proto.Message](
 	if len(topic) == 0 {
 		return nil, errs.NewValueIsRequiredError("topic")
 	}
+	if deadLetters == nil {
+		return nil, errs.NewValueIsRequiredError("deadLetters")
+	}
 
 	saramaConfig := sarama.NewConfig()
 	saramaConfig.Version = sarama.V3_4_0_0
@@ -60,6 +71,8 @@ func NewKafkaConsumerGroup[TEvent proto.Message](
 		topic:         topic,
 		consumerGroup: consumerGroup,
 		domainHandler: domainHandler,
+		retryPolicy:   retryPolicy,
+		deadLetters:   deadLetters,
 		ctx:           ctx,
 		cancel:        cancel,
 	}, nil
@@ -90,32 +103,87 @@ func (c *KafkaConsumer[TEvent]) Cleanup(_ sarama.ConsumerGroupSession) error {
 	return nil
 }
 
+// ConsumeClaim - сообщение помечается обработанным только после успешной обработки
+// или после отправки в dead-letter топик
 func (c *KafkaConsumer[TEvent]) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for message := range claim.Messages() {
-		ctx := context.Background()
 		log.Printf("Received: topic = %s, partition = %d, offset = %d, key = %s, value = %s\n",
 			message.Topic, message.Partition, message.Offset, string(message.Key), string(message.Value))
 
-		eventType := reflect.TypeOf((*TEvent)(nil)).Elem()
-		eventValue := reflect.New(eventType.Elem())
-		event := eventValue.Interface().(TEvent)
-
-		if err := proto.Unmarshal(message.Value, event); err != nil {
-			return fmt.Errorf("%w: %v", ErrIncorrectMessage, err)
+		// Ошибка означает, что сессия или консьюмер остановлены, сообщение прочитается снова
+		if err := c.handleMessage(session.Context(), message); err != nil {
+			return nil
 		}
 
+		session.MarkMessage(message, "")
+	}
+	return nil
+}
+
+// handleMessage - возвращает ошибку, только если консьюмер остановился раньше, чем сообщение обработано или отложено.
+// sessionCtx прерывает только паузу между попытками, сама обработка не отменяется.
+func (c *KafkaConsumer[TEvent]) handleMessage(sessionCtx context.Context, message *sarama.ConsumerMessage) error {
+	ctx := context.Background()
+
+	eventType := reflect.TypeOf((*TEvent)(nil)).Elem()
+	eventValue := reflect.New(eventType.Elem())
+	event := eventValue.Interface().(TEvent)
+
+	if err := proto.Unmarshal(message.Value, event); err != nil {
+		return c.sendToDeadLetters(sessionCtx, message, 1, fmt.Errorf("%w: %v", ErrIncorrectMessage, err))
+	}
+
+	maxAttempts := c.retryPolicy.Attempts()
+	for attempt := 1; ; attempt++ {
 		err := c.domainHandler.Handle(ctx, event)
-		if err != nil {
-			if errors.Is(err, ErrIncorrectMessage) {
-				session.MarkMessage(message, "")
-				continue
-			}
+		if err == nil {
+			return nil
+		}
 
-			continue
+		if errors.Is(err, ErrIncorrectMessage) || attempt >= maxAttempts {
+			return c.sendToDeadLetters(sessionCtx, message, attempt, err)
 		}
 
-		// Mark message as consumed
-		session.MarkMessage(message, "")
+		log.Printf("failed to handle message: topic = %s, partition = %d, offset = %d, attempt = %d: %v",
+			message.Topic, message.Partition, message.Offset, attempt, err)
+
+		if err := c.wait(sessionCtx, c.retryPolicy.Backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// sendToDeadLetters - пока dead-letter топик недоступен, отправка повторяется, иначе сообщение потеряется
+func (c *KafkaConsumer[TEvent]) sendToDeadLetters(sessionCtx context.Context, message *sarama.ConsumerMessage, attempts int, cause error) error {
+	log.Printf("sending message to dead-letter topic: topic = %s, partition = %d, offset = %d, attempts = %d: %v",
+		message.Topic, message.Partition, message.Offset, attempts, cause)
+
+	for sendAttempt := 1; ; sendAttempt++ {
+		err := c.deadLetters.Send(message, attempts, cause)
+		if err == nil {
+			return nil
+		}
+
+		log.Printf("failed to send message to dead-letter topic: topic = %s, partition = %d, offset = %d: %v",
+			message.Topic, message.Partition, message.Offset, err)
+
+		if err := c.wait(sessionCtx, c.retryPolicy.Backoff(sendAttempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// wait - пауза перед повтором прерывается остановкой консьюмера или ребалансировкой
+func (c *KafkaConsumer[TEvent]) wait(ctx context.Context, backoff time.Duration) error {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.ctx.Done():
+		return c.ctx.Err()
 	}
-	return nil
 }
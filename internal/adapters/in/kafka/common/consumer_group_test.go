@@ -0,0 +1,191 @@
+package common
+
+import (
+	"context"
+	"delivery/internal/pkg/retry"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type fakeSession struct {
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeSession) Claims() map[string][]int32               { return nil }
+func (s *fakeSession) MemberID() string                         { return "" }
+func (s *fakeSession) GenerationID() int32                      { return 0 }
+func (s *fakeSession) MarkOffset(string, int32, int64, string)  {}
+func (s *fakeSession) Commit()                                  {}
+func (s *fakeSession) ResetOffset(string, int32, int64, string) {}
+func (s *fakeSession) Context() context.Context                 { return s.ctx }
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	messages chan *sarama.ConsumerMessage
+}
+
+func newFakeClaim(messages ...*sarama.ConsumerMessage) *fakeClaim {
+	claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, len(messages))}
+	for _, message := range messages {
+		claim.messages <- message
+	}
+	close(claim.messages)
+	return claim
+}
+
+func (c *fakeClaim) Topic() string                            { return "basket.confirmed" }
+func (c *fakeClaim) Partition() int32                         { return 0 }
+func (c *fakeClaim) InitialOffset() int64                     { return 0 }
+func (c *fakeClaim) HighWaterMarkOffset() int64               { return 0 }
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+type fakeHandler struct {
+	errs  []error
+	calls int
+}
+
+func (h *fakeHandler) Handle(context.Context, *wrapperspb.StringValue) error {
+	h.calls++
+	if h.calls <= len(h.errs) {
+		return h.errs[h.calls-1]
+	}
+	return nil
+}
+
+type deadLetter struct {
+	offset   int64
+	attempts int
+	cause    error
+}
+
+type fakeDeadLetters struct {
+	sent     []deadLetter
+	failures int
+}
+
+func (d *fakeDeadLetters) Send(message *sarama.ConsumerMessage, attempts int, cause error) error {
+	if d.failures > 0 {
+		d.failures--
+		return errors.New("kafka is down")
+	}
+	d.sent = append(d.sent, deadLetter{offset: message.Offset, attempts: attempts, cause: cause})
+	return nil
+}
+
+func newTestConsumer(handler *fakeHandler, deadLetters *fakeDeadLetters) *KafkaConsumer[*wrapperspb.StringValue] {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &KafkaConsumer[*wrapperspb.StringValue]{
+		topic:         "basket.confirmed",
+		domainHandler: handler,
+		retryPolicy:   retry.Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 2},
+		deadLetters:   deadLetters,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+func validMessage(offset int64) *sarama.ConsumerMessage {
+	value, _ := proto.Marshal(wrapperspb.String("basket"))
+	return &sarama.ConsumerMessage{Topic: "basket.confirmed", Offset: offset, Value: value}
+}
+
+func TestKafkaConsumer_ConsumeClaim_RetriesAndMarksAfterSuccess(t *testing.T) {
+	// Arrange
+	handler := &fakeHandler{errs: []error{errors.New("db is down")}}
+	deadLetters := &fakeDeadLetters{}
+	consumer := newTestConsumer(handler, deadLetters)
+	session := &fakeSession{ctx: context.Background()}
+
+	// Act
+	err := consumer.ConsumeClaim(session, newFakeClaim(validMessage(1)))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, handler.calls)
+	assert.Empty(t, deadLetters.sent)
+	assert.Equal(t, []int64{1}, session.marked)
+}
+
+func TestKafkaConsumer_ConsumeClaim_SendsToDeadLettersAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	handlerErr := errors.New("db is down")
+	handler := &fakeHandler{errs: []error{handlerErr, handlerErr, handlerErr}}
+	deadLetters := &fakeDeadLetters{}
+	consumer := newTestConsumer(handler, deadLetters)
+	session := &fakeSession{ctx: context.Background()}
+
+	// Act
+	err := consumer.ConsumeClaim(session, newFakeClaim(validMessage(1), validMessage(2)))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 4, handler.calls)
+	assert.Equal(t, []deadLetter{{offset: 1, attempts: 3, cause: handlerErr}}, deadLetters.sent)
+	assert.Equal(t, []int64{1, 2}, session.marked)
+}
+
+func TestKafkaConsumer_ConsumeClaim_IncorrectMessagesSkipRetries(t *testing.T) {
+	// Arrange
+	handler := &fakeHandler{errs: []error{ErrIncorrectMessage}}
+	deadLetters := &fakeDeadLetters{}
+	consumer := newTestConsumer(handler, deadLetters)
+	session := &fakeSession{ctx: context.Background()}
+	brokenMessage := &sarama.ConsumerMessage{Topic: "basket.confirmed", Offset: 2, Value: []byte{0xff}}
+
+	// Act
+	err := consumer.ConsumeClaim(session, newFakeClaim(validMessage(1), brokenMessage))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, handler.calls)
+	assert.Len(t, deadLetters.sent, 2)
+	assert.Equal(t, 1, deadLetters.sent[0].attempts)
+	assert.ErrorIs(t, deadLetters.sent[1].cause, ErrIncorrectMessage)
+	assert.Equal(t, []int64{1, 2}, session.marked)
+}
+
+func TestKafkaConsumer_ConsumeClaim_RetriesDeadLetterSend(t *testing.T) {
+	// Arrange
+	handler := &fakeHandler{errs: []error{ErrIncorrectMessage}}
+	deadLetters := &fakeDeadLetters{failures: 2}
+	consumer := newTestConsumer(handler, deadLetters)
+	session := &fakeSession{ctx: context.Background()}
+
+	// Act
+	err := consumer.ConsumeClaim(session, newFakeClaim(validMessage(1)))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, deadLetters.sent, 1)
+	assert.Equal(t, []int64{1}, session.marked)
+}
+
+func TestKafkaConsumer_ConsumeClaim_DoesNotMarkWhenStoppedDuringBackoff(t *testing.T) {
+	// Arrange
+	handler := &fakeHandler{errs: []error{errors.New("db is down")}}
+	deadLetters := &fakeDeadLetters{}
+	consumer := newTestConsumer(handler, deadLetters)
+	consumer.retryPolicy.InitialBackoff = time.Hour
+	consumer.retryPolicy.MaxBackoff = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	session := &fakeSession{ctx: ctx}
+
+	// Act
+	err := consumer.ConsumeClaim(session, newFakeClaim(validMessage(1), validMessage(2)))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, handler.calls)
+	assert.Empty(t, deadLetters.sent)
+	assert.Empty(t, session.marked)
+}
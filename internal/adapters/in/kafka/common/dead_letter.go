@@ -0,0 +1,133 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+)
+
+const (
+	DefaultDeadLetterTopicSuffix = ".dlq"
+
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderError             = "x-error"
+	HeaderAttempts          = "x-attempts"
+	HeaderFailedAt          = "x-failed-at"
+)
+
+var deadLetterHeaders = []string{
+	HeaderOriginalTopic,
+	HeaderOriginalPartition,
+	HeaderOriginalOffset,
+	HeaderError,
+	HeaderAttempts,
+	HeaderFailedAt,
+}
+
+// DeadLetterSender - откладывает сообщение, которое не удалось обработать
+type DeadLetterSender interface {
+	Send(message *sarama.ConsumerMessage, attempts int, cause error) error
+}
+
+var _ DeadLetterSender = (*DeadLetterPublisher)(nil)
+
+// DeadLetterPublisher - пишет сообщение как есть в топик <topic><suffix>, причина и число попыток
+// передаются заголовками, исходные заголовки сохраняются
+type DeadLetterPublisher struct {
+	producer sarama.SyncProducer
+	suffix   string
+}
+
+func NewDeadLetterPublisher(brokers []string, suffix string) (*DeadLetterPublisher, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Version = sarama.V3_4_0_0
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dead-letter producer: %w", err)
+	}
+
+	return NewDeadLetterPublisherWithProducer(producer, suffix), nil
+}
+
+func NewDeadLetterPublisherWithProducer(producer sarama.SyncProducer, suffix string) *DeadLetterPublisher {
+	if suffix == "" {
+		suffix = DefaultDeadLetterTopicSuffix
+	}
+
+	return &DeadLetterPublisher{
+		producer: producer,
+		suffix:   suffix,
+	}
+}
+
+func (p *DeadLetterPublisher) Send(message *sarama.ConsumerMessage, attempts int, cause error) error {
+	if cause == nil {
+		cause = errors.New("unknown error")
+	}
+
+	headers := make([]sarama.RecordHeader, 0, len(message.Headers)+len(deadLetterHeaders))
+	for _, header := range message.Headers {
+		if header != nil && !isDeadLetterHeader(string(header.Key)) {
+			headers = append(headers, *header)
+		}
+	}
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(HeaderOriginalTopic), Value: []byte(message.Topic)},
+		sarama.RecordHeader{Key: []byte(HeaderOriginalPartition), Value: []byte(strconv.FormatInt(int64(message.Partition), 10))},
+		sarama.RecordHeader{Key: []byte(HeaderOriginalOffset), Value: []byte(strconv.FormatInt(message.Offset, 10))},
+		sarama.RecordHeader{Key: []byte(HeaderError), Value: []byte(cause.Error())},
+		sarama.RecordHeader{Key: []byte(HeaderAttempts), Value: []byte(strconv.Itoa(attempts))},
+		sarama.RecordHeader{Key: []byte(HeaderFailedAt), Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+	)
+
+	producerMessage := &sarama.ProducerMessage{
+		Topic:   DeadLetterTopic(message.Topic, p.suffix),
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	}
+	if message.Key != nil {
+		producerMessage.Key = sarama.ByteEncoder(message.Key)
+	}
+
+	_, _, err := p.producer.SendMessage(producerMessage)
+	if err != nil {
+		return fmt.Errorf("failed to send message to dead-letter topic: %w", err)
+	}
+
+	return nil
+}
+
+func (p *DeadLetterPublisher) Close() error {
+	return p.producer.Close()
+}
+
+func DeadLetterTopic(topic string, suffix string) string {
+	if suffix == "" {
+		suffix = DefaultDeadLetterTopicSuffix
+	}
+	return topic + suffix
+}
+
+func isDeadLetterHeader(key string) bool {
+	for _, deadLetterHeader := range deadLetterHeaders {
+		if strings.EqualFold(key, deadLetterHeader) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/IBM/sarama"
+)
+
+// DeadLetterReplayer - возвращает отложенные сообщения в исходный топик.
+// Прогресс хранится в отдельной consumer group, поэтому каждое сообщение возвращается один раз,
+// а повторная доставка уже обработанного события отсекается inbox.
+type DeadLetterReplayer struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+	group    string
+	suffix   string
+}
+
+func NewDeadLetterReplayer(brokers []string, group string, suffix string) (*DeadLetterReplayer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if len(group) == 0 {
+		return nil, errs.NewValueIsRequiredError("group")
+	}
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Version = sarama.V3_4_0_0
+	saramaCfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+
+	client, err := sarama.NewClient(brokers, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to create replay producer: %w", err)
+	}
+
+	return &DeadLetterReplayer{
+		client:   client,
+		producer: producer,
+		group:    group,
+		suffix:   suffix,
+	}, nil
+}
+
+// Replay - возвращает в topic сообщения, которые лежали в его dead-letter топике на момент вызова
+func (r *DeadLetterReplayer) Replay(ctx context.Context, topic string) (int, error) {
+	deadLetterTopic := DeadLetterTopic(topic, r.suffix)
+
+	partitions, err := r.client.Partitions(deadLetterTopic)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get partitions of %s: %w", deadLetterTopic, err)
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer consumer.Close()
+
+	offsetManager, err := sarama.NewOffsetManagerFromClient(r.group, r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer offsetManager.Close()
+
+	replayed := 0
+	for _, partition := range partitions {
+		count, err := r.replayPartition(ctx, consumer, offsetManager, topic, deadLetterTopic, partition)
+		replayed += count
+		if err != nil {
+			offsetManager.Commit()
+			return replayed, err
+		}
+	}
+
+	offsetManager.Commit()
+	return replayed, nil
+}
+
+func (r *DeadLetterReplayer) replayPartition(
+	ctx context.Context,
+	consumer sarama.Consumer,
+	offsetManager sarama.OffsetManager,
+	topic string,
+	deadLetterTopic string,
+	partition int32,
+) (int, error) {
+	partitionOffsetManager, err := offsetManager.ManagePartition(deadLetterTopic, partition)
+	if err != nil {
+		return 0, err
+	}
+	defer partitionOffsetManager.Close()
+
+	// Новые сообщения, пришедшие во время replay, остаются до следующего запуска
+	highWaterMark, err := r.client.GetOffset(deadLetterTopic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, err
+	}
+	oldest, err := r.client.GetOffset(deadLetterTopic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, err
+	}
+
+	start, _ := partitionOffsetManager.NextOffset()
+	if start < oldest {
+		start = oldest
+	}
+	if start >= highWaterMark {
+		return 0, nil
+	}
+
+	partitionConsumer, err := consumer.ConsumePartition(deadLetterTopic, partition, start)
+	if err != nil {
+		return 0, err
+	}
+	defer partitionConsumer.Close()
+
+	replayed := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return replayed, ctx.Err()
+		case consumerErr, ok := <-partitionConsumer.Errors():
+			if !ok {
+				return replayed, errors.New("partition consumer closed")
+			}
+			return replayed, consumerErr
+		case message, ok := <-partitionConsumer.Messages():
+			if !ok {
+				return replayed, errors.New("partition consumer closed")
+			}
+
+			if _, _, err := r.producer.SendMessage(ReplayMessage(message, topic)); err != nil {
+				return replayed, fmt.Errorf("failed to replay message %s/%d/%d: %w", deadLetterTopic, partition, message.Offset, err)
+			}
+			partitionOffsetManager.MarkOffset(message.Offset+1, "")
+			replayed++
+
+			if message.Offset+1 >= highWaterMark {
+				return replayed, nil
+			}
+		}
+	}
+}
+
+func (r *DeadLetterReplayer) Close() error {
+	if err := r.producer.Close(); err != nil {
+		return err
+	}
+	return r.client.Close()
+}
+
+// ReplayMessage - восстанавливает исходное сообщение: топик берется из заголовка,
+// заголовки dead-letter топика убираются
+func ReplayMessage(message *sarama.ConsumerMessage, topic string) *sarama.ProducerMessage {
+	headers := make([]sarama.RecordHeader, 0, len(message.Headers))
+	for _, header := range message.Headers {
+		if header == nil {
+			continue
+		}
+		if string(header.Key) == HeaderOriginalTopic && len(header.Value) > 0 {
+			topic = string(header.Value)
+		}
+		if !isDeadLetterHeader(string(header.Key)) {
+			headers = append(headers, *header)
+		}
+	}
+
+	producerMessage := &sarama.ProducerMessage{
+		Topic:   topic,
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	}
+	if message.Key != nil {
+		producerMessage.Key = sarama.ByteEncoder(message.Key)
+	}
+
+	return producerMessage
+}
@@ -0,0 +1,81 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func headerValue(headers []sarama.RecordHeader, key string) string {
+	for _, header := range headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func TestDeadLetterPublisher_Send_KeepsPayloadAndAddsHeaders(t *testing.T) {
+	// Arrange
+	producer := mocks.NewSyncProducer(t, nil)
+	var sent *sarama.ProducerMessage
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(message *sarama.ProducerMessage) error {
+		sent = message
+		return nil
+	})
+	publisher := NewDeadLetterPublisherWithProducer(producer, "")
+	message := &sarama.ConsumerMessage{
+		Topic:     "basket.confirmed",
+		Partition: 2,
+		Offset:    42,
+		Key:       []byte("basket-1"),
+		Value:     []byte("payload"),
+		Headers:   []*sarama.RecordHeader{{Key: []byte("trace-id"), Value: []byte("abc")}},
+	}
+
+	// Act
+	err := publisher.Send(message, 3, errors.New("db is down"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "basket.confirmed.dlq", sent.Topic)
+	key, _ := sent.Key.Encode()
+	value, _ := sent.Value.Encode()
+	assert.Equal(t, "basket-1", string(key))
+	assert.Equal(t, "payload", string(value))
+	assert.Equal(t, "abc", headerValue(sent.Headers, "trace-id"))
+	assert.Equal(t, "basket.confirmed", headerValue(sent.Headers, HeaderOriginalTopic))
+	assert.Equal(t, "2", headerValue(sent.Headers, HeaderOriginalPartition))
+	assert.Equal(t, "42", headerValue(sent.Headers, HeaderOriginalOffset))
+	assert.Equal(t, "db is down", headerValue(sent.Headers, HeaderError))
+	assert.Equal(t, "3", headerValue(sent.Headers, HeaderAttempts))
+	assert.NotEmpty(t, headerValue(sent.Headers, HeaderFailedAt))
+	assert.NoError(t, producer.Close())
+}
+
+func TestReplayMessage_RestoresOriginalTopicAndHeaders(t *testing.T) {
+	// Arrange
+	message := &sarama.ConsumerMessage{
+		Topic: "basket.confirmed.dlq",
+		Key:   []byte("basket-1"),
+		Value: []byte("payload"),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("trace-id"), Value: []byte("abc")},
+			{Key: []byte(HeaderOriginalTopic), Value: []byte("basket.confirmed")},
+			{Key: []byte(HeaderError), Value: []byte("db is down")},
+			{Key: []byte(HeaderAttempts), Value: []byte("3")},
+		},
+	}
+
+	// Act
+	replayed := ReplayMessage(message, "fallback")
+
+	// Assert
+	assert.Equal(t, "basket.confirmed", replayed.Topic)
+	value, _ := replayed.Value.Encode()
+	assert.Equal(t, "payload", string(value))
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte("trace-id"), Value: []byte("abc")}}, replayed.Headers)
+}
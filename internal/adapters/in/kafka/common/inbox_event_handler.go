@@ -0,0 +1,57 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/inbox"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// InboxEvent - интеграционное событие, у которого есть идентификатор для дедупликации
+type InboxEvent interface {
+	proto.Message
+	GetEventId() string
+}
+
+// InboxEventHandler оборачивает обработчик и гарантирует, что событие с одним event_id будет обработано один раз.
+// Запись в inbox делается в той же транзакции, что и изменения, которые вносит обработчик.
+type InboxEventHandler[TEvent InboxEvent] struct {
+	uowFactory ports.UnitOfWorkFactory
+	handler    EventHandler[TEvent]
+}
+
+func NewInboxEventHandler[TEvent InboxEvent](uowFactory ports.UnitOfWorkFactory, handler EventHandler[TEvent]) *InboxEventHandler[TEvent] {
+	return &InboxEventHandler[TEvent]{
+		uowFactory: uowFactory,
+		handler:    handler,
+	}
+}
+
+func (h *InboxEventHandler[TEvent]) Handle(ctx context.Context, event TEvent) error {
+	eventID := event.GetEventId()
+	if eventID == "" {
+		return fmt.Errorf("%w: event_id is required", ErrIncorrectMessage)
+	}
+
+	uow := h.uowFactory.NewUOW()
+
+	return uow.Do(ctx, func(ctx context.Context) error {
+		alreadyProcessed, uowErr := uow.InboxRepo().Exists(ctx, eventID)
+		if uowErr != nil {
+			return uowErr
+		}
+
+		if alreadyProcessed {
+			return nil
+		}
+
+		if uowErr := h.handler.Handle(ctx, event); uowErr != nil {
+			return uowErr
+		}
+
+		return uow.InboxRepo().Add(ctx, inbox.NewMessage(eventID, string(proto.MessageName(event))))
+	})
+}
@@ -0,0 +1,18 @@
+package common
+
+import (
+	"time"
+
+	"delivery/internal/pkg/retry"
+)
+
+// DefaultRetryPolicy - сколько раз и с какими паузами повторять обработку сообщения,
+// прежде чем отправить его в dead-letter топик
+func DefaultRetryPolicy() retry.Policy {
+	return retry.Policy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+	}
+}
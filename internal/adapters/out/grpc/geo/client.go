@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports"
 	"delivery/internal/generated/clients/geosrv/geopb"
@@ -18,8 +19,9 @@ var _ ports.GeoClient = &geoClient{}
 type closer func() error
 
 type geoClient struct {
-	client  geopb.GeoClient
-	timeout time.Duration
+	client    geopb.GeoClient
+	timeout   time.Duration
+	mapBounds shared_kernel.MapBounds
 }
 
 type Option func(*geoClient)
@@ -31,6 +33,13 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithMapBounds sets the map bounds the returned locations are validated against
+func WithMapBounds(mapBounds shared_kernel.MapBounds) Option {
+	return func(c *geoClient) {
+		c.mapBounds = mapBounds
+	}
+}
+
 func NewGeoClient(host string, opts ...Option) (*geoClient, closer) {
 	// Establish insecure connection
 	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -39,8 +48,9 @@ func NewGeoClient(host string, opts ...Option) (*geoClient, closer) {
 	}
 
 	client := &geoClient{
-		client:  geopb.NewGeoClient(conn),
-		timeout: 30 * time.Second, // default timeout
+		client:    geopb.NewGeoClient(conn),
+		timeout:   30 * time.Second, // default timeout
+		mapBounds: shared_kernel.DefaultMapBounds(),
 	}
 
 	// Apply options
@@ -55,12 +65,13 @@ func NewGeoClient(host string, opts ...Option) (*geoClient, closer) {
 	return client, closer
 }
 
-func (c *geoClient) GetGeolocation(street string) (shared_kernel.Location, error) {
+// GetGeolocation - геосервис пока ищет только по улице, остальные части адреса ему не передаются
+func (c *geoClient) GetGeolocation(address order.Address) (shared_kernel.Location, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
 	req := &geopb.GetGeolocationRequest{
-		Street: street,
+		Street: address.Street(),
 	}
 
 	resp, err := c.client.GetGeolocation(ctx, req)
@@ -73,5 +84,5 @@ func (c *geoClient) GetGeolocation(street string) (shared_kernel.Location, error
 	}
 
 	// Convert from protobuf Location (int32) to shared_kernel.Location (int64)
-	return shared_kernel.NewLocation(int64(resp.Location.X), int64(resp.Location.Y))
+	return c.mapBounds.NewLocation(int64(resp.Location.X), int64(resp.Location.Y))
 }
@@ -0,0 +1,131 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+)
+
+// CoalescingProducer - копит события и раз в interval отправляет только последнее событие по каждому ключу.
+// Подходит для частых событий вроде перемещения курьера, где потребителю важно только последнее состояние.
+// Если отправка не удалась, событие ждет следующей отправки, пока его не вытеснит более новое с тем же ключом.
+type CoalescingProducer[TDomainEvent ddd.DomainEvent] struct {
+	producer ports.EventProducer[TDomainEvent]
+	key      func(TDomainEvent) string
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[string]TDomainEvent
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func NewCoalescingProducer[TDomainEvent ddd.DomainEvent](
+	producer ports.EventProducer[TDomainEvent],
+	key func(TDomainEvent) string,
+	interval time.Duration,
+) (*CoalescingProducer[TDomainEvent], error) {
+	if producer == nil {
+		return nil, errs.NewValueIsRequiredError("producer")
+	}
+	if key == nil {
+		return nil, errs.NewValueIsRequiredError("key")
+	}
+	if interval <= 0 {
+		return nil, errs.NewValueIsInvalidError("interval")
+	}
+
+	p := &CoalescingProducer[TDomainEvent]{
+		producer: producer,
+		key:      key,
+		interval: interval,
+		pending:  make(map[string]TDomainEvent),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go p.run()
+
+	return p, nil
+}
+
+// Publish - только запоминает событие, отправка происходит в фоне
+func (p *CoalescingProducer[TDomainEvent]) Publish(_ context.Context, domainEvent TDomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pending[p.key(domainEvent)] = domainEvent
+	return nil
+}
+
+// Close - останавливает фоновую отправку, отправляет накопленные события и закрывает продюсер
+func (p *CoalescingProducer[TDomainEvent]) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.stop)
+		<-p.done
+
+		ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+		defer cancel()
+
+		p.closeErr = errors.Join(p.flush(ctx), p.producer.Close())
+	})
+	return p.closeErr
+}
+
+func (p *CoalescingProducer[TDomainEvent]) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+			if err := p.flush(ctx); err != nil {
+				log.Printf("CoalescingProducer: failed to publish, will retry on next flush: %v", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// flush - отправляет накопленные события. На первой ошибке останавливается,
+// а неотправленные события возвращает в очередь, если их еще не вытеснили более новые.
+func (p *CoalescingProducer[TDomainEvent]) flush(ctx context.Context) error {
+	p.mu.Lock()
+	batch := p.pending
+	p.pending = make(map[string]TDomainEvent)
+	p.mu.Unlock()
+
+	for key, domainEvent := range batch {
+		if err := p.producer.Publish(ctx, domainEvent); err != nil {
+			p.requeue(batch)
+			return err
+		}
+		delete(batch, key)
+	}
+
+	return nil
+}
+
+func (p *CoalescingProducer[TDomainEvent]) requeue(events map[string]TDomainEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, domainEvent := range events {
+		if _, newer := p.pending[key]; !newer {
+			p.pending[key] = domainEvent
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/event"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingProducer struct {
+	published []*event.CourierMoved
+	err       error
+	closed    bool
+}
+
+func (p *recordingProducer) Publish(_ context.Context, domainEvent *event.CourierMoved) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, domainEvent)
+	return nil
+}
+
+func (p *recordingProducer) Close() error {
+	p.closed = true
+	return nil
+}
+
+func courierKey(domainEvent *event.CourierMoved) string {
+	return domainEvent.CourierID.String()
+}
+
+func newTestCoalescingProducer(t *testing.T, producer *recordingProducer) *CoalescingProducer[*event.CourierMoved] {
+	t.Helper()
+	// Интервал больше времени теста, события отправляются только явным flush или Close
+	coalescing, err := NewCoalescingProducer[*event.CourierMoved](producer, courierKey, time.Hour)
+	assert.NoError(t, err)
+	return coalescing
+}
+
+func TestCoalescingProducer_Close_PublishesOnlyLatestEventPerCourier(t *testing.T) {
+	// Arrange
+	producer := &recordingProducer{}
+	coalescing := newTestCoalescingProducer(t, producer)
+	firstCourierID := uuid.New()
+	secondCourierID := uuid.New()
+	latest := event.NewCourierMoved(firstCourierID, 3, 3, time.Now())
+	other := event.NewCourierMoved(secondCourierID, 7, 7, time.Now())
+	_ = coalescing.Publish(context.Background(), event.NewCourierMoved(firstCourierID, 1, 1, time.Now()))
+	_ = coalescing.Publish(context.Background(), event.NewCourierMoved(firstCourierID, 2, 2, time.Now()))
+	_ = coalescing.Publish(context.Background(), latest)
+	_ = coalescing.Publish(context.Background(), other)
+
+	// Act
+	err := coalescing.Close()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []*event.CourierMoved{latest, other}, producer.published)
+	assert.True(t, producer.closed)
+}
+
+func TestCoalescingProducer_Flush_KeepsEventWhenPublishFailed(t *testing.T) {
+	// Arrange
+	producer := &recordingProducer{err: errors.New("kafka is unavailable")}
+	coalescing := newTestCoalescingProducer(t, producer)
+	moved := event.NewCourierMoved(uuid.New(), 1, 1, time.Now())
+	_ = coalescing.Publish(context.Background(), moved)
+	failedErr := coalescing.flush(context.Background())
+	producer.err = nil
+
+	// Act
+	err := coalescing.Close()
+
+	// Assert
+	assert.Error(t, failedErr)
+	assert.NoError(t, err)
+	assert.Equal(t, []*event.CourierMoved{moved}, producer.published)
+}
+
+func TestCoalescingProducer_Flush_DropsFailedEventReplacedByNewer(t *testing.T) {
+	// Arrange
+	producer := &recordingProducer{err: errors.New("kafka is unavailable")}
+	coalescing := newTestCoalescingProducer(t, producer)
+	courierID := uuid.New()
+	_ = coalescing.Publish(context.Background(), event.NewCourierMoved(courierID, 1, 1, time.Now()))
+	_ = coalescing.flush(context.Background())
+	newer := event.NewCourierMoved(courierID, 2, 2, time.Now())
+	_ = coalescing.Publish(context.Background(), newer)
+	producer.err = nil
+
+	// Act
+	err := coalescing.Close()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*event.CourierMoved{newer}, producer.published)
+}
+
+func TestNewCoalescingProducer_RequiresPositiveInterval(t *testing.T) {
+	// Act
+	coalescing, err := NewCoalescingProducer[*event.CourierMoved](&recordingProducer{}, courierKey, 0)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, coalescing)
+}
@@ -5,11 +5,18 @@ import (
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
-	"encoding/json"
 	"fmt"
 
 	"github.com/IBM/sarama"
-	"github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	HeaderContentType = "content-type"
+	HeaderEventType   = "event-type"
+
+	// ContentTypeProtobuf - сообщение сериализовано в бинарный protobuf по контрактам из configs/*.proto
+	ContentTypeProtobuf = "application/x-protobuf"
 )
 
 // Интеграционное событие, которое будет отправлено в Kafka
@@ -78,17 +85,9 @@ func (p *KafkaProducer[TDomainEvent, TIntegrationEvent]) Close() error {
 }
 
 func (p *KafkaProducer[TDomainEvent, TIntegrationEvent]) Publish(ctx context.Context, domainEvent TDomainEvent) error {
-	integrationEvent := p.eventMapper.Map(domainEvent)
-
-	bytes, err := json.Marshal(integrationEvent.Event())
+	msg, err := p.toProducerMessage(domainEvent)
 	if err != nil {
-		return fmt.Errorf("marshal event: %w", err)
-	}
-
-	msg := &sarama.ProducerMessage{
-		Topic: p.topic,
-		Key:   sarama.StringEncoder(integrationEvent.Key()),
-		Value: sarama.ByteEncoder(bytes),
+		return err
 	}
 
 	resultCh := make(chan error, 1)
@@ -105,3 +104,27 @@ func (p *KafkaProducer[TDomainEvent, TIntegrationEvent]) Publish(ctx context.Con
 		return err
 	}
 }
+
+func (p *KafkaProducer[TDomainEvent, TIntegrationEvent]) toProducerMessage(domainEvent TDomainEvent) (*sarama.ProducerMessage, error) {
+	integrationEvent := p.eventMapper.Map(domainEvent)
+
+	bytes, err := proto.Marshal(integrationEvent.Event())
+	if err != nil {
+		return nil, fmt.Errorf("marshal event: %w", err)
+	}
+
+	return &sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(integrationEvent.Key()),
+		Value: sarama.ByteEncoder(bytes),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(HeaderContentType), Value: []byte(ContentTypeProtobuf)},
+			{Key: []byte(HeaderEventType), Value: []byte(EventType(integrationEvent.Event()))},
+		},
+	}, nil
+}
+
+// EventType - полное имя интеграционного события из контракта, например order_event.OrderCreatedIntegrationEvent
+func EventType(event proto.Message) string {
+	return string(proto.MessageName(event))
+}
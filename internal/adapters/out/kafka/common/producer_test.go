@@ -0,0 +1,61 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/generated/queues/orderpb"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+type orderCreatedTestMapper struct{}
+
+func (m orderCreatedTestMapper) Map(domainEvent *event.OrderCreated) IntegrationEvent[*orderpb.OrderCreatedIntegrationEvent] {
+	integrationEvent := &orderpb.OrderCreatedIntegrationEvent{
+		EventId: domainEvent.GetID().String(),
+		OrderId: domainEvent.GetOrderID().String(),
+	}
+	return *NewIntegrationEvent(integrationEvent, domainEvent.GetOrderID().String())
+}
+
+func TestKafkaProducer_Publish_SendsBinaryProtobufWithContentType(t *testing.T) {
+	// Arrange
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	var sent *sarama.ProducerMessage
+	syncProducer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(message *sarama.ProducerMessage) error {
+		sent = message
+		return nil
+	})
+	producer := &KafkaProducer[*event.OrderCreated, *orderpb.OrderCreatedIntegrationEvent]{
+		topic:       "order.status.changed",
+		producer:    syncProducer,
+		eventMapper: orderCreatedTestMapper{},
+	}
+	domainEvent := event.NewOrderCreated(uuid.New())
+
+	// Act
+	err := producer.Publish(context.Background(), domainEvent)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "order.status.changed", sent.Topic)
+
+	key, _ := sent.Key.Encode()
+	assert.Equal(t, domainEvent.GetOrderID().String(), string(key))
+
+	value, _ := sent.Value.Encode()
+	var decoded orderpb.OrderCreatedIntegrationEvent
+	assert.NoError(t, proto.Unmarshal(value, &decoded))
+	assert.Equal(t, domainEvent.GetID().String(), decoded.GetEventId())
+	assert.Equal(t, domainEvent.GetOrderID().String(), decoded.GetOrderId())
+
+	assert.Contains(t, sent.Headers, sarama.RecordHeader{Key: []byte(HeaderContentType), Value: []byte(ContentTypeProtobuf)})
+	assert.Contains(t, sent.Headers, sarama.RecordHeader{Key: []byte(HeaderEventType), Value: []byte("order_event.OrderCreatedIntegrationEvent")})
+	assert.NoError(t, producer.Close())
+}
@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	"delivery/internal/adapters/out/kafka/common"
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/generated/queues/courierpb"
+)
+
+type CourierLocationChangedMapper struct {
+}
+
+func NewCourierLocationChangedMapper() *CourierLocationChangedMapper {
+	return &CourierLocationChangedMapper{}
+}
+
+// Map - ключ сообщения - курьер, чтобы его перемещения попадали в одну партицию по порядку
+func (m *CourierLocationChangedMapper) Map(domainEvent *event.CourierMoved) common.IntegrationEvent[*courierpb.CourierLocationChangedIntegrationEvent] {
+	event := &courierpb.CourierLocationChangedIntegrationEvent{
+		EventId:    domainEvent.GetID().String(),
+		OccurredAt: occurredAt(domainEvent.OccurredAt),
+		CourierId:  domainEvent.GetCourierID().String(),
+		Location: &courierpb.Location{
+			X: domainEvent.X,
+			Y: domainEvent.Y,
+		},
+	}
+	event.EventType = common.EventType(event)
+
+	return *common.NewIntegrationEvent[*courierpb.CourierLocationChangedIntegrationEvent](event, domainEvent.GetCourierID().String())
+}
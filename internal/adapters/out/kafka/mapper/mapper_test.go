@@ -0,0 +1,111 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/generated/queues/courierpb"
+	"delivery/internal/generated/queues/orderpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOrderCreatedMapper_Map_FillsMetadataAndSurvivesRoundTrip(t *testing.T) {
+	// Arrange
+	domainEvent := event.NewOrderCreated(uuid.New())
+
+	// Act
+	integrationEvent := NewOrderCreatedMapper().Map(domainEvent)
+	bytes, err := proto.Marshal(integrationEvent.Event())
+	assert.NoError(t, err)
+
+	var decoded orderpb.OrderCreatedIntegrationEvent
+	err = proto.Unmarshal(bytes, &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domainEvent.GetID().String(), decoded.GetEventId())
+	assert.Equal(t, "order_event.OrderCreatedIntegrationEvent", decoded.GetEventType())
+	assert.True(t, domainEvent.OccurredAt.Equal(decoded.GetOccurredAt().AsTime()))
+	assert.Equal(t, domainEvent.GetOrderID().String(), decoded.GetOrderId())
+}
+
+func TestOrderCompletedMapper_Map_FillsCourierAndSurvivesRoundTrip(t *testing.T) {
+	// Arrange
+	domainEvent := event.NewOrderCompleted(uuid.New(), uuid.New())
+
+	// Act
+	integrationEvent := NewOrderCompletedMapper().Map(domainEvent)
+	bytes, err := proto.Marshal(integrationEvent.Event())
+	assert.NoError(t, err)
+
+	var decoded orderpb.OrderCompletedIntegrationEvent
+	err = proto.Unmarshal(bytes, &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domainEvent.GetID().String(), decoded.GetEventId())
+	assert.Equal(t, "order_event.OrderCompletedIntegrationEvent", decoded.GetEventType())
+	assert.True(t, domainEvent.OccurredAt.Equal(decoded.GetOccurredAt().AsTime()))
+	assert.Equal(t, domainEvent.GetOrderID().String(), decoded.GetOrderId())
+	assert.Equal(t, domainEvent.GetCourierID().String(), decoded.GetCourierId())
+}
+
+func TestOrderCompletedMapper_Map_LegacyEventWithoutTimeAndCourier(t *testing.T) {
+	// Arrange
+	domainEvent := &event.OrderCompleted{ID: uuid.New(), Name: event.EventNameOrderCompleted, OrderID: uuid.New()}
+
+	// Act
+	integrationEvent := NewOrderCompletedMapper().Map(domainEvent)
+
+	// Assert
+	assert.Nil(t, integrationEvent.Event().GetOccurredAt())
+	assert.Empty(t, integrationEvent.Event().GetCourierId())
+}
+
+func TestOrderAssignedMapper_Map_FillsCourierAndSurvivesRoundTrip(t *testing.T) {
+	// Arrange
+	domainEvent := event.NewOrderAssigned(uuid.New(), uuid.New())
+
+	// Act
+	integrationEvent := NewOrderAssignedMapper().Map(domainEvent)
+	bytes, err := proto.Marshal(integrationEvent.Event())
+	assert.NoError(t, err)
+
+	var decoded orderpb.OrderAssignedIntegrationEvent
+	err = proto.Unmarshal(bytes, &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domainEvent.GetID().String(), decoded.GetEventId())
+	assert.Equal(t, "order_event.OrderAssignedIntegrationEvent", decoded.GetEventType())
+	assert.True(t, domainEvent.OccurredAt.Equal(decoded.GetOccurredAt().AsTime()))
+	assert.Equal(t, domainEvent.GetOrderID().String(), decoded.GetOrderId())
+	assert.Equal(t, domainEvent.GetCourierID().String(), decoded.GetCourierId())
+}
+
+func TestCourierLocationChangedMapper_Map_KeyedByCourierAndSurvivesRoundTrip(t *testing.T) {
+	// Arrange
+	domainEvent := event.NewCourierMoved(uuid.New(), 3, 7, time.Now().UTC())
+
+	// Act
+	integrationEvent := NewCourierLocationChangedMapper().Map(domainEvent)
+	bytes, err := proto.Marshal(integrationEvent.Event())
+	assert.NoError(t, err)
+
+	var decoded courierpb.CourierLocationChangedIntegrationEvent
+	err = proto.Unmarshal(bytes, &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domainEvent.GetCourierID().String(), integrationEvent.Key())
+	assert.Equal(t, domainEvent.GetID().String(), decoded.GetEventId())
+	assert.Equal(t, "courier_event.CourierLocationChangedIntegrationEvent", decoded.GetEventType())
+	assert.True(t, domainEvent.OccurredAt.Equal(decoded.GetOccurredAt().AsTime()))
+	assert.Equal(t, domainEvent.GetCourierID().String(), decoded.GetCourierId())
+	assert.Equal(t, int64(3), decoded.GetLocation().GetX())
+	assert.Equal(t, int64(7), decoded.GetLocation().GetY())
+}
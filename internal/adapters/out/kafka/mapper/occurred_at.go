@@ -0,0 +1,15 @@
+package mapper
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// occurredAt - у событий, сохраненных в outbox до появления времени, его нет, такие события уходят без occurred_at
+func occurredAt(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"delivery/internal/adapters/out/kafka/common"
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/generated/queues/orderpb"
+)
+
+type OrderAssignedMapper struct {
+}
+
+func NewOrderAssignedMapper() *OrderAssignedMapper {
+	return &OrderAssignedMapper{}
+}
+
+func (m *OrderAssignedMapper) Map(domainEvent *event.OrderAssigned) common.IntegrationEvent[*orderpb.OrderAssignedIntegrationEvent] {
+	event := &orderpb.OrderAssignedIntegrationEvent{
+		EventId:    domainEvent.GetID().String(),
+		OccurredAt: occurredAt(domainEvent.OccurredAt),
+		OrderId:    domainEvent.GetOrderID().String(),
+		CourierId:  domainEvent.GetCourierID().String(),
+	}
+	event.EventType = common.EventType(event)
+
+	return *common.NewIntegrationEvent[*orderpb.OrderAssignedIntegrationEvent](event, domainEvent.GetID().String())
+}
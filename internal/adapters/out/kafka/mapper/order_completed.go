@@ -4,6 +4,8 @@ import (
 	"delivery/internal/adapters/out/kafka/common"
 	"delivery/internal/core/domain/model/event"
 	"delivery/internal/generated/queues/orderpb"
+
+	"github.com/google/uuid"
 )
 
 type OrderCompletedMapper struct {
@@ -15,7 +17,14 @@ func NewOrderCompletedMapper() *OrderCompletedMapper {
 
 func (m *OrderCompletedMapper) Map(domainEvent *event.OrderCompleted) common.IntegrationEvent[*orderpb.OrderCompletedIntegrationEvent] {
 	event := &orderpb.OrderCompletedIntegrationEvent{
-		OrderId: domainEvent.GetOrderID().String(),
+		EventId:    domainEvent.GetID().String(),
+		OccurredAt: occurredAt(domainEvent.OccurredAt),
+		OrderId:    domainEvent.GetOrderID().String(),
+	}
+	event.EventType = common.EventType(event)
+
+	if domainEvent.GetCourierID() != uuid.Nil {
+		event.CourierId = domainEvent.GetCourierID().String()
 	}
 
 	return *common.NewIntegrationEvent[*orderpb.OrderCompletedIntegrationEvent](event, domainEvent.GetID().String())
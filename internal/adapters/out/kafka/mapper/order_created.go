@@ -15,8 +15,11 @@ func NewOrderCreatedMapper() *OrderCreatedMapper {
 
 func (m *OrderCreatedMapper) Map(domainEvent *event.OrderCreated) common.IntegrationEvent[*orderpb.OrderCreatedIntegrationEvent] {
 	event := &orderpb.OrderCreatedIntegrationEvent{
-		OrderId: domainEvent.GetOrderID().String(),
+		EventId:    domainEvent.GetID().String(),
+		OccurredAt: occurredAt(domainEvent.OccurredAt),
+		OrderId:    domainEvent.GetOrderID().String(),
 	}
+	event.EventType = common.EventType(event)
 
 	return *common.NewIntegrationEvent[*orderpb.OrderCreatedIntegrationEvent](event, domainEvent.GetID().String())
 }
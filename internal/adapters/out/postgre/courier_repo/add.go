@@ -11,15 +11,23 @@ import (
 func (r *Repository) Add(ctx context.Context, courier *modelCourier.Courier) error {
 	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
 
-	courierDTO, storagePlacesDTO := DomainToDTO(courier)
+	courierDTO, storagePlacesDTO, err := DomainToDTO(courier)
+	if err != nil {
+		return err
+	}
 
 	courierQuery, courierArgs, err := squirrel.Insert("courier").
-		Columns("id", "name", "speed", "location", "version").
+		Columns("id", "name", "speed", "location", "transit_to", "transit_left", "transport_type", "route_plan", "work_status", "version").
 		Values(
 			courierDTO.ID,
 			courierDTO.Name,
 			courierDTO.Speed,
 			squirrel.Expr("POINT(?, ?)", courierDTO.Location.X, courierDTO.Location.Y),
+			courierDTO.TransitToValue(),
+			courierDTO.TransitLeft,
+			courierDTO.TransportType,
+			courierDTO.RoutePlan,
+			courierDTO.WorkStatus,
 			courierDTO.Version,
 		).
 		PlaceholderFormat(squirrel.Dollar).
@@ -49,5 +57,5 @@ func (r *Repository) Add(ctx context.Context, courier *modelCourier.Courier) err
 		}
 	}
 
-	return nil
+	return r.publishDomainEvents(ctx, courier)
 }
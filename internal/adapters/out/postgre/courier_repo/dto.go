@@ -6,15 +6,37 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/Masterminds/squirrel"
+
 	"github.com/google/uuid"
 )
 
 type CourierDTO struct {
-	ID       uuid.UUID   `db:"id"`
-	Name     string      `db:"name"`
-	Speed    int64       `db:"speed"`
-	Location LocationDTO `db:"location"`
-	Version  int64       `db:"version"`
+	ID            uuid.UUID    `db:"id"`
+	Name          string       `db:"name"`
+	Speed         int64        `db:"speed"`
+	Location      LocationDTO  `db:"location"`
+	TransitTo     *LocationDTO `db:"transit_to"`
+	TransitLeft   int64        `db:"transit_left"`
+	TransportType string       `db:"transport_type"`
+	RoutePlan     string       `db:"route_plan"`
+	WorkStatus    string       `db:"work_status"`
+	Version       int64        `db:"version"`
+}
+
+// TransitToValue - значение для колонки transit_to: NULL, если курьер не в пути
+func (c *CourierDTO) TransitToValue() any {
+	if c.TransitTo == nil {
+		return nil
+	}
+	return squirrel.Expr("POINT(?, ?)", c.TransitTo.X, c.TransitTo.Y)
+}
+
+type RouteStopDTO struct {
+	OrderID uuid.UUID `json:"order_id"`
+	X       int64     `json:"x"`
+	Y       int64     `json:"y"`
+	Pickup  bool      `json:"pickup,omitempty"`
 }
 
 type LocationDTO struct {
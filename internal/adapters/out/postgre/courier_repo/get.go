@@ -15,7 +15,7 @@ import (
 func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*modelCourier.Courier, error) {
 	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
 
-	courierQuery, courierArgs, err := squirrel.Select("id", "name", "speed", "location", "version").
+	courierQuery, courierArgs, err := squirrel.Select("id", "name", "speed", "location", "transit_to", "transit_left", "transport_type", "route_plan", "work_status", "version").
 		From("courier").
 		Where(squirrel.Eq{"id": id}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -33,10 +33,12 @@ func (r *Repository) GetAllFreeCouriers(ctx context.Context) ([]*modelCourier.Co
 }
 
 func (r *Repository) getFreeCouriersDTO(ctx context.Context, tx trmsqlx.Tr) ([]CourierDTO, error) {
-	query, args, err := squirrel.Select("c.id", "c.name", "c.speed", "c.location", "c.version").
+	query, args, err := squirrel.Select("c.id", "c.name", "c.speed", "c.location", "c.transit_to", "c.transit_left", "c.transport_type", "c.route_plan", "c.work_status", "c.version").
 		From("courier c").
-		Join("storage_place sp ON c.id = sp.courier_id").
-		Where("sp.order_id IS NULL").
+		// EXISTS, а не JOIN: курьер с несколькими свободными местами хранения должен вернуться один раз
+		Where("EXISTS (SELECT 1 FROM storage_place sp WHERE sp.courier_id = c.id AND sp.order_id IS NULL)").
+		// Курьеры не на смене и на перерыве заказы не получают
+		Where(squirrel.Eq{"c.work_status": modelCourier.WorkStatusOnline.String()}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -1,11 +1,30 @@
 package courier_repo
 
 import (
+	"encoding/json"
+
 	modelCourier "delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/shared_kernel"
 )
 
-func DomainToDTO(courier *modelCourier.Courier) (*CourierDTO, []StoragePlaceDTO) {
+func DomainToDTO(courier *modelCourier.Courier) (*CourierDTO, []StoragePlaceDTO, error) {
+	routePlan := courier.RoutePlan()
+	routePlanDTO := make([]RouteStopDTO, 0, len(routePlan))
+	for _, stop := range routePlan {
+		routePlanDTO = append(routePlanDTO, RouteStopDTO{
+			OrderID: stop.OrderID(),
+			X:       stop.Location().X(),
+			Y:       stop.Location().Y(),
+			Pickup:  stop.IsPickup(),
+		})
+	}
+
+	// маршрут передаем строкой: []byte драйвер pq отправляет как bytea, а колонка jsonb
+	routePlanPayload, err := json.Marshal(routePlanDTO)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	courierDTO := &CourierDTO{
 		ID:    courier.ID(),
 		Name:  courier.Name(),
@@ -14,7 +33,15 @@ func DomainToDTO(courier *modelCourier.Courier) (*CourierDTO, []StoragePlaceDTO)
 			X: courier.Location().X(),
 			Y: courier.Location().Y(),
 		},
-		Version: courier.Version(),
+		TransportType: courier.TransportType().String(),
+		RoutePlan:     string(routePlanPayload),
+		WorkStatus:    courier.WorkStatus().String(),
+		Version:       courier.Version(),
+	}
+
+	if transit := courier.Transit(); transit.IsSet() {
+		courierDTO.TransitTo = &LocationDTO{X: transit.To().X(), Y: transit.To().Y()}
+		courierDTO.TransitLeft = transit.Left()
 	}
 
 	storagePlaces := make([]StoragePlaceDTO, 0, len(courier.StoragePlaces()))
@@ -29,13 +56,18 @@ func DomainToDTO(courier *modelCourier.Courier) (*CourierDTO, []StoragePlaceDTO)
 		})
 	}
 
-	return courierDTO, storagePlaces
+	return courierDTO, storagePlaces, nil
 }
 
 func DTOToDomain(courierDTO *CourierDTO, storagePlacesDTO []StoragePlaceDTO) (*modelCourier.Courier, error) {
-	location, err := shared_kernel.NewLocation(courierDTO.Location.X, courierDTO.Location.Y)
-	if err != nil {
-		return nil, err
+	location := shared_kernel.LoadLocationFromRepo(courierDTO.Location.X, courierDTO.Location.Y)
+
+	var transit modelCourier.Transit
+	if courierDTO.TransitTo != nil {
+		transit = modelCourier.LoadTransitFromRepo(
+			shared_kernel.LoadLocationFromRepo(courierDTO.TransitTo.X, courierDTO.TransitTo.Y),
+			courierDTO.TransitLeft,
+		)
 	}
 
 	storagePlaces := make([]*modelCourier.StoragePlace, 0, len(storagePlacesDTO))
@@ -45,12 +77,33 @@ func DTOToDomain(courierDTO *CourierDTO, storagePlacesDTO []StoragePlaceDTO) (*m
 		storagePlaces = append(storagePlaces, sp)
 	}
 
+	var routePlanDTO []RouteStopDTO
+	if courierDTO.RoutePlan != "" {
+		if err := json.Unmarshal([]byte(courierDTO.RoutePlan), &routePlanDTO); err != nil {
+			return nil, err
+		}
+	}
+
+	routePlan := make([]modelCourier.RouteStop, 0, len(routePlanDTO))
+	for _, stopDTO := range routePlanDTO {
+		stopLocation := shared_kernel.LoadLocationFromRepo(stopDTO.X, stopDTO.Y)
+		if stopDTO.Pickup {
+			routePlan = append(routePlan, modelCourier.NewPickupRouteStop(stopDTO.OrderID, stopLocation))
+			continue
+		}
+		routePlan = append(routePlan, modelCourier.NewRouteStop(stopDTO.OrderID, stopLocation))
+	}
+
 	return modelCourier.LoadCourierFromRepo(
 		courierDTO.ID,
 		courierDTO.Name,
 		courierDTO.Speed,
 		location,
+		transit,
+		modelCourier.TransportType(courierDTO.TransportType),
 		storagePlaces,
+		routePlan,
+		modelCourier.WorkStatus(courierDTO.WorkStatus),
 		courierDTO.Version,
 	), nil
 }
@@ -0,0 +1,21 @@
+package courier_repo
+
+import (
+	"context"
+
+	modelCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
+)
+
+// publishDomainEvents - сохраняет доменные события курьера в outbox в той же транзакции, что и курьера
+func (r *Repository) publishDomainEvents(ctx context.Context, courier *modelCourier.Courier) error {
+	return ddd.SaveDomainEvents(ctx, courier, func(e ddd.DomainEvent) error {
+		message, err := outbox.EncodeDomainEvent(e)
+		if err != nil {
+			return err
+		}
+
+		return r.outboxRepo.Add(ctx, &message)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/outbox"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
@@ -15,14 +16,20 @@ type txGetter interface {
 	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
 }
 
+type outboxRepo interface {
+	Add(ctx context.Context, message *outbox.Message) error
+}
+
 type Repository struct {
-	db       *sqlx.DB
-	txGetter txGetter
+	db         *sqlx.DB
+	txGetter   txGetter
+	outboxRepo outboxRepo
 }
 
-func NewRepository(db *sqlx.DB, txGetter txGetter) *Repository {
+func NewRepository(db *sqlx.DB, txGetter txGetter, outboxRepo outboxRepo) *Repository {
 	return &Repository{
-		db:       db,
-		txGetter: txGetter,
+		db:         db,
+		txGetter:   txGetter,
+		outboxRepo: outboxRepo,
 	}
 }
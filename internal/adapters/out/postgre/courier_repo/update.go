@@ -16,7 +16,10 @@ import (
 func (r *Repository) Update(ctx context.Context, courier *modelCourier.Courier) error {
 	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
 
-	courierDTO, storagePlacesDTO := DomainToDTO(courier)
+	courierDTO, storagePlacesDTO, err := DomainToDTO(courier)
+	if err != nil {
+		return err
+	}
 
 	courierExists, err := r.courierExists(ctx, tx, courierDTO.ID)
 	if err != nil {
@@ -37,7 +40,7 @@ func (r *Repository) Update(ctx context.Context, courier *modelCourier.Courier)
 		return err
 	}
 
-	return nil
+	return r.publishDomainEvents(ctx, courier)
 }
 
 func (r *Repository) updateCourierWithOptimisticLock(ctx context.Context, tx trmsqlx.Tr, courierDTO *CourierDTO) error {
@@ -47,6 +50,11 @@ func (r *Repository) updateCourierWithOptimisticLock(ctx context.Context, tx trm
 		Set("name", courierDTO.Name).
 		Set("speed", courierDTO.Speed).
 		Set("location", squirrel.Expr("POINT(?, ?)", courierDTO.Location.X, courierDTO.Location.Y)).
+		Set("transit_to", courierDTO.TransitToValue()).
+		Set("transit_left", courierDTO.TransitLeft).
+		Set("transport_type", courierDTO.TransportType).
+		Set("route_plan", courierDTO.RoutePlan).
+		Set("work_status", courierDTO.WorkStatus).
 		Set("version", courierDTO.Version+1).
 		PlaceholderFormat(squirrel.Dollar).
 		Suffix("RETURNING id").
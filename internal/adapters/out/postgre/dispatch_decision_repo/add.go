@@ -0,0 +1,41 @@
+package dispatch_decision_repo
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/dispatch"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *Repository) Add(ctx context.Context, decision *dispatch.Decision) error {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	decisionDTO, err := DomainToDTO(decision)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := squirrel.Insert(tableName).
+		Columns("id", "order_id", "strategy", "courier_id", "candidates", "decided_at_utc").
+		Values(
+			decisionDTO.ID,
+			decisionDTO.OrderID,
+			decisionDTO.Strategy,
+			decisionDTO.CourierID,
+			decisionDTO.Candidates,
+			decisionDTO.DecidedAtUtc,
+		).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package dispatch_decision_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const tableName = "dispatch_decision"
+
+type DecisionDTO struct {
+	ID           uuid.UUID  `db:"id"`
+	OrderID      uuid.UUID  `db:"order_id"`
+	Strategy     string     `db:"strategy"`
+	CourierID    *uuid.UUID `db:"courier_id"`
+	Candidates   string     `db:"candidates"`
+	DecidedAtUtc time.Time  `db:"decided_at_utc"`
+}
+
+type CandidateDTO struct {
+	CourierID       uuid.UUID `json:"courier_id"`
+	TimeToLocation  float64   `json:"time_to_location"`
+	RejectionReason string    `json:"rejection_reason,omitempty"`
+}
@@ -0,0 +1,47 @@
+package dispatch_decision_repo
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/dispatch"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (r *Repository) GetLatestByOrderIDs(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]*dispatch.Decision, error) {
+	decisions := make(map[uuid.UUID]*dispatch.Decision, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return decisions, nil
+	}
+
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	query, args, err := squirrel.Select("id", "order_id", "strategy", "courier_id", "candidates", "decided_at_utc").
+		Options("DISTINCT ON (order_id)").
+		From(tableName).
+		Where(squirrel.Eq{"order_id": orderIDs}).
+		OrderBy("order_id", "decided_at_utc DESC").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var decisionsDTO []DecisionDTO
+	err = tx.SelectContext(ctx, &decisionsDTO, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, decisionDTO := range decisionsDTO {
+		decision, err := DTOToDomain(&decisionDTO)
+		if err != nil {
+			return nil, err
+		}
+
+		decisions[decision.OrderID()] = decision
+	}
+
+	return decisions, nil
+}
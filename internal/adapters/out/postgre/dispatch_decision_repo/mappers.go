@@ -0,0 +1,59 @@
+package dispatch_decision_repo
+
+import (
+	"encoding/json"
+
+	"delivery/internal/core/domain/model/dispatch"
+)
+
+func DomainToDTO(decision *dispatch.Decision) (*DecisionDTO, error) {
+	candidates := decision.Candidates()
+	candidatesDTO := make([]CandidateDTO, 0, len(candidates))
+	for _, candidate := range candidates {
+		candidatesDTO = append(candidatesDTO, CandidateDTO{
+			CourierID:       candidate.CourierID(),
+			TimeToLocation:  candidate.TimeToLocation(),
+			RejectionReason: string(candidate.RejectionReason()),
+		})
+	}
+
+	// кандидатов передаем строкой: []byte драйвер pq отправляет как bytea, а колонка jsonb
+	payload, err := json.Marshal(candidatesDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DecisionDTO{
+		ID:           decision.ID(),
+		OrderID:      decision.OrderID(),
+		Strategy:     decision.Strategy(),
+		CourierID:    decision.CourierID(),
+		Candidates:   string(payload),
+		DecidedAtUtc: decision.DecidedAt(),
+	}, nil
+}
+
+func DTOToDomain(decisionDTO *DecisionDTO) (*dispatch.Decision, error) {
+	var candidatesDTO []CandidateDTO
+	if err := json.Unmarshal([]byte(decisionDTO.Candidates), &candidatesDTO); err != nil {
+		return nil, err
+	}
+
+	candidates := make([]dispatch.Candidate, 0, len(candidatesDTO))
+	for _, candidateDTO := range candidatesDTO {
+		candidates = append(candidates, dispatch.NewCandidate(
+			candidateDTO.CourierID,
+			candidateDTO.TimeToLocation,
+			dispatch.RejectionReason(candidateDTO.RejectionReason),
+		))
+	}
+
+	return dispatch.LoadDecisionFromRepo(
+		decisionDTO.ID,
+		decisionDTO.OrderID,
+		decisionDTO.Strategy,
+		decisionDTO.CourierID,
+		candidates,
+		decisionDTO.DecidedAtUtc.UTC(),
+	), nil
+}
@@ -0,0 +1,29 @@
+package inbox_repo
+
+import (
+	"context"
+
+	"delivery/internal/pkg/inbox"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *Repository) Add(ctx context.Context, message *inbox.Message) error {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	query, args, err := squirrel.Insert(inbox.Message{}.TableName()).
+		Columns("event_id", "event_type", "processed_at_utc").
+		Values(message.EventID, message.EventType, message.ProcessedAtUtc).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package inbox_repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"delivery/internal/pkg/inbox"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *Repository) Exists(ctx context.Context, eventID string) (bool, error) {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	query, args, err := squirrel.Select("1").
+		From(inbox.Message{}.TableName()).
+		Where(squirrel.Eq{"event_id": eventID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var exists int
+	err = tx.GetContext(ctx, &exists, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,77 @@
+package location_bounds
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/shared_kernel"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// locationTables - таблицы, в которых хранятся координаты в колонке location
+var locationTables = []string{"courier", `"order"`}
+
+// Repository - проверяет, что сохраненные координаты укладываются в границы карты,
+// и при необходимости переносит их на ближайшую допустимую точку
+type Repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) CountOutOfBounds(ctx context.Context, bounds shared_kernel.MapBounds) (int64, error) {
+	var total int64
+
+	for _, table := range locationTables {
+		var count int64
+		err := r.db.GetContext(ctx, &count,
+			`SELECT count(*) FROM `+table+`
+			 WHERE location[0] < $1 OR location[0] > $2 OR location[1] < $3 OR location[1] > $4`,
+			bounds.MinX(), bounds.MaxX(), bounds.MinY(), bounds.MaxY(),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		total += count
+	}
+
+	return total, nil
+}
+
+func (r *Repository) ClampToBounds(ctx context.Context, bounds shared_kernel.MapBounds) (int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var total int64
+
+	for _, table := range locationTables {
+		result, err := tx.ExecContext(ctx,
+			`UPDATE `+table+`
+			 SET location = POINT(LEAST(GREATEST(location[0], $1), $2), LEAST(GREATEST(location[1], $3), $4))
+			 WHERE location[0] < $1 OR location[0] > $2 OR location[1] < $3 OR location[1] > $4`,
+			bounds.MinX(), bounds.MaxX(), bounds.MinY(), bounds.MaxY(),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+
+		total += affected
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
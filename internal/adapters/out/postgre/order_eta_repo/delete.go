@@ -0,0 +1,27 @@
+package order_eta_repo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func (r *Repository) Delete(ctx context.Context, orderID uuid.UUID) error {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	query, args, err := squirrel.Delete(tableName).
+		Where(squirrel.Eq{"order_id": orderID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
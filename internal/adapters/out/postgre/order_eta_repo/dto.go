@@ -0,0 +1,18 @@
+package order_eta_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const tableName = "order_eta"
+
+type EstimateDTO struct {
+	OrderID         uuid.UUID `db:"order_id"`
+	CourierID       uuid.UUID `db:"courier_id"`
+	Moves           int64     `db:"moves"`
+	DurationSeconds int64     `db:"duration_seconds"`
+	ArrivalAtUtc    time.Time `db:"arrival_at_utc"`
+	CalculatedAtUtc time.Time `db:"calculated_at_utc"`
+}
@@ -0,0 +1,16 @@
+package order_eta_repo
+
+import (
+	"delivery/internal/core/domain/model/eta"
+)
+
+func DomainToDTO(estimate *eta.Estimate) *EstimateDTO {
+	return &EstimateDTO{
+		OrderID:         estimate.OrderID(),
+		CourierID:       estimate.CourierID(),
+		Moves:           estimate.Moves(),
+		DurationSeconds: int64(estimate.Duration().Seconds()),
+		ArrivalAtUtc:    estimate.ArrivalAt(),
+		CalculatedAtUtc: estimate.CalculatedAt(),
+	}
+}
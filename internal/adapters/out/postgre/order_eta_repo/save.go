@@ -0,0 +1,45 @@
+package order_eta_repo
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/eta"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Save - сохраняет оценку, заменяя предыдущую оценку заказа
+func (r *Repository) Save(ctx context.Context, estimate *eta.Estimate) error {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	estimateDTO := DomainToDTO(estimate)
+
+	query, args, err := squirrel.Insert(tableName).
+		Columns("order_id", "courier_id", "moves", "duration_seconds", "arrival_at_utc", "calculated_at_utc").
+		Values(
+			estimateDTO.OrderID,
+			estimateDTO.CourierID,
+			estimateDTO.Moves,
+			estimateDTO.DurationSeconds,
+			estimateDTO.ArrivalAtUtc,
+			estimateDTO.CalculatedAtUtc,
+		).
+		Suffix(`ON CONFLICT (order_id) DO UPDATE SET
+			courier_id = EXCLUDED.courier_id,
+			moves = EXCLUDED.moves,
+			duration_seconds = EXCLUDED.duration_seconds,
+			arrival_at_utc = EXCLUDED.arrival_at_utc,
+			calculated_at_utc = EXCLUDED.calculated_at_utc`).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
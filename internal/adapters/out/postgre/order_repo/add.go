@@ -4,6 +4,7 @@ import (
 	"context"
 
 	modelOrder "delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
 
 	"github.com/Masterminds/squirrel"
 )
@@ -14,25 +15,43 @@ func (r *Repository) Add(ctx context.Context, order *modelOrder.Order) error {
 	orderDTO := DomainToDTO(order)
 
 	query, args, err := squirrel.Insert(`"order"`).
-		Columns("id", "courier_id", "location", "volume", "status", "version").
+		Columns("id", "courier_id", "location", "pickup_location", "volume", "status", "version", "delivery_period_from", "delivery_period_to", "delivery_at_risk").
 		Values(
 			orderDTO.ID,
 			orderDTO.CourierID,
 			squirrel.Expr("POINT(?, ?)", orderDTO.Location.X, orderDTO.Location.Y),
+			pickupLocationValue(orderDTO),
 			orderDTO.Volume,
 			orderDTO.Status,
 			orderDTO.Version,
+			orderDTO.DeliveryPeriodFrom,
+			orderDTO.DeliveryPeriodTo,
+			orderDTO.DeliveryAtRisk,
 		).
+		Suffix("ON CONFLICT (id) DO NOTHING").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.ExecContext(ctx, query, args...)
+	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
 
-	return r.publishDomainEvents(ctx, tx, order)
+	// Идентификатор заказа задает клиент, поэтому вставка может столкнуться с уже существующим заказом
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.NewObjectAlreadyExistsError("order", order.ID())
+	}
+
+	if err := r.saveStatusHistory(ctx, order); err != nil {
+		return err
+	}
+
+	return r.publishDomainEvents(ctx, order)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,9 +14,15 @@ type OrderDTO struct {
 	ID        uuid.UUID   `db:"id"`
 	CourierID *uuid.UUID  `db:"courier_id"`
 	Location  LocationDTO `db:"location"`
-	Volume    int64       `db:"volume"`
-	Status    string      `db:"status"`
-	Version   int64       `db:"version"`
+	// PickupLocation - NULL у заказов без точки забора
+	PickupLocation *LocationDTO `db:"pickup_location"`
+	Volume         int64        `db:"volume"`
+	Status         string       `db:"status"`
+	Version        int64        `db:"version"`
+
+	DeliveryPeriodFrom *time.Time `db:"delivery_period_from"`
+	DeliveryPeriodTo   *time.Time `db:"delivery_period_to"`
+	DeliveryAtRisk     bool       `db:"delivery_at_risk"`
 }
 
 type LocationDTO struct {
@@ -60,3 +67,14 @@ func (l *LocationDTO) Scan(src interface{}) error {
 
 	return nil
 }
+
+const statusHistoryTableName = "order_status_history"
+
+type StatusChangeDTO struct {
+	OrderID      uuid.UUID  `db:"order_id"`
+	FromStatus   string     `db:"from_status"`
+	ToStatus     string     `db:"to_status"`
+	CourierID    *uuid.UUID `db:"courier_id"`
+	Command      string     `db:"command"`
+	ChangedAtUtc time.Time  `db:"changed_at_utc"`
+}
@@ -0,0 +1,42 @@
+package order_repo
+
+import (
+	"context"
+
+	modelOrder "delivery/internal/core/domain/model/order"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *Repository) GetAllInCreatedStatus(ctx context.Context, limit uint64) ([]*modelOrder.Order, error) {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	query, args, err := squirrel.Select("id", "courier_id", "location", "pickup_location", "volume", "status", "version", "delivery_period_from", "delivery_period_to", "delivery_at_risk").
+		From(`"order"`).
+		Where(squirrel.Eq{"status": modelOrder.StatusCreated.String()}).
+		OrderBy("created_at").
+		Limit(limit).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var ordersDTO []OrderDTO
+	err = tx.SelectContext(ctx, &ordersDTO, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*modelOrder.Order
+	for _, orderDTO := range ordersDTO {
+		order, err := DTOToDomain(&orderDTO)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, order)
+	}
+
+	return result, nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func (r *Repository) GetFirstInCreatedStatus(ctx context.Context) (*modelOrder.Order, error) {
-	query, args, err := squirrel.Select("id", "courier_id", "location", "volume", "status", "version").
+	query, args, err := squirrel.Select("id", "courier_id", "location", "pickup_location", "volume", "status", "version", "delivery_period_from", "delivery_period_to", "delivery_at_risk").
 		From(`"order"`).
 		Where(squirrel.Eq{"status": modelOrder.StatusCreated}).
 		OrderBy("created_at").
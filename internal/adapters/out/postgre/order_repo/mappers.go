@@ -3,36 +3,89 @@ package order_repo
 import (
 	modelOrder "delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/pkg/pointer"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 )
 
 func DomainToDTO(order *modelOrder.Order) *OrderDTO {
-	return &OrderDTO{
+	orderDTO := &OrderDTO{
 		ID:        order.ID(),
 		CourierID: order.CourierID(),
 		Location: LocationDTO{
 			X: order.Location().X(),
 			Y: order.Location().Y(),
 		},
-		Volume:  order.Volume(),
-		Status:  order.Status().String(),
-		Version: order.Version(),
+		Volume:         order.Volume(),
+		Status:         order.Status().String(),
+		Version:        order.Version(),
+		DeliveryAtRisk: order.DeliveryAtRisk(),
+	}
+
+	if order.PickupLocation().IsSet() {
+		orderDTO.PickupLocation = &LocationDTO{
+			X: order.PickupLocation().X(),
+			Y: order.PickupLocation().Y(),
+		}
+	}
+
+	if order.DeliveryPeriod().IsSet() {
+		orderDTO.DeliveryPeriodFrom = pointer.New(order.DeliveryPeriod().From())
+		orderDTO.DeliveryPeriodTo = pointer.New(order.DeliveryPeriod().To())
 	}
+
+	return orderDTO
 }
 
 func DTOToDomain(orderDTO *OrderDTO) (*modelOrder.Order, error) {
-	location, err := shared_kernel.NewLocation(orderDTO.Location.X, orderDTO.Location.Y)
-	if err != nil {
-		return nil, err
+	location := shared_kernel.LoadLocationFromRepo(orderDTO.Location.X, orderDTO.Location.Y)
+
+	var pickupLocation shared_kernel.Location
+	if orderDTO.PickupLocation != nil {
+		pickupLocation = shared_kernel.LoadLocationFromRepo(orderDTO.PickupLocation.X, orderDTO.PickupLocation.Y)
 	}
 
 	status := modelOrder.Status(orderDTO.Status)
 
+	var deliveryPeriod modelOrder.DeliveryPeriod
+	if orderDTO.DeliveryPeriodFrom != nil && orderDTO.DeliveryPeriodTo != nil {
+		var err error
+		deliveryPeriod, err = modelOrder.NewDeliveryPeriod(*orderDTO.DeliveryPeriodFrom, *orderDTO.DeliveryPeriodTo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return modelOrder.LoadOrderFromRepo(
 		orderDTO.ID,
 		orderDTO.CourierID,
 		location,
+		pickupLocation,
 		orderDTO.Volume,
 		status,
 		orderDTO.Version,
+		deliveryPeriod,
+		orderDTO.DeliveryAtRisk,
 	)
 }
+
+// pickupLocationValue - значение для колонки pickup_location: POINT или NULL
+func pickupLocationValue(orderDTO *OrderDTO) any {
+	if orderDTO.PickupLocation == nil {
+		return nil
+	}
+
+	return squirrel.Expr("POINT(?, ?)", orderDTO.PickupLocation.X, orderDTO.PickupLocation.Y)
+}
+
+func StatusChangeToDTO(orderID uuid.UUID, change modelOrder.StatusChange, commandName string) *StatusChangeDTO {
+	return &StatusChangeDTO{
+		OrderID:      orderID,
+		FromStatus:   change.From().String(),
+		ToStatus:     change.To().String(),
+		CourierID:    change.CourierID(),
+		Command:      commandName,
+		ChangedAtUtc: change.ChangedAt(),
+	}
+}
@@ -2,20 +2,22 @@ package order_repo
 
 import (
 	"context"
-	modelOrder "delivery/internal/core/domain/model/order"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	modelOrder "delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
 )
 
-func (r *Repository) publishDomainEvents(ctx context.Context, _ trmsqlx.Tr, order *modelOrder.Order) error {
-	events := order.DomainEvents()
-
-	for _, e := range events {
-		err := r.eventPublisher.Publish(ctx, e)
+// publishDomainEvents - сохраняет доменные события в outbox в той же транзакции, что и заказ.
+// Отправкой сообщений из outbox занимается отдельная фоновая задача.
+// События очищаются в заказе только после фиксации транзакции.
+func (r *Repository) publishDomainEvents(ctx context.Context, order *modelOrder.Order) error {
+	return ddd.SaveDomainEvents(ctx, order, func(e ddd.DomainEvent) error {
+		message, err := outbox.EncodeDomainEvent(e)
 		if err != nil {
 			return err
 		}
-	}
 
-	return nil
+		return r.outboxRepo.Add(ctx, &message)
+	})
 }
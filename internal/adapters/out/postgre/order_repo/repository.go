@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"delivery/internal/core/ports"
-	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
@@ -16,20 +16,20 @@ type txGetter interface {
 	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
 }
 
-type eventPublisher interface {
-	Publish(ctx context.Context, event ddd.DomainEvent) error
+type outboxRepo interface {
+	Add(ctx context.Context, message *outbox.Message) error
 }
 
 type Repository struct {
-	db             *sqlx.DB
-	txGetter       txGetter
-	eventPublisher eventPublisher
+	db         *sqlx.DB
+	txGetter   txGetter
+	outboxRepo outboxRepo
 }
 
-func NewRepository(db *sqlx.DB, txGetter txGetter, eventPublisher eventPublisher) *Repository {
+func NewRepository(db *sqlx.DB, txGetter txGetter, outboxRepo outboxRepo) *Repository {
 	return &Repository{
-		db:             db,
-		txGetter:       txGetter,
-		eventPublisher: eventPublisher,
+		db:         db,
+		txGetter:   txGetter,
+		outboxRepo: outboxRepo,
 	}
 }
@@ -0,0 +1,48 @@
+package order_repo
+
+import (
+	"context"
+
+	modelOrder "delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/audit"
+	"delivery/internal/pkg/ddd"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// saveStatusHistory - записывает переходы статуса заказа в историю в той же транзакции, что и заказ.
+// Команда, вызвавшая переход, берется из контекста: хендлеры команд, меняющих статус заказа,
+// кладут ее туда через audit.WithCommand. Без нее переход попадет в историю с пустой командой.
+// Переходы очищаются в заказе только после фиксации транзакции.
+func (r *Repository) saveStatusHistory(ctx context.Context, order *modelOrder.Order) error {
+	return ddd.SaveChanges(ctx, order, statusHistoryTableName, order.StatusChanges(), func(changes []modelOrder.StatusChange) error {
+		return r.insertStatusChanges(ctx, order, changes)
+	}, order.ClearStatusChanges)
+}
+
+func (r *Repository) insertStatusChanges(ctx context.Context, order *modelOrder.Order, changes []modelOrder.StatusChange) error {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+	commandName := audit.CommandFromContext(ctx)
+
+	insert := squirrel.Insert(statusHistoryTableName).
+		Columns("order_id", "from_status", "to_status", "courier_id", "command", "changed_at_utc")
+	for _, change := range changes {
+		changeDTO := StatusChangeToDTO(order.ID(), change, commandName)
+		insert = insert.Values(
+			changeDTO.OrderID,
+			changeDTO.FromStatus,
+			changeDTO.ToStatus,
+			changeDTO.CourierID,
+			changeDTO.Command,
+			changeDTO.ChangedAtUtc,
+		)
+	}
+
+	query, args, err := insert.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
@@ -32,8 +32,12 @@ func (r *Repository) Update(ctx context.Context, order *modelOrder.Order) error
 		Where(squirrel.Eq{"version": orderDTO.Version}).
 		Set("courier_id", orderDTO.CourierID).
 		Set("location", squirrel.Expr("POINT(?, ?)", orderDTO.Location.X, orderDTO.Location.Y)).
+		Set("pickup_location", pickupLocationValue(orderDTO)).
 		Set("volume", orderDTO.Volume).
 		Set("status", orderDTO.Status).
+		Set("delivery_period_from", orderDTO.DeliveryPeriodFrom).
+		Set("delivery_period_to", orderDTO.DeliveryPeriodTo).
+		Set("delivery_at_risk", orderDTO.DeliveryAtRisk).
 		Set("version", orderDTO.Version+1).
 		PlaceholderFormat(squirrel.Dollar).
 		Suffix("RETURNING id").
@@ -54,7 +58,11 @@ func (r *Repository) Update(ctx context.Context, order *modelOrder.Order) error
 		return err
 	}
 
-	return r.publishDomainEvents(ctx, tx, order)
+	if err := r.saveStatusHistory(ctx, order); err != nil {
+		return err
+	}
+
+	return r.publishDomainEvents(ctx, order)
 }
 
 func (r *Repository) orderExists(ctx context.Context, tx trmsqlx.Tr, id uuid.UUID) (bool, error) {
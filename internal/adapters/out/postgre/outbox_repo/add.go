@@ -0,0 +1,41 @@
+package outbox_repo
+
+import (
+	"context"
+
+	"delivery/internal/pkg/outbox"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *Repository) Add(ctx context.Context, message *outbox.Message) error {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	messageDTO := DomainToDTO(message)
+
+	query, args, err := squirrel.Insert(outbox.Message{}.TableName()).
+		Columns("id", "name", "payload", "occurred_at_utc", "processed_at_utc", "attempts", "last_error", "next_attempt_at_utc", "failed_at_utc").
+		Values(
+			messageDTO.ID,
+			messageDTO.Name,
+			messageDTO.Payload,
+			messageDTO.OccurredAtUtc,
+			messageDTO.ProcessedAtUtc,
+			messageDTO.Attempts,
+			messageDTO.LastError,
+			messageDTO.NextAttemptAtUtc,
+			messageDTO.FailedAtUtc,
+		).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
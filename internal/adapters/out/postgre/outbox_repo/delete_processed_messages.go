@@ -0,0 +1,35 @@
+package outbox_repo
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/pkg/outbox"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// DeleteProcessedMessages - удаляет не больше limit сообщений, обработанных раньше processedBefore
+func (r *Repository) DeleteProcessedMessages(ctx context.Context, processedBefore time.Time, limit uint64) (int64, error) {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	expiredQuery := squirrel.Select("id").
+		From(outbox.Message{}.TableName()).
+		Where(squirrel.Lt{"processed_at_utc": processedBefore}).
+		Limit(limit)
+
+	query, args, err := squirrel.Delete(outbox.Message{}.TableName()).
+		Where(squirrel.Expr("id IN (?)", expiredQuery)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,19 @@
+package outbox_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageDTO struct {
+	ID               uuid.UUID  `db:"id"`
+	Name             string     `db:"name"`
+	Payload          string     `db:"payload"`
+	OccurredAtUtc    time.Time  `db:"occurred_at_utc"`
+	ProcessedAtUtc   *time.Time `db:"processed_at_utc"`
+	Attempts         int        `db:"attempts"`
+	LastError        string     `db:"last_error"`
+	NextAttemptAtUtc *time.Time `db:"next_attempt_at_utc"`
+	FailedAtUtc      *time.Time `db:"failed_at_utc"`
+}
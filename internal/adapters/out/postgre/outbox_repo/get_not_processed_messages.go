@@ -0,0 +1,40 @@
+package outbox_repo
+
+import (
+	"context"
+
+	"delivery/internal/pkg/outbox"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// GetNotProcessedMessages - возвращает необработанные и не отложенные сообщения в порядке их появления.
+// Строки блокируются до конца транзакции, поэтому несколько экземпляров сервиса не отправят одно сообщение дважды.
+func (r *Repository) GetNotProcessedMessages(ctx context.Context, limit uint64) ([]*outbox.Message, error) {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	query, args, err := squirrel.Select("id", "name", "payload", "occurred_at_utc", "processed_at_utc", "attempts", "last_error", "next_attempt_at_utc", "failed_at_utc").
+		From(outbox.Message{}.TableName()).
+		Where(squirrel.Eq{"processed_at_utc": nil, "failed_at_utc": nil}).
+		OrderBy("occurred_at_utc").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var messagesDTO []MessageDTO
+	err = tx.SelectContext(ctx, &messagesDTO, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*outbox.Message, 0, len(messagesDTO))
+	for _, messageDTO := range messagesDTO {
+		result = append(result, DTOToDomain(&messageDTO))
+	}
+
+	return result, nil
+}
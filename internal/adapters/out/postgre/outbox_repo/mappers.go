@@ -0,0 +1,36 @@
+package outbox_repo
+
+import (
+	"delivery/internal/pkg/outbox"
+)
+
+func DomainToDTO(message *outbox.Message) *MessageDTO {
+	// payload передаем строкой: []byte драйвер pq отправляет как bytea, а колонка jsonb
+	return &MessageDTO{
+		ID:             message.ID,
+		Name:           message.Name,
+		Payload:        string(message.Payload),
+		OccurredAtUtc:  message.OccurredAtUtc,
+		ProcessedAtUtc: message.ProcessedAtUtc,
+
+		Attempts:         message.Attempts,
+		LastError:        message.LastError,
+		NextAttemptAtUtc: message.NextAttemptAtUtc,
+		FailedAtUtc:      message.FailedAtUtc,
+	}
+}
+
+func DTOToDomain(messageDTO *MessageDTO) *outbox.Message {
+	return &outbox.Message{
+		ID:             messageDTO.ID,
+		Name:           messageDTO.Name,
+		Payload:        []byte(messageDTO.Payload),
+		OccurredAtUtc:  messageDTO.OccurredAtUtc,
+		ProcessedAtUtc: messageDTO.ProcessedAtUtc,
+
+		Attempts:         messageDTO.Attempts,
+		LastError:        messageDTO.LastError,
+		NextAttemptAtUtc: messageDTO.NextAttemptAtUtc,
+		FailedAtUtc:      messageDTO.FailedAtUtc,
+	}
+}
@@ -0,0 +1,45 @@
+package outbox_repo
+
+import (
+	"context"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (r *Repository) Update(ctx context.Context, message *outbox.Message) error {
+	tx := r.txGetter.DefaultTrOrDB(ctx, r.db)
+
+	messageDTO := DomainToDTO(message)
+
+	query, args, err := squirrel.Update(outbox.Message{}.TableName()).
+		Where(squirrel.Eq{"id": messageDTO.ID}).
+		Set("processed_at_utc", messageDTO.ProcessedAtUtc).
+		Set("attempts", messageDTO.Attempts).
+		Set("last_error", messageDTO.LastError).
+		Set("next_attempt_at_utc", messageDTO.NextAttemptAtUtc).
+		Set("failed_at_utc", messageDTO.FailedAtUtc).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errs.NewObjectNotFoundError("outbox message", messageDTO.ID)
+	}
+
+	return nil
+}
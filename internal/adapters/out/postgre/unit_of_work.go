@@ -2,9 +2,14 @@ package postgre
 
 import (
 	"context"
+	"log"
 
 	"delivery/internal/adapters/out/postgre/courier_repo"
+	"delivery/internal/adapters/out/postgre/dispatch_decision_repo"
+	"delivery/internal/adapters/out/postgre/inbox_repo"
+	"delivery/internal/adapters/out/postgre/order_eta_repo"
 	"delivery/internal/adapters/out/postgre/order_repo"
+	"delivery/internal/adapters/out/postgre/outbox_repo"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/ddd"
 	eventPublisher "delivery/internal/pkg/event_publisher"
@@ -20,16 +25,19 @@ type TxGetter interface {
 	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
 }
 
-type EventPublisher interface {
-	Publish(ctx context.Context, event ddd.DomainEvent) error
-}
-
 type UnitOfWork struct {
-	db             *sqlx.DB
-	trManager      *manager.Manager
-	txGetter       TxGetter
-	orderRepo      ports.OrderRepo
-	courierRepo    ports.CourierRepo
+	db          *sqlx.DB
+	trManager   *manager.Manager
+	txGetter    TxGetter
+	orderRepo   ports.OrderRepo
+	courierRepo ports.CourierRepo
+	outboxRepo  ports.OutboxRepo
+	inboxRepo   ports.InboxRepo
+
+	dispatchDecisionRepo ports.DispatchDecisionRepo
+	orderEtaRepo         ports.OrderEtaRepo
+
+	// eventPublisher - публикует TransientDomainEvent после фиксации транзакции
 	eventPublisher eventPublisher.EventPublisher
 }
 
@@ -37,25 +45,51 @@ func NewUnitOfWork(
 	db *sqlx.DB,
 	trManager *manager.Manager,
 	txGetter TxGetter,
-	eventPublisher EventPublisher,
+	eventPublisher eventPublisher.EventPublisher,
 ) ports.UnitOfWork {
 	uow := &UnitOfWork{}
 
-	orderRepo := order_repo.NewRepository(db, txGetter, eventPublisher)
-	courierRepo := courier_repo.NewRepository(db, txGetter)
+	outboxRepo := outbox_repo.NewRepository(db, txGetter)
+	orderRepo := order_repo.NewRepository(db, txGetter, outboxRepo)
+	courierRepo := courier_repo.NewRepository(db, txGetter, outboxRepo)
 
+	uow.outboxRepo = outboxRepo
+	uow.inboxRepo = inbox_repo.NewRepository(db, txGetter)
+	uow.dispatchDecisionRepo = dispatch_decision_repo.NewRepository(db, txGetter)
+	uow.orderEtaRepo = order_eta_repo.NewRepository(db, txGetter)
 	uow.orderRepo = orderRepo
 	uow.courierRepo = courierRepo
 	uow.txGetter = txGetter
 	uow.trManager = trManager
-	uow.eventPublisher = eventPublisher
 	uow.db = db
+	uow.eventPublisher = eventPublisher
 
 	return uow
 }
 
+// Do - выполняет fn в транзакции. События сохраненных агрегатов очищаются только после фиксации,
+// при откате они остаются в агрегатах. Вложенный Do работает в транзакции внешнего.
+// TransientDomainEvent публикуются после фиксации в этом же процессе, ошибка публикации только логируется.
 func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
-	return u.trManager.Do(ctx, fn)
+	if ddd.HasDomainEventTracker(ctx) {
+		return u.trManager.Do(ctx, fn)
+	}
+
+	txCtx, clearDomainEvents := ddd.WithDomainEventTracker(ctx)
+	if err := u.trManager.Do(txCtx, fn); err != nil {
+		return err
+	}
+
+	for _, e := range clearDomainEvents() {
+		if u.eventPublisher == nil {
+			break
+		}
+		if err := u.eventPublisher.Publish(ctx, e); err != nil {
+			log.Printf("UnitOfWork: failed to publish %s (%s): %v", e.GetName(), e.GetID(), err)
+		}
+	}
+
+	return nil
 }
 
 func (u *UnitOfWork) DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr {
@@ -69,3 +103,19 @@ func (u *UnitOfWork) OrderRepo() ports.OrderRepo {
 func (u *UnitOfWork) CourierRepo() ports.CourierRepo {
 	return u.courierRepo
 }
+
+func (u *UnitOfWork) OutboxRepo() ports.OutboxRepo {
+	return u.outboxRepo
+}
+
+func (u *UnitOfWork) InboxRepo() ports.InboxRepo {
+	return u.inboxRepo
+}
+
+func (u *UnitOfWork) DispatchDecisionRepo() ports.DispatchDecisionRepo {
+	return u.dispatchDecisionRepo
+}
+
+func (u *UnitOfWork) OrderEtaRepo() ports.OrderEtaRepo {
+	return u.orderEtaRepo
+}
@@ -2,6 +2,7 @@ package postgre
 
 import (
 	"delivery/internal/core/ports"
+	eventPublisher "delivery/internal/pkg/event_publisher"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/jmoiron/sqlx"
@@ -10,13 +11,19 @@ import (
 var _ ports.UnitOfWorkFactory = (*UnitOfWorkFactory)(nil)
 
 type UnitOfWorkFactory struct {
-	db             *sqlx.DB
-	trManager      *manager.Manager
-	txGetter       TxGetter
-	eventPublisher EventPublisher
+	db        *sqlx.DB
+	trManager *manager.Manager
+	txGetter  TxGetter
+
+	eventPublisher eventPublisher.EventPublisher
 }
 
-func NewUnitOfWorkFactory(db *sqlx.DB, trManager *manager.Manager, txGetter TxGetter, eventPublisher EventPublisher) ports.UnitOfWorkFactory {
+func NewUnitOfWorkFactory(
+	db *sqlx.DB,
+	trManager *manager.Manager,
+	txGetter TxGetter,
+	eventPublisher eventPublisher.EventPublisher,
+) ports.UnitOfWorkFactory {
 	return &UnitOfWorkFactory{db: db, trManager: trManager, txGetter: txGetter, eventPublisher: eventPublisher}
 }
 
@@ -2,16 +2,21 @@ package postgre
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/inbox"
 	"delivery/internal/pkg/testcnts"
 
 	modelCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
+	"delivery/internal/core/domain/model/event"
 	modelOrder "delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/model/shared_kernel"
 
@@ -25,11 +30,14 @@ import (
 
 var dbURL string
 var uow ports.UnitOfWork
+var publishedEvents = &recordingEventPublisher{}
 
-type fakeEventPublisher struct {
+type recordingEventPublisher struct {
+	events []ddd.DomainEvent
 }
 
-func (f *fakeEventPublisher) Publish(ctx context.Context, event ddd.DomainEvent) error {
+func (p *recordingEventPublisher) Publish(_ context.Context, domainEvent ddd.DomainEvent) error {
+	p.events = append(p.events, domainEvent)
 	return nil
 }
 
@@ -55,9 +63,7 @@ func TestMain(m *testing.M) {
 		}
 	}()
 
-	eventPublisher := &fakeEventPublisher{}
-
-	uow = NewUnitOfWork(db, trManager, trmsqlx.DefaultCtxGetter, eventPublisher)
+	uow = NewUnitOfWork(db, trManager, trmsqlx.DefaultCtxGetter, publishedEvents)
 
 	dbURL = containerDBURL
 
@@ -84,7 +90,7 @@ func cleanupDB(t *testing.T) {
 		defer db.Close()
 
 		// Очищаем таблицы в правильном порядке (из-за внешних ключей)
-		_, err = db.Exec("TRUNCATE TABLE storage_place, \"order\", courier RESTART IDENTITY CASCADE")
+		_, err = db.Exec("TRUNCATE TABLE outbox, inbox, dispatch_decision, storage_place, \"order\", courier RESTART IDENTITY CASCADE")
 		if err != nil {
 			t.Fatalf("failed to cleanup database: %v", err)
 		}
@@ -106,6 +112,28 @@ func Test_OrderRepoShouldAddOrder(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_OrderRepoShouldRejectOrderWithExistingID(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	duplicate, _ := modelOrder.NewOrder(order.ID(), randomLocation, 7)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, order)
+	})
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, duplicate)
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrObjectAlreadyExists)
+	storedOrder, getErr := uow.OrderRepo().Get(context.Background(), order.ID())
+	assert.NoError(t, getErr)
+	assert.Equal(t, int64(5), storedOrder.Volume())
+}
+
 func Test_OrderRepoShouldGetOrder(t *testing.T) {
 	cleanupDB(t)
 	// Arrange
@@ -238,6 +266,42 @@ func Test_OrderRepoShouldGetAllInAssignedStatus(t *testing.T) {
 	assert.Equal(t, assignedOrder.ID(), gettedOrders[0].ID())
 }
 
+func Test_OrderRepoShouldGetAllInDeliveryWithPickupLocation(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	pickupLocation, _ := shared_kernel.NewLocation(1, 1)
+	pickedUpOrder, _ := modelOrder.NewOrder(uuid.New(), location, 5)
+	_ = pickedUpOrder.SetPickupLocation(pickupLocation)
+	createdOrder, _ := modelOrder.NewOrder(uuid.New(), location, 5)
+	courier, _ := modelCourier.NewCourier("test", 10, pickupLocation)
+	_ = pickedUpOrder.Assign(courier.ID())
+	_ = pickedUpOrder.PickUp()
+	// Добавляем курьера
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		_ = uow.CourierRepo().Add(ctx, courier)
+
+		return nil
+	})
+	// Добавляем заказы
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		_ = uow.OrderRepo().Add(ctx, pickedUpOrder)
+		_ = uow.OrderRepo().Add(ctx, createdOrder)
+
+		return nil
+	})
+
+	// Act
+	gettedOrders, err := uow.OrderRepo().GetAllInDelivery(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(gettedOrders))
+	assert.Equal(t, pickedUpOrder.ID(), gettedOrders[0].ID())
+	assert.Equal(t, modelOrder.StatusPickedUp, gettedOrders[0].Status())
+	assert.Equal(t, pickupLocation, gettedOrders[0].PickupLocation())
+}
+
 func Test_CourierRepoShouldAddCourier(t *testing.T) {
 	cleanupDB(t)
 	// Arrange
@@ -293,6 +357,33 @@ func Test_CourierRepoShouldUpdateCourier(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_CourierRepoShouldPersistTransitAlongLongRoad(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	target, _ := shared_kernel.NewLocation(1, 6)
+	road, _ := shared_kernel.NewRoadEdge(start, target, 5, false)
+	graph, _ := shared_kernel.NewRoadGraph(shared_kernel.DefaultMapBounds(), false, []shared_kernel.RoadEdge{road}, nil)
+	courier, _ := modelCourier.NewCourier("test", 2, start)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.CourierRepo().Add(ctx, courier)
+	})
+	_ = courier.Move(graph, target)
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.CourierRepo().Update(ctx, courier)
+	})
+	gettedCourier, getErr := uow.CourierRepo().Get(context.Background(), courier.ID())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, getErr)
+	assert.Equal(t, start, gettedCourier.Location())
+	assert.Equal(t, target, gettedCourier.Transit().To())
+	assert.Equal(t, int64(3), gettedCourier.Transit().Left())
+}
+
 func Test_CourierRepoImpossibleToUpdateCourierWhenItNotExists(t *testing.T) {
 	cleanupDB(t)
 	// Arrange
@@ -338,7 +429,7 @@ func Test_CourierRepoShouldGetAllFreeCouriers(t *testing.T) {
 	courierThatTakeOrder, _ := modelCourier.NewCourier("test", 10, randomLocation)
 	freeCourier, _ := modelCourier.NewCourier("test", 10, randomLocation)
 	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
-	_ = courierThatTakeOrder.TakeOrder(order)
+	_ = courierThatTakeOrder.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
 
 	// Добавляем заказ, свободного курьера и курьера, который взял заказ
 	_ = uow.Do(context.Background(), func(ctx context.Context) error {
@@ -357,3 +448,468 @@ func Test_CourierRepoShouldGetAllFreeCouriers(t *testing.T) {
 	assert.Equal(t, 1, len(gettedCouriers))
 	assert.Equal(t, freeCourier.ID(), gettedCouriers[0].ID())
 }
+
+func Test_CourierRepoShouldGetFreeCourierOnceWithSeveralFreeStoragePlaces(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	courier, _ := modelCourier.NewCourier("test", 10, randomLocation)
+	_ = courier.AddStoragePlace("Багажник", 30)
+
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.CourierRepo().Add(ctx, courier)
+	})
+
+	// Act
+	gettedCouriers, err := uow.CourierRepo().GetAllFreeCouriers(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(gettedCouriers))
+	assert.Equal(t, courier.ID(), gettedCouriers[0].ID())
+	assert.Equal(t, 2, len(gettedCouriers[0].StoragePlaces()))
+}
+
+func Test_CourierRepoShouldNotGetCouriersOffShift(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	onlineCourier, _ := modelCourier.NewCourier("online", 10, randomLocation)
+	offlineCourier, _ := modelCourier.NewCourier("offline", 10, randomLocation)
+	_ = offlineCourier.EndShift(nil)
+	courierOnBreak, _ := modelCourier.NewCourier("on break", 10, randomLocation)
+	_ = courierOnBreak.TakeBreak()
+
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		_ = uow.CourierRepo().Add(ctx, onlineCourier)
+		_ = uow.CourierRepo().Add(ctx, offlineCourier)
+		_ = uow.CourierRepo().Add(ctx, courierOnBreak)
+
+		return nil
+	})
+
+	// Act
+	gettedCouriers, err := uow.CourierRepo().GetAllFreeCouriers(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(gettedCouriers))
+	assert.Equal(t, onlineCourier.ID(), gettedCouriers[0].ID())
+
+	gettedOfflineCourier, err := uow.CourierRepo().Get(context.Background(), offlineCourier.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, modelCourier.WorkStatusOffline, gettedOfflineCourier.WorkStatus())
+}
+
+func Test_OrderRepoShouldSaveDomainEventsToOutbox(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, order)
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, order.GetDomainEvents())
+
+	messages, err := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(messages))
+	assert.Equal(t, "OrderCreated", messages[0].Name)
+	assert.Nil(t, messages[0].ProcessedAtUtc)
+}
+
+func Test_CourierRepoShouldSaveDomainEventsToOutbox(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	courier, _ := modelCourier.NewCourier("test", 10, randomLocation)
+	_ = courier.AddStoragePlace("Багажник", 50)
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.CourierRepo().Add(ctx, courier)
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, courier.GetDomainEvents())
+
+	messages, err := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(messages))
+}
+
+func Test_OrderRepoShouldNotSaveDomainEventsToOutboxWhenTransactionFailed(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		_ = uow.OrderRepo().Add(ctx, order)
+
+		return errors.New("something went wrong")
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Len(t, order.GetDomainEvents(), 1)
+
+	messages, err := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func Test_OrderRepoShouldKeepStatusChangesWhenTransactionFailed(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		_ = uow.OrderRepo().Add(ctx, order)
+
+		return errors.New("something went wrong")
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Len(t, order.StatusChanges(), 1)
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, order)
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, order.StatusChanges())
+}
+
+func Test_OrderRepoShouldSaveDomainEventsOnceWhenOrderSavedTwiceInTransaction(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		if err := uow.OrderRepo().Add(ctx, order); err != nil {
+			return err
+		}
+		return uow.OrderRepo().Update(ctx, order)
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, order.GetDomainEvents())
+
+	messages, err := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(messages))
+}
+
+func Test_CourierRepoShouldPublishCourierMovedAfterCommitInsteadOfOutbox(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	location, _ := shared_kernel.NewLocation(1, 1)
+	target, _ := shared_kernel.NewLocation(5, 5)
+	courier, _ := modelCourier.NewCourier("test", 2, location)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.CourierRepo().Add(ctx, courier)
+	})
+	messages, _ := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	publishedEvents.events = nil
+	_ = courier.Move(shared_kernel.NewGridRoadNetwork(), target)
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.CourierRepo().Update(ctx, courier)
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, courier.GetDomainEvents())
+	assert.Len(t, publishedEvents.events, 1)
+	assert.IsType(t, &event.CourierMoved{}, publishedEvents.events[0])
+
+	messagesAfterMove, err := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, len(messages), len(messagesAfterMove))
+}
+
+func Test_CourierRepoShouldNotPublishCourierMovedWhenTransactionFailed(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	location, _ := shared_kernel.NewLocation(1, 1)
+	target, _ := shared_kernel.NewLocation(5, 5)
+	courier, _ := modelCourier.NewCourier("test", 2, location)
+	courier.ClearDomainEvents()
+	_ = courier.Move(shared_kernel.NewGridRoadNetwork(), target)
+	publishedEvents.events = nil
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		_ = uow.CourierRepo().Add(ctx, courier)
+
+		return errors.New("something went wrong")
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, publishedEvents.events)
+	assert.Len(t, courier.GetDomainEvents(), 1)
+}
+
+func Test_OutboxRepoShouldNotReturnProcessedMessages(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, order)
+	})
+	messages, _ := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	messages[0].MarkAsProcessed()
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OutboxRepo().Update(ctx, messages[0])
+	})
+
+	// Assert
+	assert.NoError(t, err)
+
+	messages, err = uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func Test_OutboxRepoShouldNotReturnParkedMessages(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, order)
+	})
+	messages, _ := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	messages[0].Park(errors.New("bad payload"), time.Now())
+
+	// Act
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OutboxRepo().Update(ctx, messages[0])
+	})
+
+	// Assert
+	assert.NoError(t, err)
+
+	messages, err = uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func Test_OutboxRepoShouldDeleteOnlyExpiredProcessedMessages(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	processedOrder, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, processedOrder)
+	})
+	messages, _ := uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	messages[0].MarkAsProcessed()
+	_ = uow.OutboxRepo().Update(context.Background(), messages[0])
+
+	pendingOrder, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, pendingOrder)
+	})
+
+	// Act
+	deleted, err := uow.OutboxRepo().DeleteProcessedMessages(context.Background(), time.Now().UTC().Add(time.Minute), 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	messages, err = uow.OutboxRepo().GetNotProcessedMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+}
+
+func Test_OrderRepoShouldPersistDeliveryPeriod(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	deliveryPeriod, _ := modelOrder.NewDeliveryPeriod(
+		time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+	)
+	_ = order.SetDeliveryPeriod(deliveryPeriod)
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.OrderRepo().Add(ctx, order)
+	})
+
+	// Act
+	gettedOrder, err := uow.OrderRepo().Get(context.Background(), order.ID())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, deliveryPeriod.Equals(gettedOrder.DeliveryPeriod()))
+	assert.False(t, gettedOrder.DeliveryAtRisk())
+}
+
+func Test_OrderRepoShouldGetAllWithDeliveryPeriodNotAtRisk(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	now := time.Now().UTC()
+	deliveryPeriod, _ := modelOrder.NewDeliveryPeriod(now, now.Add(time.Minute))
+
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	orderWithoutDeliveryPeriod, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	orderWithDeliveryPeriod, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	_ = orderWithDeliveryPeriod.SetDeliveryPeriod(deliveryPeriod)
+	orderAtRisk, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	_ = orderAtRisk.SetDeliveryPeriod(deliveryPeriod)
+	_ = orderAtRisk.FlagDeliveryAtRiskIfLate(now.Add(time.Hour))
+
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		for _, order := range []*modelOrder.Order{orderWithoutDeliveryPeriod, orderWithDeliveryPeriod, orderAtRisk} {
+			if err := uow.OrderRepo().Add(ctx, order); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	// Act
+	orders, err := uow.OrderRepo().GetAllWithDeliveryPeriodNotAtRisk(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, orders, 1)
+	assert.Equal(t, orderWithDeliveryPeriod.ID(), orders[0].ID())
+}
+
+func Test_OrderRepoShouldGetAllInCreatedStatusWithLimit(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	_ = uow.Do(context.Background(), func(ctx context.Context) error {
+		for range 3 {
+			order, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+			if err := uow.OrderRepo().Add(ctx, order); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	// Act
+	orders, err := uow.OrderRepo().GetAllInCreatedStatus(context.Background(), 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, orders, 2)
+	for _, order := range orders {
+		assert.Equal(t, modelOrder.StatusCreated, order.Status())
+	}
+}
+
+func Test_InboxRepoShouldReportProcessedMessage(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	eventID := uuid.NewString()
+
+	existsBefore, err := uow.InboxRepo().Exists(context.Background(), eventID)
+	assert.NoError(t, err)
+
+	// Act
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		return uow.InboxRepo().Add(ctx, inbox.NewMessage(eventID, "basket_event.BasketConfirmedIntegrationEvent"))
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, existsBefore)
+
+	existsAfter, err := uow.InboxRepo().Exists(context.Background(), eventID)
+	assert.NoError(t, err)
+	assert.True(t, existsAfter)
+}
+
+func Test_InboxRepoShouldRejectDuplicateMessageAndRollBackItsChanges(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	eventID := uuid.NewString()
+	randomLocation, _ := shared_kernel.NewRandomLocation()
+	firstOrder, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+	duplicateOrder, _ := modelOrder.NewOrder(uuid.New(), randomLocation, 5)
+
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		if err := uow.OrderRepo().Add(ctx, firstOrder); err != nil {
+			return err
+		}
+		return uow.InboxRepo().Add(ctx, inbox.NewMessage(eventID, "basket_event.BasketConfirmedIntegrationEvent"))
+	})
+	assert.NoError(t, err)
+
+	// Act
+	// Повторная доставка, которая прошла проверку Exists одновременно с первой
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		if err := uow.OrderRepo().Add(ctx, duplicateOrder); err != nil {
+			return err
+		}
+		return uow.InboxRepo().Add(ctx, inbox.NewMessage(eventID, "basket_event.BasketConfirmedIntegrationEvent"))
+	})
+
+	// Assert
+	assert.Error(t, err)
+
+	_, err = uow.OrderRepo().Get(context.Background(), firstOrder.ID())
+	assert.NoError(t, err)
+
+	_, err = uow.OrderRepo().Get(context.Background(), duplicateOrder.ID())
+	assert.Error(t, err)
+}
+
+func Test_DispatchDecisionRepoShouldGetLatestDecisionPerOrder(t *testing.T) {
+	cleanupDB(t)
+	// Arrange
+	orderID, orderWithoutDecisionsID := uuid.New(), uuid.New()
+	courierID := uuid.New()
+	decidedAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	older, _ := dispatch.NewDecision(orderID, "nearest", decidedAt)
+	older.AddCandidate(dispatch.NewCandidate(courierID, 3, dispatch.RejectionReasonNoStoragePlace))
+	latest, _ := dispatch.NewDecision(orderID, "nearest", decidedAt.Add(time.Second))
+	latest.AddCandidate(dispatch.NewCandidate(courierID, 2, dispatch.RejectionReasonNone))
+	_ = latest.Select(courierID)
+
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		if err := uow.DispatchDecisionRepo().Add(ctx, latest); err != nil {
+			return err
+		}
+		return uow.DispatchDecisionRepo().Add(ctx, older)
+	})
+	assert.NoError(t, err)
+
+	// Act
+	decisions, err := uow.DispatchDecisionRepo().GetLatestByOrderIDs(context.Background(), []uuid.UUID{orderID, orderWithoutDecisionsID})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, decisions, 1)
+
+	gettedDecision := decisions[orderID]
+	assert.Equal(t, latest.ID(), gettedDecision.ID())
+	assert.Equal(t, courierID, *gettedDecision.CourierID())
+	assert.Equal(t, latest.DecidedAt(), gettedDecision.DecidedAt())
+	assert.True(t, latest.HasSameOutcomeAs(gettedDecision))
+}
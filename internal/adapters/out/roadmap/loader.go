@@ -0,0 +1,79 @@
+package roadmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"delivery/internal/core/domain/model/shared_kernel"
+)
+
+// fileDTO - формат файла дорожной сети:
+//
+//	{
+//	  "grid": true,
+//	  "edges": [{"from": {"x": 1, "y": 1}, "to": {"x": 5, "y": 1}, "cost": 2, "oneWay": false}],
+//	  "blocked": [{"x": 3, "y": 3}]
+//	}
+type fileDTO struct {
+	Grid    bool          `json:"grid"`
+	Edges   []edgeDTO     `json:"edges"`
+	Blocked []locationDTO `json:"blocked"`
+}
+
+type edgeDTO struct {
+	From   locationDTO `json:"from"`
+	To     locationDTO `json:"to"`
+	Cost   int64       `json:"cost"`
+	OneWay bool        `json:"oneWay"`
+}
+
+type locationDTO struct {
+	X int64 `json:"x"`
+	Y int64 `json:"y"`
+}
+
+// Load - читает дорожную сеть из JSON файла. Координаты проверяются по границам карты bounds.
+func Load(path string, bounds shared_kernel.MapBounds) (*shared_kernel.RoadGraph, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read road map %s: %w", path, err)
+	}
+
+	var file fileDTO
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse road map %s: %w", path, err)
+	}
+
+	edges := make([]shared_kernel.RoadEdge, 0, len(file.Edges))
+	for i, edgeDTO := range file.Edges {
+		from, err := bounds.NewLocation(edgeDTO.From.X, edgeDTO.From.Y)
+		if err != nil {
+			return nil, fmt.Errorf("road map edge %d: %w", i, err)
+		}
+
+		to, err := bounds.NewLocation(edgeDTO.To.X, edgeDTO.To.Y)
+		if err != nil {
+			return nil, fmt.Errorf("road map edge %d: %w", i, err)
+		}
+
+		edge, err := shared_kernel.NewRoadEdge(from, to, edgeDTO.Cost, edgeDTO.OneWay)
+		if err != nil {
+			return nil, fmt.Errorf("road map edge %d: %w", i, err)
+		}
+
+		edges = append(edges, edge)
+	}
+
+	blocked := make([]shared_kernel.Location, 0, len(file.Blocked))
+	for i, locationDTO := range file.Blocked {
+		location, err := bounds.NewLocation(locationDTO.X, locationDTO.Y)
+		if err != nil {
+			return nil, fmt.Errorf("road map blocked location %d: %w", i, err)
+		}
+
+		blocked = append(blocked, location)
+	}
+
+	return shared_kernel.NewRoadGraph(bounds, file.Grid, edges, blocked)
+}
@@ -0,0 +1,157 @@
+package tracking
+
+import (
+	"sync"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/google/uuid"
+)
+
+const (
+	UpdateTypeCourierLocation UpdateType = "courier_location"
+	UpdateTypeOrderStatus     UpdateType = "order_status"
+
+	defaultBufferSize = 64
+)
+
+type UpdateType string
+
+// Update - изменение, которое отправляется подписчикам трекинга
+type Update struct {
+	Type       UpdateType
+	CourierID  *uuid.UUID
+	OrderID    *uuid.UUID
+	X          int64
+	Y          int64
+	FromStatus string
+	ToStatus   string
+	OccurredAt time.Time
+}
+
+// Filter - на что подписан клиент. Пустой фильтр - на все изменения.
+// При подписке на заказ CourierID - курьер, который везет заказ в момент подписки.
+type Filter struct {
+	OrderID   *uuid.UUID
+	CourierID *uuid.UUID
+}
+
+type subscriber struct {
+	filter    Filter
+	courierID *uuid.UUID
+	updates   chan Update
+}
+
+// matches вызывается под блокировкой хаба
+func (s *subscriber) matches(update Update) bool {
+	switch {
+	case s.filter.OrderID != nil:
+		if update.Type == UpdateTypeOrderStatus {
+			return update.OrderID != nil && *update.OrderID == *s.filter.OrderID
+		}
+		return s.courierID != nil && update.CourierID != nil && *update.CourierID == *s.courierID
+	case s.filter.CourierID != nil:
+		return update.CourierID != nil && *update.CourierID == *s.filter.CourierID
+	default:
+		return true
+	}
+}
+
+// trackCourier - подписчик на заказ следит за курьером, который его сейчас везет
+func (s *subscriber) trackCourier(update Update) {
+	if s.filter.OrderID == nil || update.Type != UpdateTypeOrderStatus {
+		return
+	}
+	if update.OrderID == nil || *update.OrderID != *s.filter.OrderID {
+		return
+	}
+
+	switch order.Status(update.ToStatus) {
+	case order.StatusAssigned, order.StatusPickedUp:
+		s.courierID = update.CourierID
+	default:
+		s.courierID = nil
+	}
+}
+
+// Hub - рассылает изменения подписчикам трекинга. Медленный подписчик не тормозит
+// остальных: если его буфер заполнен, изменение для него отбрасывается.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	bufferSize  int
+	closed      bool
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[*subscriber]struct{}),
+		bufferSize:  defaultBufferSize,
+	}
+}
+
+// Subscribe - возвращает канал изменений и функцию отписки.
+// Канал закрывается при отписке или закрытии хаба.
+func (h *Hub) Subscribe(filter Filter) (<-chan Update, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s := &subscriber{
+		filter:    filter,
+		courierID: filter.CourierID,
+		updates:   make(chan Update, h.bufferSize),
+	}
+	if h.closed {
+		close(s.updates)
+		return s.updates, func() {}
+	}
+	h.subscribers[s] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			if _, ok := h.subscribers[s]; ok {
+				delete(h.subscribers, s)
+				close(s.updates)
+			}
+		})
+	}
+
+	return s.updates, unsubscribe
+}
+
+func (h *Hub) Broadcast(update Update) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscribers {
+		matches := s.matches(update)
+		s.trackCourier(update)
+		if !matches {
+			continue
+		}
+
+		select {
+		case s.updates <- update:
+		default:
+		}
+	}
+}
+
+// Close - отключает всех подписчиков, вызывается при остановке сервера
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscribers {
+		delete(h.subscribers, s)
+		close(s.updates)
+	}
+	h.closed = true
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package tracking
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func courierLocation(courierID uuid.UUID) Update {
+	return Update{Type: UpdateTypeCourierLocation, CourierID: &courierID, X: 1, Y: 2, OccurredAt: time.Now().UTC()}
+}
+
+func orderStatus(orderID uuid.UUID, courierID *uuid.UUID, from, to order.Status) Update {
+	return Update{
+		Type:       UpdateTypeOrderStatus,
+		OrderID:    &orderID,
+		CourierID:  courierID,
+		FromStatus: from.String(),
+		ToStatus:   to.String(),
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func drain(updates <-chan Update) []Update {
+	var result []Update
+	for {
+		select {
+		case update := <-updates:
+			result = append(result, update)
+		default:
+			return result
+		}
+	}
+}
+
+func TestHub_EmptyFilter_ReceivesEverything(t *testing.T) {
+	// Arrange
+	hub := NewHub()
+	updates, unsubscribe := hub.Subscribe(Filter{})
+	defer unsubscribe()
+
+	// Act
+	hub.Broadcast(courierLocation(uuid.New()))
+	hub.Broadcast(orderStatus(uuid.New(), nil, order.StatusCreated, order.StatusCancelled))
+
+	// Assert
+	assert.Len(t, drain(updates), 2)
+}
+
+func TestHub_CourierFilter_ReceivesOnlyThatCourier(t *testing.T) {
+	// Arrange
+	hub := NewHub()
+	courierID := uuid.New()
+	updates, unsubscribe := hub.Subscribe(Filter{CourierID: &courierID})
+	defer unsubscribe()
+
+	// Act
+	hub.Broadcast(courierLocation(uuid.New()))
+	hub.Broadcast(courierLocation(courierID))
+
+	// Assert
+	received := drain(updates)
+	assert.Len(t, received, 1)
+	assert.Equal(t, courierID, *received[0].CourierID)
+}
+
+func TestHub_OrderFilter_FollowsAssignedCourier(t *testing.T) {
+	// Arrange
+	hub := NewHub()
+	orderID := uuid.New()
+	courierID := uuid.New()
+	updates, unsubscribe := hub.Subscribe(Filter{OrderID: &orderID})
+	defer unsubscribe()
+
+	// Act
+	hub.Broadcast(courierLocation(courierID))
+	hub.Broadcast(orderStatus(orderID, &courierID, order.StatusCreated, order.StatusAssigned))
+	hub.Broadcast(courierLocation(courierID))
+	hub.Broadcast(orderStatus(orderID, &courierID, order.StatusAssigned, order.StatusCompleted))
+	hub.Broadcast(courierLocation(courierID))
+
+	// Assert
+	received := drain(updates)
+	assert.Len(t, received, 3)
+	assert.Equal(t, UpdateTypeOrderStatus, received[0].Type)
+	assert.Equal(t, UpdateTypeCourierLocation, received[1].Type)
+	assert.Equal(t, order.StatusCompleted.String(), received[2].ToStatus)
+}
+
+func TestHub_FullBuffer_DropsUpdatesWithoutBlocking(t *testing.T) {
+	// Arrange
+	hub := NewHub()
+	hub.bufferSize = 1
+	updates, unsubscribe := hub.Subscribe(Filter{})
+	defer unsubscribe()
+
+	// Act
+	hub.Broadcast(courierLocation(uuid.New()))
+	hub.Broadcast(courierLocation(uuid.New()))
+
+	// Assert
+	assert.Len(t, drain(updates), 1)
+}
+
+func TestHub_Close_ClosesSubscriberChannels(t *testing.T) {
+	// Arrange
+	hub := NewHub()
+	updates, unsubscribe := hub.Subscribe(Filter{})
+
+	// Act
+	err := hub.Close()
+	unsubscribe()
+
+	// Assert
+	assert.NoError(t, err)
+	_, ok := <-updates
+	assert.False(t, ok)
+}
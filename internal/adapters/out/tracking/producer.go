@@ -0,0 +1,63 @@
+package tracking
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/core/ports"
+)
+
+var _ ports.EventProducer[*event.CourierMoved] = (*CourierMovedProducer)(nil)
+var _ ports.EventProducer[*event.OrderStatusChanged] = (*OrderStatusChangedProducer)(nil)
+
+// CourierMovedProducer - отправляет новое положение курьера подписчикам трекинга
+type CourierMovedProducer struct {
+	hub *Hub
+}
+
+func NewCourierMovedProducer(hub *Hub) *CourierMovedProducer {
+	return &CourierMovedProducer{hub: hub}
+}
+
+func (p *CourierMovedProducer) Publish(_ context.Context, domainEvent *event.CourierMoved) error {
+	courierID := domainEvent.CourierID
+	p.hub.Broadcast(Update{
+		Type:       UpdateTypeCourierLocation,
+		CourierID:  &courierID,
+		X:          domainEvent.X,
+		Y:          domainEvent.Y,
+		OccurredAt: domainEvent.OccurredAt,
+	})
+	return nil
+}
+
+// Close - хабом владеет HTTP сервер, продюсер его не закрывает
+func (p *CourierMovedProducer) Close() error {
+	return nil
+}
+
+// OrderStatusChangedProducer - отправляет смену статуса заказа подписчикам трекинга
+type OrderStatusChangedProducer struct {
+	hub *Hub
+}
+
+func NewOrderStatusChangedProducer(hub *Hub) *OrderStatusChangedProducer {
+	return &OrderStatusChangedProducer{hub: hub}
+}
+
+func (p *OrderStatusChangedProducer) Publish(_ context.Context, domainEvent *event.OrderStatusChanged) error {
+	orderID := domainEvent.OrderID
+	p.hub.Broadcast(Update{
+		Type:       UpdateTypeOrderStatus,
+		OrderID:    &orderID,
+		CourierID:  domainEvent.CourierID,
+		FromStatus: domainEvent.FromStatus,
+		ToStatus:   domainEvent.ToStatus,
+		OccurredAt: domainEvent.OccurredAt,
+	})
+	return nil
+}
+
+func (p *OrderStatusChangedProducer) Close() error {
+	return nil
+}
@@ -2,22 +2,27 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"sync"
 	"time"
 
+	grpcinterceptor "delivery/internal/adapters/in/grpc/interceptor"
 	httpmiddleware "delivery/internal/adapters/in/http/middleware"
 	"delivery/internal/config"
 	"delivery/internal/core/domain/model/event"
 	"delivery/internal/generated/servers"
+	"delivery/internal/generated/servers/deliverysrv/deliverypb"
 	"delivery/internal/pkg/closer"
+	eventPublisher "delivery/internal/pkg/event_publisher"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/robfig/cron/v3"
-
-	"github.com/mehdihadeli/go-mediatr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 const (
@@ -28,6 +33,8 @@ type App struct {
 	serviceProvider *serviceProvider
 	configPath      string
 	httpServer      *http.Server
+	grpcServer      *grpc.Server
+	grpcListener    net.Listener
 	cronScheduler   *cron.Cron
 }
 
@@ -55,6 +62,7 @@ func (a *App) Run() error {
 		{action: a.runHttpServer, errMsg: "ошибка при запуске HTTP сервера"},
 		{action: a.runCronScheduler, errMsg: "ошибка при запуске Cron планировщика"},
 		{action: a.runKafkaConsumerGroup, errMsg: "ошибка при запуске Kafka consumer group"},
+		{action: a.runBasketCancelledConsumerGroup, errMsg: "ошибка при запуске Kafka consumer group для отмены корзин"},
 	}
 
 	wg := sync.WaitGroup{}
@@ -81,8 +89,11 @@ func (a *App) initDeps(ctx context.Context) error {
 	initDepFunctions := []func(context.Context) error{
 		a.initConfig,
 		a.initServiceProvider,
+		a.initMapBounds,
+		a.initRoadNetwork,
 		a.initMediator,
 		a.initHttpServer,
+		a.initGRPCServer,
 		a.initCronScheduler,
 	}
 
@@ -109,8 +120,75 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
-func (a *App) initGRPCServer(ctx context.Context) error {
-	// TODO: когда будем добавлять grpc сервер - реализовать
+// initMapBounds - проверяет сохраненные координаты по границам карты из конфигурации до того,
+// как сервис начнет их читать. Если в БД есть координаты вне границ, старт прерывается,
+// пока не разрешен их перенос через MAP_CLAMP_EXISTING_LOCATIONS.
+func (a *App) initMapBounds(ctx context.Context) error {
+	mapConfig := a.serviceProvider.MapConfig()
+	bounds := a.serviceProvider.MapBounds()
+
+	locationBoundsRepo := a.serviceProvider.LocationBoundsRepo()
+
+	outOfBounds, err := locationBoundsRepo.CountOutOfBounds(ctx, bounds)
+	if err != nil {
+		return err
+	}
+
+	if outOfBounds == 0 {
+		return nil
+	}
+
+	if !mapConfig.ClampExistingLocations {
+		return fmt.Errorf("%d stored locations are outside map bounds, set MAP_CLAMP_EXISTING_LOCATIONS=true to move them inside", outOfBounds)
+	}
+
+	clamped, err := locationBoundsRepo.ClampToBounds(ctx, bounds)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("moved %d stored locations inside map bounds", clamped)
+
+	return nil
+}
+
+// initRoadNetwork - загружает дорожную сеть при старте, а не при первом тике, чтобы ошибка в файле сразу остановила сервис
+func (a *App) initRoadNetwork(_ context.Context) error {
+	a.serviceProvider.RoadNetwork()
+	return nil
+}
+
+func (a *App) initGRPCServer(_ context.Context) error {
+	grpcConfig := a.serviceProvider.GrpcConfig()
+
+	listener, err := net.Listen("tcp", grpcConfig.Address())
+	if err != nil {
+		return err
+	}
+	a.grpcListener = listener
+
+	a.grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(grpcinterceptor.ErrorHandlingInterceptor()),
+	)
+	deliverypb.RegisterDeliveryServer(a.grpcServer, a.serviceProvider.GrpcHandlers())
+	reflection.Register(a.grpcServer)
+
+	closer.Add(func() error {
+		stopped := make(chan struct{})
+		go func() {
+			a.grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		// Дожидаемся текущих вызовов, но не дольше, чем HTTP сервер
+		select {
+		case <-stopped:
+		case <-time.After(5 * time.Second):
+			a.grpcServer.Stop()
+		}
+		return nil
+	})
+
 	return nil
 }
 
@@ -131,6 +209,10 @@ func (a *App) initHttpServer(ctx context.Context) error {
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 	}
+	// Shutdown не прерывает открытые потоки трекинга, поэтому отключаем подписчиков сами
+	a.httpServer.RegisterOnShutdown(func() {
+		_ = a.serviceProvider.TrackingHub().Close()
+	})
 
 	closer.Add(func() error {
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -141,11 +223,29 @@ func (a *App) initHttpServer(ctx context.Context) error {
 	return nil
 }
 
+// initMediator - подписывает обработчики на доменные события из outbox и на TransientDomainEvent,
+// которые UnitOfWork публикует после фиксации.
+// Трекинг получает изменения только после фиксации транзакции, Kafka - внутри нее, чтобы ошибка отправки повторялась.
 func (a *App) initMediator(_ context.Context) error {
-	if err := mediatr.RegisterNotificationHandler[*event.OrderCreated](a.serviceProvider.OrderCreatedHandler()); err != nil {
+	dispatcher := a.serviceProvider.EventPublisher()
+
+	if err := eventPublisher.Subscribe[*event.OrderCreated](dispatcher, a.serviceProvider.OrderCreatedHandler()); err != nil {
+		return err
+	}
+	if err := eventPublisher.Subscribe[*event.OrderCompleted](dispatcher, a.serviceProvider.OrderCompletedHandler()); err != nil {
+		return err
+	}
+	if err := eventPublisher.Subscribe[*event.OrderAssigned](dispatcher, a.serviceProvider.OrderAssignedHandler()); err != nil {
+		return err
+	}
+	if err := eventPublisher.SubscribeAfterCommit[*event.OrderStatusChanged](dispatcher, a.serviceProvider.OrderStatusChangedHandler()); err != nil {
+		return err
+	}
+	// CourierMoved не проходит через outbox: UnitOfWork публикует его после фиксации в том же процессе
+	if err := eventPublisher.SubscribeAfterCommit[*event.CourierMoved](dispatcher, a.serviceProvider.CourierLocationChangedHandler()); err != nil {
 		return err
 	}
-	if err := mediatr.RegisterNotificationHandler[*event.OrderCompleted](a.serviceProvider.OrderCompletedHandler()); err != nil {
+	if err := eventPublisher.SubscribeAfterCommit[*event.CourierMoved](dispatcher, a.serviceProvider.CourierMovedHandler()); err != nil {
 		return err
 	}
 
@@ -153,8 +253,8 @@ func (a *App) initMediator(_ context.Context) error {
 }
 
 func (a *App) runGRPCServer() error {
-	// TODO: когда будем добавлять grpc сервер - реализовать
-	return nil
+	log.Printf("Starting GRPC server on %s", a.grpcListener.Addr())
+	return a.grpcServer.Serve(a.grpcListener)
 }
 
 func (a *App) initCronScheduler(ctx context.Context) error {
@@ -170,6 +270,21 @@ func (a *App) initCronScheduler(ctx context.Context) error {
 		return err
 	}
 
+	_, err = a.cronScheduler.AddJob("@every 1s", a.serviceProvider.OutboxJob())
+	if err != nil {
+		return err
+	}
+
+	_, err = a.cronScheduler.AddJob("@every 10m", a.serviceProvider.OutboxCleanupJob())
+	if err != nil {
+		return err
+	}
+
+	_, err = a.cronScheduler.AddJob("@every 10s", a.serviceProvider.FlagOrdersAtRiskJob())
+	if err != nil {
+		return err
+	}
+
 	closer.Add(func() error {
 		ctx := a.cronScheduler.Stop()
 		<-ctx.Done()
@@ -190,6 +305,11 @@ func (a *App) runKafkaConsumerGroup() error {
 	return a.serviceProvider.BasketConfirmedConsumerGroup().Consume()
 }
 
+func (a *App) runBasketCancelledConsumerGroup() error {
+	log.Printf("Starting Kafka basket cancelled consumer group")
+	return a.serviceProvider.BasketCancelledConsumerGroup().Consume()
+}
+
 func (a *App) runHttpServer() error {
 	log.Printf("Starting HTTP server on %s", a.httpServer.Addr)
 	return a.httpServer.ListenAndServe()
@@ -2,7 +2,9 @@ package app
 
 import (
 	"log"
+	"reflect"
 
+	grpcv1 "delivery/internal/adapters/in/grpc/v1"
 	httpv1 "delivery/internal/adapters/in/http/v1"
 	"delivery/internal/adapters/in/kafka"
 	kafkaConsumerCommon "delivery/internal/adapters/in/kafka/common"
@@ -11,25 +13,43 @@ import (
 	"delivery/internal/adapters/out/kafka/mapper"
 	"delivery/internal/adapters/out/postgre"
 	"delivery/internal/adapters/out/postgre/courier_repo"
+	"delivery/internal/adapters/out/postgre/location_bounds"
 	"delivery/internal/adapters/out/postgre/order_repo"
+	"delivery/internal/adapters/out/postgre/outbox_repo"
+	"delivery/internal/adapters/out/roadmap"
+	"delivery/internal/adapters/out/tracking"
 	"delivery/internal/config"
 	"delivery/internal/config/env"
 	eventHandlers "delivery/internal/core/application/event_handlers"
 	"delivery/internal/core/application/usecases/commands/add_storage_place"
-	"delivery/internal/core/application/usecases/commands/assign_order"
+	"delivery/internal/core/application/usecases/commands/batch_assign_orders"
+	"delivery/internal/core/application/usecases/commands/cancel_order"
 	"delivery/internal/core/application/usecases/commands/create_courier"
 	"delivery/internal/core/application/usecases/commands/create_order"
+	"delivery/internal/core/application/usecases/commands/end_courier_shift"
+	"delivery/internal/core/application/usecases/commands/flag_orders_at_risk"
 	"delivery/internal/core/application/usecases/commands/move_couriers_and_complete_order"
+	"delivery/internal/core/application/usecases/commands/start_courier_shift"
+	"delivery/internal/core/application/usecases/commands/take_courier_break"
 	"delivery/internal/core/application/usecases/queries/get_all_couriers"
 	"delivery/internal/core/application/usecases/queries/get_all_uncompleted_orders"
+	"delivery/internal/core/application/usecases/queries/get_courier"
+	"delivery/internal/core/application/usecases/queries/get_order"
+	"delivery/internal/core/application/usecases/queries/get_order_dispatch_decisions"
+	"delivery/internal/core/application/usecases/queries/get_order_status_history"
 	"delivery/internal/core/domain/model/event"
+	sharedKernel "delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/crons"
 	"delivery/internal/generated/queues/basketpb"
+	"delivery/internal/generated/queues/courierpb"
 	"delivery/internal/generated/queues/orderpb"
 	"delivery/internal/pkg/closer"
+	"delivery/internal/pkg/ddd"
 	eventPublisher "delivery/internal/pkg/event_publisher"
+	"delivery/internal/pkg/outbox"
+	"delivery/internal/pkg/retry"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
@@ -38,56 +58,98 @@ import (
 )
 
 type serviceProvider struct {
-	pgConfig    *config.PgConfig
-	httpConfig  *config.HttpConfig
-	geoConfig   *config.GeoConfig
-	kafkaConfig *config.KafkaConfig
-	db          *sqlx.DB
-	trManager   *manager.Manager
-	uowFactory  ports.UnitOfWorkFactory
-	orderRepo   ports.OrderRepo
-	courierRepo ports.CourierRepo
+	pgConfig       *config.PgConfig
+	httpConfig     *config.HttpConfig
+	grpcConfig     *config.GrpcConfig
+	geoConfig      *config.GeoConfig
+	kafkaConfig    *config.KafkaConfig
+	dispatchConfig *config.DispatchConfig
+	mapConfig      *config.MapConfig
+	mapBounds      *sharedKernel.MapBounds
+	roadNetwork    sharedKernel.RoadNetwork
+	db             *sqlx.DB
+	trManager      *manager.Manager
+	uowFactory     ports.UnitOfWorkFactory
+	orderRepo      ports.OrderRepo
+	courierRepo    ports.CourierRepo
+	outboxRepo     ports.OutboxRepo
 
 	// External clients
 	geoClient ports.GeoClient
 
 	// HTTP
 	httpHandlers *httpv1.DeliveryService
+	trackingHub  *tracking.Hub
+
+	// GRPC
+	grpcHandlers *grpcv1.DeliveryService
 
 	// Cron Jobs
-	moveCouriersJob cron.Job
-	assignOrdersJob cron.Job
+	moveCouriersJob  cron.Job
+	assignOrdersJob  cron.Job
+	outboxJob        cron.Job
+	outboxCleanupJob cron.Job
+
+	flagOrdersAtRiskJob cron.Job
 
 	// Kafka Consumers
+	deadLetterPublisher          *kafkaConsumerCommon.DeadLetterPublisher
 	basketConfirmedConsumerGroup *kafkaConsumerCommon.KafkaConsumer[*basketpb.BasketConfirmedIntegrationEvent]
 	basketConfirmedEventHandler  *kafka.BasketConfirmedEventHandler
+	basketCancelledConsumerGroup *kafkaConsumerCommon.KafkaConsumer[*basketpb.BasketCancelledIntegrationEvent]
+	basketCancelledEventHandler  *kafka.BasketCancelledEventHandler
 
 	// Kafka Producers
-	orderCreatedProducer                  ports.EventProducer[*event.OrderCreated]
-	orderCompletedProducer                ports.EventProducer[*event.OrderCompleted]
-	fromOrderCreatedToIntegrationMapper   *mapper.OrderCreatedMapper
-	fromOrderCompletedToIntegrationMapper *mapper.OrderCompletedMapper
+	orderCreatedProducer                          ports.EventProducer[*event.OrderCreated]
+	orderCompletedProducer                        ports.EventProducer[*event.OrderCompleted]
+	orderAssignedProducer                         ports.EventProducer[*event.OrderAssigned]
+	courierLocationChangedProducer                ports.EventProducer[*event.CourierMoved]
+	fromOrderCreatedToIntegrationMapper           *mapper.OrderCreatedMapper
+	fromOrderCompletedToIntegrationMapper         *mapper.OrderCompletedMapper
+	fromOrderAssignedToIntegrationMapper          *mapper.OrderAssignedMapper
+	fromCourierLocationChangedToIntegrationMapper *mapper.CourierLocationChangedMapper
+
+	// Tracking Producers
+	courierMovedProducer       ports.EventProducer[*event.CourierMoved]
+	orderStatusChangedProducer ports.EventProducer[*event.OrderStatusChanged]
 
 	// Domain Services
-	orderDispatcher ports.OrderDispatcher
+	batchOrderDispatcher     ports.BatchOrderDispatcher
+	dispatchStrategyRegistry *services.DispatchStrategyRegistry
 
 	// Command Handlers
 	createOrderHandler                  create_order.CreateOrderHandler
 	createeCourierHandler               create_courier.CreateCourierHandler
 	addStoragePlaceHandler              add_storage_place.AddStoragePlaceHandler
-	assignOrderHandler                  assign_order.AssignedOrderHandler
 	moveCouriersAndCompleteOrderHandler move_couriers_and_complete_order.MoveCouriersAndCompleteOrderHandler
+	cancelOrderHandler                  cancel_order.CancelOrderHandler
+	flagOrdersAtRiskHandler             flag_orders_at_risk.FlagOrdersAtRiskHandler
+	batchAssignOrdersHandler            batch_assign_orders.BatchAssignOrdersHandler
+	startCourierShiftHandler            start_courier_shift.StartCourierShiftHandler
+	endCourierShiftHandler              end_courier_shift.EndCourierShiftHandler
+	takeCourierBreakHandler             take_courier_break.TakeCourierBreakHandler
 
 	// Query Handlers
-	getAllCouriersHandler          get_all_couriers.GetAllCouriersHandler
-	getAllUncompletedOrdersHandler get_all_uncompleted_orders.GetAllUncompletedOrdersHandler
+	getAllCouriersHandler            get_all_couriers.GetAllCouriersHandler
+	getCourierHandler                get_courier.GetCourierHandler
+	getAllUncompletedOrdersHandler   get_all_uncompleted_orders.GetAllUncompletedOrdersHandler
+	getOrderHandler                  get_order.GetOrderHandler
+	getOrderDispatchDecisionsHandler get_order_dispatch_decisions.GetOrderDispatchDecisionsHandler
+	getOrderStatusHistoryHandler     get_order_status_history.GetOrderStatusHistoryHandler
 
 	// Event Handlers
-	orderCreatedHandler   *eventHandlers.OrderCreatedHandler
-	orderCompletedHandler *eventHandlers.OrderCompletedHandler
+	orderCreatedHandler           *eventHandlers.OrderCreatedHandler
+	orderCompletedHandler         *eventHandlers.OrderCompletedHandler
+	orderAssignedHandler          *eventHandlers.OrderAssignedHandler
+	orderStatusChangedHandler     *eventHandlers.OrderStatusChangedHandler
+	courierMovedHandler           *eventHandlers.CourierMovedHandler
+	courierLocationChangedHandler *eventHandlers.CourierMovedHandler
 
 	// Event Publishers
-	eventPublisher eventPublisher.EventPublisher
+	eventPublisher *eventPublisher.EventDispatcher
+
+	// Outbox
+	eventRegistry outbox.EventRegistry
 }
 
 func newServiceProvider() *serviceProvider {
@@ -134,7 +196,7 @@ func (s *serviceProvider) TRManager() *manager.Manager {
 
 func (s *serviceProvider) OrderRepo() ports.OrderRepo {
 	if s.orderRepo == nil {
-		s.orderRepo = order_repo.NewRepository(s.DB(), trmsqlx.DefaultCtxGetter, s.EventPublisher())
+		s.orderRepo = order_repo.NewRepository(s.DB(), trmsqlx.DefaultCtxGetter, s.OutboxRepo())
 	}
 
 	return s.orderRepo
@@ -142,12 +204,20 @@ func (s *serviceProvider) OrderRepo() ports.OrderRepo {
 
 func (s *serviceProvider) CourierRepo() ports.CourierRepo {
 	if s.courierRepo == nil {
-		s.courierRepo = courier_repo.NewRepository(s.DB(), trmsqlx.DefaultCtxGetter)
+		s.courierRepo = courier_repo.NewRepository(s.DB(), trmsqlx.DefaultCtxGetter, s.OutboxRepo())
 	}
 
 	return s.courierRepo
 }
 
+func (s *serviceProvider) OutboxRepo() ports.OutboxRepo {
+	if s.outboxRepo == nil {
+		s.outboxRepo = outbox_repo.NewRepository(s.DB(), trmsqlx.DefaultCtxGetter)
+	}
+
+	return s.outboxRepo
+}
+
 func (s *serviceProvider) UOWFactory() ports.UnitOfWorkFactory {
 	if s.uowFactory == nil {
 		s.uowFactory = postgre.NewUnitOfWorkFactory(s.DB(), s.TRManager(), trmsqlx.DefaultCtxGetter, s.EventPublisher())
@@ -158,19 +228,38 @@ func (s *serviceProvider) UOWFactory() ports.UnitOfWorkFactory {
 
 // Domain Services
 
-func (s *serviceProvider) OrderDispatcher() ports.OrderDispatcher {
-	if s.orderDispatcher == nil {
-		s.orderDispatcher = services.NewCourierDispatcher()
+func (s *serviceProvider) BatchOrderDispatcher() ports.BatchOrderDispatcher {
+	if s.batchOrderDispatcher == nil {
+		strategy := s.DispatchConfig().Strategy
+		if strategy == services.StrategyOptimal {
+			s.batchOrderDispatcher = services.NewBatchCourierDispatcher(s.RoadNetwork())
+		} else {
+			batchOrderDispatcher, err := s.DispatchStrategyRegistry().Dispatcher(strategy, s.RoadNetwork())
+			if err != nil {
+				log.Fatalf("failed to create batch order dispatcher: %v", err)
+			}
+
+			s.batchOrderDispatcher = batchOrderDispatcher
+		}
 	}
 
-	return s.orderDispatcher
+	return s.batchOrderDispatcher
+}
+
+func (s *serviceProvider) DispatchStrategyRegistry() *services.DispatchStrategyRegistry {
+	if s.dispatchStrategyRegistry == nil {
+		dispatchConfig := s.DispatchConfig()
+		s.dispatchStrategyRegistry = services.NewDefaultDispatchStrategyRegistry(dispatchConfig.TimeWeight, dispatchConfig.LoadWeight)
+	}
+
+	return s.dispatchStrategyRegistry
 }
 
 // Command Handlers
 
 func (s *serviceProvider) CreateOrderHandler() create_order.CreateOrderHandler {
 	if s.createOrderHandler == nil {
-		s.createOrderHandler = create_order.NewCreateOrderHandler(s.UOWFactory(), s.GeoClient())
+		s.createOrderHandler = create_order.NewCreateOrderHandler(s.UOWFactory(), s.GeoClient(), s.PickupLocation(), s.MapBounds(), s.RoadNetwork())
 	}
 
 	return s.createOrderHandler
@@ -178,7 +267,7 @@ func (s *serviceProvider) CreateOrderHandler() create_order.CreateOrderHandler {
 
 func (s *serviceProvider) CreateCourierHandler() create_courier.CreateCourierHandler {
 	if s.createeCourierHandler == nil {
-		s.createeCourierHandler = create_courier.NewCreateCourierHandler(s.UOWFactory())
+		s.createeCourierHandler = create_courier.NewCreateCourierHandler(s.UOWFactory(), s.MapBounds())
 	}
 
 	return s.createeCourierHandler
@@ -192,24 +281,64 @@ func (s *serviceProvider) AddStoragePlaceHandler() add_storage_place.AddStorageP
 	return s.addStoragePlaceHandler
 }
 
-func (s *serviceProvider) AssignOrderHandler() assign_order.AssignedOrderHandler {
-	if s.assignOrderHandler == nil {
-		s.assignOrderHandler = assign_order.NewAssignedOrderHandler(s.UOWFactory(), s.OrderDispatcher())
+func (s *serviceProvider) MoveCouriersAndCompleteOrderHandler() move_couriers_and_complete_order.MoveCouriersAndCompleteOrderHandler {
+	if s.moveCouriersAndCompleteOrderHandler == nil {
+		s.moveCouriersAndCompleteOrderHandler = move_couriers_and_complete_order.NewMoveCouriersAndCompleteOrderHandler(s.UOWFactory(), s.RoadNetwork())
 	}
 
-	return s.assignOrderHandler
+	return s.moveCouriersAndCompleteOrderHandler
 }
 
-func (s *serviceProvider) MoveCouriersAndCompleteOrderHandler() move_couriers_and_complete_order.MoveCouriersAndCompleteOrderHandler {
-	if s.moveCouriersAndCompleteOrderHandler == nil {
-		s.moveCouriersAndCompleteOrderHandler = move_couriers_and_complete_order.NewMoveCouriersAndCompleteOrderHandler(s.UOWFactory())
+func (s *serviceProvider) CancelOrderHandler() cancel_order.CancelOrderHandler {
+	if s.cancelOrderHandler == nil {
+		s.cancelOrderHandler = cancel_order.NewCancelOrderHandler(s.UOWFactory())
 	}
 
-	return s.moveCouriersAndCompleteOrderHandler
+	return s.cancelOrderHandler
+}
+
+func (s *serviceProvider) FlagOrdersAtRiskHandler() flag_orders_at_risk.FlagOrdersAtRiskHandler {
+	if s.flagOrdersAtRiskHandler == nil {
+		s.flagOrdersAtRiskHandler = flag_orders_at_risk.NewFlagOrdersAtRiskHandler(s.UOWFactory(), s.RoadNetwork())
+	}
+
+	return s.flagOrdersAtRiskHandler
+}
+
+func (s *serviceProvider) BatchAssignOrdersHandler() batch_assign_orders.BatchAssignOrdersHandler {
+	if s.batchAssignOrdersHandler == nil {
+		s.batchAssignOrdersHandler = batch_assign_orders.NewBatchAssignOrdersHandler(s.UOWFactory(), s.BatchOrderDispatcher())
+	}
+
+	return s.batchAssignOrdersHandler
 }
 
 // Query Handlers
 
+func (s *serviceProvider) StartCourierShiftHandler() start_courier_shift.StartCourierShiftHandler {
+	if s.startCourierShiftHandler == nil {
+		s.startCourierShiftHandler = start_courier_shift.NewStartCourierShiftHandler(s.UOWFactory())
+	}
+
+	return s.startCourierShiftHandler
+}
+
+func (s *serviceProvider) EndCourierShiftHandler() end_courier_shift.EndCourierShiftHandler {
+	if s.endCourierShiftHandler == nil {
+		s.endCourierShiftHandler = end_courier_shift.NewEndCourierShiftHandler(s.UOWFactory())
+	}
+
+	return s.endCourierShiftHandler
+}
+
+func (s *serviceProvider) TakeCourierBreakHandler() take_courier_break.TakeCourierBreakHandler {
+	if s.takeCourierBreakHandler == nil {
+		s.takeCourierBreakHandler = take_courier_break.NewTakeCourierBreakHandler(s.UOWFactory())
+	}
+
+	return s.takeCourierBreakHandler
+}
+
 func (s *serviceProvider) GetAllCouriersHandler() get_all_couriers.GetAllCouriersHandler {
 	if s.getAllCouriersHandler == nil {
 		s.getAllCouriersHandler = get_all_couriers.NewGetAllCouriersHandler(s.DB(), trmsqlx.DefaultCtxGetter)
@@ -226,6 +355,38 @@ func (s *serviceProvider) GetAllUncompletedOrdersHandler() get_all_uncompleted_o
 	return s.getAllUncompletedOrdersHandler
 }
 
+func (s *serviceProvider) GetCourierHandler() get_courier.GetCourierHandler {
+	if s.getCourierHandler == nil {
+		s.getCourierHandler = get_courier.NewGetCourierHandler(s.DB(), trmsqlx.DefaultCtxGetter)
+	}
+
+	return s.getCourierHandler
+}
+
+func (s *serviceProvider) GetOrderHandler() get_order.GetOrderHandler {
+	if s.getOrderHandler == nil {
+		s.getOrderHandler = get_order.NewGetOrderHandler(s.DB(), trmsqlx.DefaultCtxGetter)
+	}
+
+	return s.getOrderHandler
+}
+
+func (s *serviceProvider) GetOrderDispatchDecisionsHandler() get_order_dispatch_decisions.GetOrderDispatchDecisionsHandler {
+	if s.getOrderDispatchDecisionsHandler == nil {
+		s.getOrderDispatchDecisionsHandler = get_order_dispatch_decisions.NewGetOrderDispatchDecisionsHandler(s.DB(), trmsqlx.DefaultCtxGetter)
+	}
+
+	return s.getOrderDispatchDecisionsHandler
+}
+
+func (s *serviceProvider) GetOrderStatusHistoryHandler() get_order_status_history.GetOrderStatusHistoryHandler {
+	if s.getOrderStatusHistoryHandler == nil {
+		s.getOrderStatusHistoryHandler = get_order_status_history.NewGetOrderStatusHistoryHandler(s.DB(), trmsqlx.DefaultCtxGetter)
+	}
+
+	return s.getOrderStatusHistoryHandler
+}
+
 func (s *serviceProvider) HttpConfig() *config.HttpConfig {
 	if s.httpConfig == nil {
 		httpConfig, err := config.NewHttpConfigSearcher().Get()
@@ -239,6 +400,100 @@ func (s *serviceProvider) HttpConfig() *config.HttpConfig {
 	return s.httpConfig
 }
 
+func (s *serviceProvider) GrpcConfig() *config.GrpcConfig {
+	if s.grpcConfig == nil {
+		grpcConfig, err := config.NewGrpcConfigSearcher().Get()
+		if err != nil {
+			log.Fatalf("failed to get grpc config: %v", err)
+		}
+
+		s.grpcConfig = grpcConfig
+	}
+
+	return s.grpcConfig
+}
+
+func (s *serviceProvider) DispatchConfig() *config.DispatchConfig {
+	if s.dispatchConfig == nil {
+		dispatchConfig, err := config.NewDispatchConfigSearcher().Get()
+		if err != nil {
+			log.Fatalf("failed to get dispatch config: %v", err)
+		}
+
+		s.dispatchConfig = dispatchConfig
+	}
+
+	return s.dispatchConfig
+}
+
+func (s *serviceProvider) MapConfig() *config.MapConfig {
+	if s.mapConfig == nil {
+		mapConfig, err := config.NewMapConfigSearcher().Get()
+		if err != nil {
+			log.Fatalf("failed to get map config: %v", err)
+		}
+
+		s.mapConfig = mapConfig
+	}
+
+	return s.mapConfig
+}
+
+func (s *serviceProvider) MapBounds() sharedKernel.MapBounds {
+	if s.mapBounds == nil {
+		mapConfig := s.MapConfig()
+
+		mapBounds, err := sharedKernel.NewMapBounds(mapConfig.MinX, mapConfig.MaxX, mapConfig.MinY, mapConfig.MaxY)
+		if err != nil {
+			log.Fatalf("invalid map bounds: %v", err)
+		}
+
+		s.mapBounds = &mapBounds
+	}
+
+	return *s.mapBounds
+}
+
+// RoadNetwork - дорожная сеть из файла ROAD_MAP_FILE, без файла - сеть без препятствий
+func (s *serviceProvider) RoadNetwork() sharedKernel.RoadNetwork {
+	if s.roadNetwork == nil {
+		roadMapFile := s.MapConfig().RoadMapFile
+		if roadMapFile == "" {
+			s.roadNetwork = sharedKernel.NewGridRoadNetwork()
+			return s.roadNetwork
+		}
+
+		roadGraph, err := roadmap.Load(roadMapFile, s.MapBounds())
+		if err != nil {
+			log.Fatalf("failed to load road network: %v", err)
+		}
+
+		log.Printf("loaded road network from %s", roadMapFile)
+		s.roadNetwork = roadGraph
+	}
+
+	return s.roadNetwork
+}
+
+// PickupLocation - склад из конфигурации, проверенный по границам карты
+func (s *serviceProvider) PickupLocation() sharedKernel.Location {
+	mapConfig := s.MapConfig()
+	if mapConfig.PickupX == nil || mapConfig.PickupY == nil {
+		return sharedKernel.Location{}
+	}
+
+	pickupLocation, err := s.MapBounds().NewLocation(*mapConfig.PickupX, *mapConfig.PickupY)
+	if err != nil {
+		log.Fatalf("invalid pickup location: %v", err)
+	}
+
+	return pickupLocation
+}
+
+func (s *serviceProvider) LocationBoundsRepo() *location_bounds.Repository {
+	return location_bounds.NewRepository(s.DB())
+}
+
 func (s *serviceProvider) GeoConfig() *config.GeoConfig {
 	if s.geoConfig == nil {
 		geoConfig, err := config.NewGeoConfigSearcher().Get()
@@ -256,15 +511,46 @@ func (s *serviceProvider) HttpHandlers() *httpv1.DeliveryService {
 	if s.httpHandlers == nil {
 		s.httpHandlers = httpv1.NewDeliveryService(
 			s.GetAllCouriersHandler(),
+			s.GetCourierHandler(),
 			s.CreateCourierHandler(),
+			s.AddStoragePlaceHandler(),
 			s.GetAllUncompletedOrdersHandler(),
 			s.CreateOrderHandler(),
+			s.GetOrderHandler(),
+			s.GetOrderDispatchDecisionsHandler(),
+			s.GetOrderStatusHistoryHandler(),
+			s.StartCourierShiftHandler(),
+			s.EndCourierShiftHandler(),
+			s.TakeCourierBreakHandler(),
+			s.TrackingHub(),
 		)
 	}
 
 	return s.httpHandlers
 }
 
+func (s *serviceProvider) GrpcHandlers() *grpcv1.DeliveryService {
+	if s.grpcHandlers == nil {
+		s.grpcHandlers = grpcv1.NewDeliveryService(
+			s.GetAllCouriersHandler(),
+			s.CreateCourierHandler(),
+			s.AddStoragePlaceHandler(),
+			s.GetAllUncompletedOrdersHandler(),
+			s.CreateOrderHandler(),
+			s.GetOrderHandler(),
+		)
+	}
+
+	return s.grpcHandlers
+}
+
+func (s *serviceProvider) TrackingHub() *tracking.Hub {
+	if s.trackingHub == nil {
+		s.trackingHub = tracking.NewHub()
+	}
+	return s.trackingHub
+}
+
 // Cron Jobs
 
 func (s *serviceProvider) MoveCouriersJob() cron.Job {
@@ -281,7 +567,7 @@ func (s *serviceProvider) MoveCouriersJob() cron.Job {
 
 func (s *serviceProvider) AssignOrdersJob() cron.Job {
 	if s.assignOrdersJob == nil {
-		job, err := crons.NewAssignOrdersJob(s.AssignOrderHandler())
+		job, err := crons.NewAssignOrdersJob(s.BatchAssignOrdersHandler())
 		if err != nil {
 			log.Fatalf("cannot create AssignOrdersJob: %v", err)
 		}
@@ -291,12 +577,48 @@ func (s *serviceProvider) AssignOrdersJob() cron.Job {
 	return s.assignOrdersJob
 }
 
+func (s *serviceProvider) OutboxJob() cron.Job {
+	if s.outboxJob == nil {
+		job, err := crons.NewOutboxJob(s.UOWFactory(), s.EventRegistry(), s.EventPublisher())
+		if err != nil {
+			log.Fatalf("cannot create OutboxJob: %v", err)
+		}
+		s.outboxJob = job
+	}
+
+	return s.outboxJob
+}
+
+func (s *serviceProvider) OutboxCleanupJob() cron.Job {
+	if s.outboxCleanupJob == nil {
+		job, err := crons.NewOutboxCleanupJob(s.UOWFactory())
+		if err != nil {
+			log.Fatalf("cannot create OutboxCleanupJob: %v", err)
+		}
+		s.outboxCleanupJob = job
+	}
+
+	return s.outboxCleanupJob
+}
+
+func (s *serviceProvider) FlagOrdersAtRiskJob() cron.Job {
+	if s.flagOrdersAtRiskJob == nil {
+		job, err := crons.NewFlagOrdersAtRiskJob(s.FlagOrdersAtRiskHandler())
+		if err != nil {
+			log.Fatalf("cannot create FlagOrdersAtRiskJob: %v", err)
+		}
+		s.flagOrdersAtRiskJob = job
+	}
+
+	return s.flagOrdersAtRiskJob
+}
+
 // External Clients
 
 func (s *serviceProvider) GeoClient() ports.GeoClient {
 	if s.geoClient == nil {
 		geoHost := s.GeoConfig().Address()
-		client, closerFunc := geo.NewGeoClient(geoHost)
+		client, closerFunc := geo.NewGeoClient(geoHost, geo.WithMapBounds(s.MapBounds()))
 
 		closer.Add(closerFunc)
 		s.geoClient = client
@@ -317,6 +639,32 @@ func (s *serviceProvider) KafkaConfig() *config.KafkaConfig {
 	return s.kafkaConfig
 }
 
+func (s *serviceProvider) KafkaRetryPolicy() retry.Policy {
+	retryPolicy := kafkaConsumerCommon.DefaultRetryPolicy()
+	retryPolicy.MaxAttempts = s.KafkaConfig().RetryMaxAttempts
+	retryPolicy.InitialBackoff = s.KafkaConfig().RetryInitialBackoff
+	retryPolicy.MaxBackoff = s.KafkaConfig().RetryMaxBackoff
+
+	return retryPolicy
+}
+
+func (s *serviceProvider) DeadLetterPublisher() *kafkaConsumerCommon.DeadLetterPublisher {
+	if s.deadLetterPublisher == nil {
+		publisher, err := kafkaConsumerCommon.NewDeadLetterPublisher(
+			[]string{s.KafkaConfig().Host},
+			s.KafkaConfig().DeadLetterTopicSuffix,
+		)
+		if err != nil {
+			log.Fatalf("failed to create dead-letter publisher: %v", err)
+		}
+
+		closer.Add(publisher.Close)
+		s.deadLetterPublisher = publisher
+	}
+
+	return s.deadLetterPublisher
+}
+
 func (s *serviceProvider) BasketConfirmedEventHandler() *kafka.BasketConfirmedEventHandler {
 	if s.basketConfirmedEventHandler == nil {
 		s.basketConfirmedEventHandler = kafka.NewBasketConfirmedEventHandler(s.CreateOrderHandler())
@@ -331,7 +679,12 @@ func (s *serviceProvider) BasketConfirmedConsumerGroup() *kafkaConsumerCommon.Ka
 			[]string{s.KafkaConfig().Host},
 			s.KafkaConfig().ConsumerGroup,
 			s.KafkaConfig().BasketConfirmedTopic,
-			s.BasketConfirmedEventHandler(),
+			kafkaConsumerCommon.NewInboxEventHandler[*basketpb.BasketConfirmedIntegrationEvent](
+				s.UOWFactory(),
+				s.BasketConfirmedEventHandler(),
+			),
+			s.KafkaRetryPolicy(),
+			s.DeadLetterPublisher(),
 		)
 		if err != nil {
 			log.Fatalf("failed to create basket confirmed consumer group: %v", err)
@@ -343,6 +696,37 @@ func (s *serviceProvider) BasketConfirmedConsumerGroup() *kafkaConsumerCommon.Ka
 	return s.basketConfirmedConsumerGroup
 }
 
+func (s *serviceProvider) BasketCancelledEventHandler() *kafka.BasketCancelledEventHandler {
+	if s.basketCancelledEventHandler == nil {
+		s.basketCancelledEventHandler = kafka.NewBasketCancelledEventHandler(s.CancelOrderHandler())
+	}
+
+	return s.basketCancelledEventHandler
+}
+
+func (s *serviceProvider) BasketCancelledConsumerGroup() *kafkaConsumerCommon.KafkaConsumer[*basketpb.BasketCancelledIntegrationEvent] {
+	if s.basketCancelledConsumerGroup == nil {
+		consumerGroup, err := kafkaConsumerCommon.NewKafkaConsumerGroup[*basketpb.BasketCancelledIntegrationEvent](
+			[]string{s.KafkaConfig().Host},
+			s.KafkaConfig().BasketCancelledConsumerGroup,
+			s.KafkaConfig().BasketCancelledTopic,
+			kafkaConsumerCommon.NewInboxEventHandler[*basketpb.BasketCancelledIntegrationEvent](
+				s.UOWFactory(),
+				s.BasketCancelledEventHandler(),
+			),
+			s.KafkaRetryPolicy(),
+			s.DeadLetterPublisher(),
+		)
+		if err != nil {
+			log.Fatalf("failed to create basket cancelled consumer group: %v", err)
+		}
+
+		s.basketCancelledConsumerGroup = consumerGroup
+	}
+
+	return s.basketCancelledConsumerGroup
+}
+
 func (s *serviceProvider) OrderCreatedHandler() *eventHandlers.OrderCreatedHandler {
 	if s.orderCreatedHandler == nil {
 		s.orderCreatedHandler = eventHandlers.NewOrderCreatedHandler(s.OrderCreatedProducer())
@@ -357,13 +741,72 @@ func (s *serviceProvider) OrderCompletedHandler() *eventHandlers.OrderCompletedH
 	return s.orderCompletedHandler
 }
 
-func (s *serviceProvider) EventPublisher() eventPublisher.EventPublisher {
+func (s *serviceProvider) OrderAssignedHandler() *eventHandlers.OrderAssignedHandler {
+	if s.orderAssignedHandler == nil {
+		s.orderAssignedHandler = eventHandlers.NewOrderAssignedHandler(s.OrderAssignedProducer())
+	}
+	return s.orderAssignedHandler
+}
+
+func (s *serviceProvider) OrderStatusChangedHandler() *eventHandlers.OrderStatusChangedHandler {
+	if s.orderStatusChangedHandler == nil {
+		s.orderStatusChangedHandler = eventHandlers.NewOrderStatusChangedHandler(s.OrderStatusChangedProducer())
+	}
+	return s.orderStatusChangedHandler
+}
+
+func (s *serviceProvider) CourierMovedHandler() *eventHandlers.CourierMovedHandler {
+	if s.courierMovedHandler == nil {
+		s.courierMovedHandler = eventHandlers.NewCourierMovedHandler(s.CourierMovedProducer())
+	}
+	return s.courierMovedHandler
+}
+
+// CourierLocationChangedHandler - перемещения курьера кроме трекинга уходят в Kafka
+func (s *serviceProvider) CourierLocationChangedHandler() *eventHandlers.CourierMovedHandler {
+	if s.courierLocationChangedHandler == nil {
+		s.courierLocationChangedHandler = eventHandlers.NewCourierMovedHandler(s.CourierLocationChangedProducer())
+	}
+	return s.courierLocationChangedHandler
+}
+
+func (s *serviceProvider) EventPublisher() *eventPublisher.EventDispatcher {
 	if s.eventPublisher == nil {
-		s.eventPublisher = eventPublisher.NewEventPublisher()
+		s.eventPublisher = eventPublisher.NewEventDispatcher()
 	}
 	return s.eventPublisher
 }
 
+func (s *serviceProvider) EventRegistry() outbox.EventRegistry {
+	if s.eventRegistry == nil {
+		eventRegistry, err := outbox.NewEventRegistry()
+		if err != nil {
+			log.Fatalf("failed to create event registry: %v", err)
+		}
+
+		domainEvents := []ddd.DomainEvent{
+			&event.OrderCreated{},
+			&event.OrderCompleted{},
+			&event.OrderAssigned{},
+			&event.OrderCancelled{},
+			&event.OrderDeliveryAtRisk{},
+			&event.OrderStatusChanged{},
+			&event.CourierCreated{},
+			&event.StoragePlaceAdded{},
+			&event.OrderTaken{},
+			&event.OrderDelivered{},
+		}
+		for _, domainEvent := range domainEvents {
+			if err := eventRegistry.RegisterDomainEvent(reflect.TypeOf(domainEvent)); err != nil {
+				log.Fatalf("failed to register domain event: %v", err)
+			}
+		}
+
+		s.eventRegistry = eventRegistry
+	}
+	return s.eventRegistry
+}
+
 func (s *serviceProvider) FromOrderCreatedToIntegrationMapper() *mapper.OrderCreatedMapper {
 	if s.fromOrderCreatedToIntegrationMapper == nil {
 		s.fromOrderCreatedToIntegrationMapper = mapper.NewOrderCreatedMapper()
@@ -378,6 +821,20 @@ func (s *serviceProvider) FromOrderCompletedToIntegrationMapper() *mapper.OrderC
 	return s.fromOrderCompletedToIntegrationMapper
 }
 
+func (s *serviceProvider) FromOrderAssignedToIntegrationMapper() *mapper.OrderAssignedMapper {
+	if s.fromOrderAssignedToIntegrationMapper == nil {
+		s.fromOrderAssignedToIntegrationMapper = mapper.NewOrderAssignedMapper()
+	}
+	return s.fromOrderAssignedToIntegrationMapper
+}
+
+func (s *serviceProvider) FromCourierLocationChangedToIntegrationMapper() *mapper.CourierLocationChangedMapper {
+	if s.fromCourierLocationChangedToIntegrationMapper == nil {
+		s.fromCourierLocationChangedToIntegrationMapper = mapper.NewCourierLocationChangedMapper()
+	}
+	return s.fromCourierLocationChangedToIntegrationMapper
+}
+
 func (s *serviceProvider) OrderCreatedProducer() ports.EventProducer[*event.OrderCreated] {
 	if s.orderCreatedProducer == nil {
 		producer, err := kafkaProducerCommon.NewKafkaProducer[
@@ -415,3 +872,67 @@ func (s *serviceProvider) OrderCompletedProducer() ports.EventProducer[*event.Or
 	}
 	return s.orderCompletedProducer
 }
+
+func (s *serviceProvider) OrderAssignedProducer() ports.EventProducer[*event.OrderAssigned] {
+	if s.orderAssignedProducer == nil {
+		producer, err := kafkaProducerCommon.NewKafkaProducer[
+			*event.OrderAssigned,
+			*orderpb.OrderAssignedIntegrationEvent,
+			*mapper.OrderAssignedMapper,
+		](
+			[]string{s.KafkaConfig().Host},
+			s.KafkaConfig().OrderAssignedTopic,
+			s.FromOrderAssignedToIntegrationMapper(),
+		)
+		if err != nil {
+			log.Fatalf("failed to create order assigned producer: %v", err)
+		}
+		s.orderAssignedProducer = producer
+	}
+	return s.orderAssignedProducer
+}
+
+func (s *serviceProvider) CourierLocationChangedProducer() ports.EventProducer[*event.CourierMoved] {
+	if s.courierLocationChangedProducer == nil {
+		producer, err := kafkaProducerCommon.NewKafkaProducer[
+			*event.CourierMoved,
+			*courierpb.CourierLocationChangedIntegrationEvent,
+			*mapper.CourierLocationChangedMapper,
+		](
+			[]string{s.KafkaConfig().Host},
+			s.KafkaConfig().CourierLocationChangedTopic,
+			s.FromCourierLocationChangedToIntegrationMapper(),
+		)
+		if err != nil {
+			log.Fatalf("failed to create courier location changed producer: %v", err)
+		}
+
+		// Курьеры двигаются каждую секунду, в Kafka уходит только последнее положение курьера
+		coalescing, err := kafkaProducerCommon.NewCoalescingProducer[*event.CourierMoved](
+			producer,
+			func(e *event.CourierMoved) string { return e.CourierID.String() },
+			s.KafkaConfig().CourierLocationFlushInterval,
+		)
+		if err != nil {
+			log.Fatalf("failed to create courier location changed producer: %v", err)
+		}
+
+		closer.Add(coalescing.Close)
+		s.courierLocationChangedProducer = coalescing
+	}
+	return s.courierLocationChangedProducer
+}
+
+func (s *serviceProvider) CourierMovedProducer() ports.EventProducer[*event.CourierMoved] {
+	if s.courierMovedProducer == nil {
+		s.courierMovedProducer = tracking.NewCourierMovedProducer(s.TrackingHub())
+	}
+	return s.courierMovedProducer
+}
+
+func (s *serviceProvider) OrderStatusChangedProducer() ports.EventProducer[*event.OrderStatusChanged] {
+	if s.orderStatusChangedProducer == nil {
+		s.orderStatusChangedProducer = tracking.NewOrderStatusChangedProducer(s.TrackingHub())
+	}
+	return s.orderStatusChangedProducer
+}
@@ -1,9 +1,11 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -16,6 +18,10 @@ type HttpConfigSearcher interface {
 	Get() (*HttpConfig, error)
 }
 
+type GrpcConfigSearcher interface {
+	Get() (*GrpcConfig, error)
+}
+
 type GeoConfigSearcher interface {
 	Get() (*GeoConfig, error)
 }
@@ -24,6 +30,14 @@ type KafkaConfigSearcher interface {
 	Get() (*KafkaConfig, error)
 }
 
+type DispatchConfigSearcher interface {
+	Get() (*DispatchConfig, error)
+}
+
+type MapConfigSearcher interface {
+	Get() (*MapConfig, error)
+}
+
 func Load(path string) error {
 	err := godotenv.Load(path)
 	if err != nil {
@@ -34,10 +48,25 @@ func Load(path string) error {
 }
 
 type KafkaConfig struct {
-	Host                 string
-	ConsumerGroup        string
-	BasketConfirmedTopic string
-	OrderChangedTopic    string
+	Host                        string
+	ConsumerGroup               string
+	BasketConfirmedTopic        string
+	BasketCancelledTopic        string
+	OrderChangedTopic           string
+	OrderAssignedTopic          string
+	CourierLocationChangedTopic string
+
+	// Отдельная группа для отмен корзин, чтобы ребалансировка одного потребителя не останавливала другой
+	BasketCancelledConsumerGroup string
+
+	// Перемещения курьеров копятся и раз в CourierLocationFlushInterval отправляются последним положением каждого курьера
+	CourierLocationFlushInterval time.Duration
+
+	// Повторы обработки входящих сообщений и dead-letter топик <topic><DeadLetterTopicSuffix>
+	RetryMaxAttempts      int
+	RetryInitialBackoff   time.Duration
+	RetryMaxBackoff       time.Duration
+	DeadLetterTopicSuffix string
 }
 
 type PgConfig struct {
@@ -64,6 +93,15 @@ func (cfg *HttpConfig) Address() string {
 	return fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 }
 
+type GrpcConfig struct {
+	Host string
+	Port int
+}
+
+func (cfg *GrpcConfig) Address() string {
+	return fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+}
+
 type GeoConfig struct {
 	Host string
 }
@@ -72,6 +110,28 @@ func (cfg *GeoConfig) Address() string {
 	return cfg.Host
 }
 
+// DispatchConfig - стратегия назначения заказов и веса для стратегии weighted
+type DispatchConfig struct {
+	Strategy   string
+	TimeWeight float64
+	LoadWeight float64
+}
+
+// MapConfig - границы карты. ClampExistingLocations разрешает при старте перенести
+// сохраненные координаты, которые не попадают в новые границы, на ближайшую допустимую точку.
+type MapConfig struct {
+	MinX                   int64
+	MaxX                   int64
+	MinY                   int64
+	MaxY                   int64
+	ClampExistingLocations bool
+	// RoadMapFile - файл с дорожной сетью. Если не задан, курьеры ходят по клеткам без препятствий.
+	RoadMapFile string
+	// PickupX, PickupY - склад, откуда курьеры забирают заказы. Если не заданы, заказ везут клиенту сразу.
+	PickupX *int64
+	PickupY *int64
+}
+
 type envHttpConfigSearcher struct{}
 
 func NewHttpConfigSearcher() HttpConfigSearcher {
@@ -100,6 +160,34 @@ func (e *envHttpConfigSearcher) Get() (*HttpConfig, error) {
 	}, nil
 }
 
+type envGrpcConfigSearcher struct{}
+
+func NewGrpcConfigSearcher() GrpcConfigSearcher {
+	return &envGrpcConfigSearcher{}
+}
+
+func (e *envGrpcConfigSearcher) Get() (*GrpcConfig, error) {
+	host := os.Getenv("GRPC_HOST")
+	if host == "" {
+		host = "localhost"
+	}
+
+	portStr := os.Getenv("GRPC_PORT")
+	if portStr == "" {
+		portStr = "9090"
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GRPC_PORT: %w", err)
+	}
+
+	return &GrpcConfig{
+		Host: host,
+		Port: port,
+	}, nil
+}
+
 type envGeoConfigSearcher struct{}
 
 func NewGeoConfigSearcher() GeoConfigSearcher {
@@ -116,3 +204,146 @@ func (e *envGeoConfigSearcher) Get() (*GeoConfig, error) {
 		Host: host,
 	}, nil
 }
+
+type envDispatchConfigSearcher struct{}
+
+func NewDispatchConfigSearcher() DispatchConfigSearcher {
+	return &envDispatchConfigSearcher{}
+}
+
+func (e *envDispatchConfigSearcher) Get() (*DispatchConfig, error) {
+	strategy := os.Getenv("DISPATCH_STRATEGY")
+	if strategy == "" {
+		strategy = "optimal"
+	}
+
+	timeWeight, err := getEnvFloat("DISPATCH_WEIGHT_TIME", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	loadWeight, err := getEnvFloat("DISPATCH_WEIGHT_LOAD", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DispatchConfig{
+		Strategy:   strategy,
+		TimeWeight: timeWeight,
+		LoadWeight: loadWeight,
+	}, nil
+}
+
+type envMapConfigSearcher struct{}
+
+func NewMapConfigSearcher() MapConfigSearcher {
+	return &envMapConfigSearcher{}
+}
+
+func (e *envMapConfigSearcher) Get() (*MapConfig, error) {
+	minX, err := getEnvInt64("MAP_MIN_X", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	maxX, err := getEnvInt64("MAP_MAX_X", 10)
+	if err != nil {
+		return nil, err
+	}
+
+	minY, err := getEnvInt64("MAP_MIN_Y", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	maxY, err := getEnvInt64("MAP_MAX_Y", 10)
+	if err != nil {
+		return nil, err
+	}
+
+	clamp := false
+	if clampStr := os.Getenv("MAP_CLAMP_EXISTING_LOCATIONS"); clampStr != "" {
+		clamp, err = strconv.ParseBool(clampStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MAP_CLAMP_EXISTING_LOCATIONS: %w", err)
+		}
+	}
+
+	pickupX, err := getEnvOptionalInt64("PICKUP_X")
+	if err != nil {
+		return nil, err
+	}
+
+	pickupY, err := getEnvOptionalInt64("PICKUP_Y")
+	if err != nil {
+		return nil, err
+	}
+
+	if (pickupX == nil) != (pickupY == nil) {
+		return nil, errors.New("PICKUP_X and PICKUP_Y must be set together")
+	}
+
+	if minX > maxX {
+		return nil, fmt.Errorf("invalid map bounds: MAP_MIN_X (%d) is greater than MAP_MAX_X (%d)", minX, maxX)
+	}
+
+	if minY > maxY {
+		return nil, fmt.Errorf("invalid map bounds: MAP_MIN_Y (%d) is greater than MAP_MAX_Y (%d)", minY, maxY)
+	}
+
+	return &MapConfig{
+		MinX:                   minX,
+		MaxX:                   maxX,
+		MinY:                   minY,
+		MaxY:                   maxY,
+		ClampExistingLocations: clamp,
+		RoadMapFile:            os.Getenv("ROAD_MAP_FILE"),
+		PickupX:                pickupX,
+		PickupY:                pickupY,
+	}, nil
+}
+
+func getEnvInt64(key string, defaultValue int64) (int64, error) {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+func getEnvOptionalInt64(key string) (*int64, error) {
+	if os.Getenv(key) == "" {
+		return nil, nil
+	}
+
+	value, err := getEnvInt64(key, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+func getEnvFloat(key string, defaultValue float64) (float64, error) {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	if value < 0 {
+		return 0, fmt.Errorf("invalid %s: must not be negative", key)
+	}
+
+	return value, nil
+}
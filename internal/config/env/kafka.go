@@ -3,14 +3,33 @@ package env
 import (
 	"delivery/internal/config"
 	"errors"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 const (
 	kafkaHost                 = "KAFKA_HOST"
 	kafkaConsumerGroup        = "KAFKA_CONSUMER_GROUP"
+	kafkaCancelledGroup       = "KAFKA_BASKET_CANCELLED_CONSUMER_GROUP"
 	kafkaBasketConfirmedTopic = "KAFKA_BASKET_CONFIRMED_TOPIC"
+	kafkaBasketCancelledTopic = "KAFKA_BASKET_CANCELLED_TOPIC"
 	kafkaOrderChangedTopic    = "KAFKA_ORDER_CHANGED_TOPIC"
+	kafkaOrderAssignedTopic   = "KAFKA_ORDER_ASSIGNED_TOPIC"
+	kafkaCourierLocationTopic = "KAFKA_COURIER_LOCATION_CHANGED_TOPIC"
+	kafkaRetryMaxAttempts     = "KAFKA_RETRY_MAX_ATTEMPTS"
+	kafkaRetryInitialBackoff  = "KAFKA_RETRY_INITIAL_BACKOFF"
+	kafkaRetryMaxBackoff      = "KAFKA_RETRY_MAX_BACKOFF"
+	kafkaDeadLetterSuffix     = "KAFKA_DEAD_LETTER_TOPIC_SUFFIX"
+	kafkaCourierLocationFlush = "KAFKA_COURIER_LOCATION_FLUSH_INTERVAL"
+
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 200 * time.Millisecond
+	defaultRetryMaxBackoff     = 5 * time.Second
+	defaultDeadLetterSuffix    = ".dlq"
+	defaultLocationFlush       = 5 * time.Second
+	defaultCancelledGroupTail  = "-basket-cancelled"
 )
 
 type KafkaCfgSearcher struct{}
@@ -30,20 +49,93 @@ func (k *KafkaCfgSearcher) Get() (*config.KafkaConfig, error) {
 		return nil, errors.New("KAFKA_CONSUMER_GROUP is not set")
 	}
 
+	basketCancelledConsumerGroup := os.Getenv(kafkaCancelledGroup)
+	if len(basketCancelledConsumerGroup) == 0 {
+		basketCancelledConsumerGroup = consumerGroup + defaultCancelledGroupTail
+	}
+
 	basketConfirmedTopic := os.Getenv(kafkaBasketConfirmedTopic)
 	if len(basketConfirmedTopic) == 0 {
 		return nil, errors.New("KAFKA_BASKET_CONFIRMED_TOPIC is not set")
 	}
 
+	basketCancelledTopic := os.Getenv(kafkaBasketCancelledTopic)
+	if len(basketCancelledTopic) == 0 {
+		return nil, errors.New("KAFKA_BASKET_CANCELLED_TOPIC is not set")
+	}
+
 	orderChangedTopic := os.Getenv(kafkaOrderChangedTopic)
 	if len(orderChangedTopic) == 0 {
 		return nil, errors.New("KAFKA_ORDER_CHANGED_TOPIC is not set")
 	}
 
+	orderAssignedTopic := os.Getenv(kafkaOrderAssignedTopic)
+	if len(orderAssignedTopic) == 0 {
+		return nil, errors.New("KAFKA_ORDER_ASSIGNED_TOPIC is not set")
+	}
+
+	courierLocationChangedTopic := os.Getenv(kafkaCourierLocationTopic)
+	if len(courierLocationChangedTopic) == 0 {
+		return nil, errors.New("KAFKA_COURIER_LOCATION_CHANGED_TOPIC is not set")
+	}
+
+	retryMaxAttempts := defaultRetryMaxAttempts
+	if value := os.Getenv(kafkaRetryMaxAttempts); len(value) != 0 {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return nil, fmt.Errorf("invalid %s: %q", kafkaRetryMaxAttempts, value)
+		}
+		retryMaxAttempts = parsed
+	}
+
+	retryInitialBackoff, err := getDuration(kafkaRetryInitialBackoff, defaultRetryInitialBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	retryMaxBackoff, err := getDuration(kafkaRetryMaxBackoff, defaultRetryMaxBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	courierLocationFlushInterval, err := getDuration(kafkaCourierLocationFlush, defaultLocationFlush)
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetterSuffix := os.Getenv(kafkaDeadLetterSuffix)
+	if len(deadLetterSuffix) == 0 {
+		deadLetterSuffix = defaultDeadLetterSuffix
+	}
+
 	return &config.KafkaConfig{
-		Host:                 host,
-		ConsumerGroup:        consumerGroup,
-		BasketConfirmedTopic: basketConfirmedTopic,
-		OrderChangedTopic:    orderChangedTopic,
+		Host:                         host,
+		ConsumerGroup:                consumerGroup,
+		BasketCancelledConsumerGroup: basketCancelledConsumerGroup,
+		BasketConfirmedTopic:         basketConfirmedTopic,
+		BasketCancelledTopic:         basketCancelledTopic,
+		OrderChangedTopic:            orderChangedTopic,
+		OrderAssignedTopic:           orderAssignedTopic,
+		CourierLocationChangedTopic:  courierLocationChangedTopic,
+		CourierLocationFlushInterval: courierLocationFlushInterval,
+		RetryMaxAttempts:             retryMaxAttempts,
+		RetryInitialBackoff:          retryInitialBackoff,
+		RetryMaxBackoff:              retryMaxBackoff,
+		DeadLetterTopicSuffix:        deadLetterSuffix,
 	}, nil
 }
+
+// getDuration - значение в формате time.ParseDuration, например 200ms или 5s
+func getDuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if len(value) == 0 {
+		return defaultValue, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", key, value)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,22 @@
+package event_handlers
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/core/ports"
+)
+
+type CourierMovedHandler struct {
+	producer ports.EventProducer[*event.CourierMoved]
+}
+
+func NewCourierMovedHandler(producer ports.EventProducer[*event.CourierMoved]) *CourierMovedHandler {
+	return &CourierMovedHandler{
+		producer: producer,
+	}
+}
+
+// Handle - курьеры двигаются каждую секунду, поэтому перемещения не логируются
+func (h *CourierMovedHandler) Handle(ctx context.Context, event *event.CourierMoved) error {
+	return h.producer.Publish(ctx, event)
+}
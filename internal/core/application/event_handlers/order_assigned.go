@@ -0,0 +1,23 @@
+package event_handlers
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/core/ports"
+	"log"
+)
+
+type OrderAssignedHandler struct {
+	producer ports.EventProducer[*event.OrderAssigned]
+}
+
+func NewOrderAssignedHandler(producer ports.EventProducer[*event.OrderAssigned]) *OrderAssignedHandler {
+	return &OrderAssignedHandler{
+		producer: producer,
+	}
+}
+
+func (h *OrderAssignedHandler) Handle(ctx context.Context, event *event.OrderAssigned) error {
+	log.Printf("Order assigned: %v", event)
+	return h.producer.Publish(ctx, event)
+}
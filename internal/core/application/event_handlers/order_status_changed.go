@@ -0,0 +1,23 @@
+package event_handlers
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/core/ports"
+	"log"
+)
+
+type OrderStatusChangedHandler struct {
+	producer ports.EventProducer[*event.OrderStatusChanged]
+}
+
+func NewOrderStatusChangedHandler(producer ports.EventProducer[*event.OrderStatusChanged]) *OrderStatusChangedHandler {
+	return &OrderStatusChangedHandler{
+		producer: producer,
+	}
+}
+
+func (h *OrderStatusChangedHandler) Handle(ctx context.Context, event *event.OrderStatusChanged) error {
+	log.Printf("Order status changed: %v", event)
+	return h.producer.Publish(ctx, event)
+}
@@ -0,0 +1,19 @@
+package batch_assign_orders
+
+type BatchAssignOrdersCommand struct {
+	isValid bool
+}
+
+func NewBatchAssignOrdersCommand() BatchAssignOrdersCommand {
+	return BatchAssignOrdersCommand{
+		isValid: true,
+	}
+}
+
+func (c BatchAssignOrdersCommand) CommandName() string {
+	return "BatchAssignOrdersCommand"
+}
+
+func (c BatchAssignOrdersCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,125 @@
+package batch_assign_orders
+
+import (
+	"context"
+	"errors"
+
+	modelCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/audit"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ordersBatchSize - сколько заказов распределяется за один запуск
+const ordersBatchSize = 1000
+
+type BatchAssignOrdersHandler interface {
+	Handle(ctx context.Context, command BatchAssignOrdersCommand) error
+}
+
+var _ BatchAssignOrdersHandler = (*batchAssignOrdersHandler)(nil)
+
+type batchAssignOrdersHandler struct {
+	uowFactory           ports.UnitOfWorkFactory
+	batchOrderDispatcher ports.BatchOrderDispatcher
+}
+
+func NewBatchAssignOrdersHandler(uowFactory ports.UnitOfWorkFactory, batchOrderDispatcher ports.BatchOrderDispatcher) BatchAssignOrdersHandler {
+	return &batchAssignOrdersHandler{
+		uowFactory:           uowFactory,
+		batchOrderDispatcher: batchOrderDispatcher,
+	}
+}
+
+func (h *batchAssignOrdersHandler) Handle(ctx context.Context, command BatchAssignOrdersCommand) error {
+	if !command.IsValid() {
+		return errs.NewCommandIsInvalidErrorWithCause(command.CommandName(), errors.New("should use NewBatchAssignOrdersCommand to create a command"))
+	}
+
+	ctx = audit.WithCommand(ctx, command.CommandName())
+
+	uow := h.uowFactory.NewUOW()
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orders, uowErr := uow.OrderRepo().GetAllInCreatedStatus(ctx, ordersBatchSize)
+		if uowErr != nil {
+			return uowErr
+		}
+
+		if len(orders) == 0 {
+			return nil
+		}
+
+		couriers, uowErr := uow.CourierRepo().GetAllFreeCouriers(ctx)
+		if uowErr != nil {
+			return uowErr
+		}
+
+		assignedOrders, decisions, uowErr := h.batchOrderDispatcher.DispatchAll(orders, couriers)
+		if uowErr != nil {
+			return uowErr
+		}
+
+		orderIDs := make([]uuid.UUID, 0, len(orders))
+		for _, order := range orders {
+			orderIDs = append(orderIDs, order.ID())
+		}
+
+		latestDecisions, uowErr := uow.DispatchDecisionRepo().GetLatestByOrderIDs(ctx, orderIDs)
+		if uowErr != nil {
+			return uowErr
+		}
+
+		// Неназначенный заказ пересматривается на каждом запуске, решение сохраняется только если результат изменился
+		for _, decision := range decisions {
+			if decision.HasSameOutcomeAs(latestDecisions[decision.OrderID()]) {
+				continue
+			}
+
+			if uowErr := uow.DispatchDecisionRepo().Add(ctx, decision); uowErr != nil {
+				return uowErr
+			}
+		}
+
+		couriersByID := make(map[uuid.UUID]*modelCourier.Courier, len(couriers))
+		for _, courier := range couriers {
+			couriersByID[courier.ID()] = courier
+		}
+
+		// Курьер может получить несколько заказов за запуск, но сохраняется один раз:
+		// каждое сохранение увеличивает версию, и повторное упало бы на проверке версии
+		assignedCouriers := make([]*modelCourier.Courier, 0, len(assignedOrders))
+		updatedCourierIDs := make(map[uuid.UUID]struct{}, len(assignedOrders))
+		for _, order := range assignedOrders {
+			if uowErr := uow.OrderRepo().Update(ctx, order); uowErr != nil {
+				return uowErr
+			}
+
+			courier, ok := couriersByID[*order.CourierID()]
+			if !ok {
+				return errs.NewObjectNotFoundError("courier", *order.CourierID())
+			}
+
+			if _, ok := updatedCourierIDs[courier.ID()]; ok {
+				continue
+			}
+			updatedCourierIDs[courier.ID()] = struct{}{}
+			assignedCouriers = append(assignedCouriers, courier)
+		}
+
+		for _, courier := range assignedCouriers {
+			if uowErr := uow.CourierRepo().Update(ctx, courier); uowErr != nil {
+				return uowErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
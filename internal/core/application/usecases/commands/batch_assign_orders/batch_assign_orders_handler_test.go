@@ -0,0 +1,290 @@
+package batch_assign_orders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBatchAssignOrdersHandler_Handle_UpdatesAssignedOrdersAndCouriers(t *testing.T) {
+	// Arrange
+	testOrders := []*order.Order{newValidOrder(t), newValidOrder(t)}
+	testCouriers := []*courier.Courier{newValidCourier(t), newValidCourier(t)}
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllInCreatedStatus(mock.Anything, uint64(ordersBatchSize)).Return(testOrders, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrders[0]).Return(nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().GetAllFreeCouriers(mock.Anything).Return(testCouriers, nil)
+	mockCourierRepo.EXPECT().Update(mock.Anything, testCouriers[1]).Return(nil)
+
+	decisions := []*dispatch.Decision{newDecision(t, testOrders[0]), newDecision(t, testOrders[1])}
+
+	// Диспетчер назначает только первый заказ второму курьеру
+	mockDispatcher := mocks.NewBatchOrderDispatcher(t)
+	mockDispatcher.EXPECT().DispatchAll(testOrders, testCouriers).RunAndReturn(
+		func(orders []*order.Order, couriers []*courier.Courier) ([]*order.Order, []*dispatch.Decision, error) {
+			_ = couriers[1].TakeOrder(shared_kernel.NewGridRoadNetwork(), orders[0])
+			_ = orders[0].Assign(couriers[1].ID())
+			return []*order.Order{orders[0]}, decisions, nil
+		},
+	)
+
+	// Решения сохраняются для всех заказов, в том числе не назначенных
+	mockDispatchDecisionRepo := mocks.NewDispatchDecisionRepo(t)
+	mockDispatchDecisionRepo.EXPECT().GetLatestByOrderIDs(mock.Anything, []uuid.UUID{testOrders[0].ID(), testOrders[1].ID()}).Return(map[uuid.UUID]*dispatch.Decision{}, nil)
+	mockDispatchDecisionRepo.EXPECT().Add(mock.Anything, decisions[0]).Return(nil)
+	mockDispatchDecisionRepo.EXPECT().Add(mock.Anything, decisions[1]).Return(nil)
+
+	mockUoW := setupSuccessfulUoWForBatchAssignment(t, mockOrderRepo, mockCourierRepo)
+	mockUoW.EXPECT().DispatchDecisionRepo().Return(mockDispatchDecisionRepo)
+	handler := NewBatchAssignOrdersHandler(setupUoWFactoryForBatchAssignment(t, mockUoW), mockDispatcher)
+
+	// Act
+	err := handler.Handle(context.Background(), NewBatchAssignOrdersCommand())
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestBatchAssignOrdersHandler_Handle_UpdatesCourierWithSeveralOrdersOnce(t *testing.T) {
+	// Arrange
+	testOrders := []*order.Order{newValidOrder(t), newValidOrder(t)}
+	testCourier := newValidCourier(t)
+	_ = testCourier.AddStoragePlace("Багажник", 30)
+	testCouriers := []*courier.Courier{testCourier}
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllInCreatedStatus(mock.Anything, uint64(ordersBatchSize)).Return(testOrders, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrders[0]).Return(nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrders[1]).Return(nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().GetAllFreeCouriers(mock.Anything).Return(testCouriers, nil)
+	mockCourierRepo.EXPECT().Update(mock.Anything, testCourier).Return(nil).Once()
+
+	decisions := []*dispatch.Decision{newDecision(t, testOrders[0]), newDecision(t, testOrders[1])}
+
+	// Диспетчер назначает оба заказа одному курьеру
+	mockDispatcher := mocks.NewBatchOrderDispatcher(t)
+	mockDispatcher.EXPECT().DispatchAll(testOrders, testCouriers).RunAndReturn(
+		func(orders []*order.Order, couriers []*courier.Courier) ([]*order.Order, []*dispatch.Decision, error) {
+			for _, o := range orders {
+				_ = couriers[0].TakeOrder(shared_kernel.NewGridRoadNetwork(), o)
+				_ = o.Assign(couriers[0].ID())
+			}
+			return orders, decisions, nil
+		},
+	)
+
+	mockDispatchDecisionRepo := mocks.NewDispatchDecisionRepo(t)
+	mockDispatchDecisionRepo.EXPECT().GetLatestByOrderIDs(mock.Anything, mock.Anything).Return(map[uuid.UUID]*dispatch.Decision{}, nil)
+	mockDispatchDecisionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+
+	mockUoW := setupSuccessfulUoWForBatchAssignment(t, mockOrderRepo, mockCourierRepo)
+	mockUoW.EXPECT().DispatchDecisionRepo().Return(mockDispatchDecisionRepo)
+	handler := NewBatchAssignOrdersHandler(setupUoWFactoryForBatchAssignment(t, mockUoW), mockDispatcher)
+
+	// Act
+	err := handler.Handle(context.Background(), NewBatchAssignOrdersCommand())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, testCourier.ID(), *testOrders[0].CourierID())
+	assert.Equal(t, testCourier.ID(), *testOrders[1].CourierID())
+	mockCourierRepo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func TestBatchAssignOrdersHandler_Handle_SkipsDecisionWithUnchangedOutcome(t *testing.T) {
+	// Arrange
+	testOrders := []*order.Order{newValidOrder(t), newValidOrder(t)}
+	testCouriers := []*courier.Courier{newValidCourier(t)}
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllInCreatedStatus(mock.Anything, uint64(ordersBatchSize)).Return(testOrders, nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().GetAllFreeCouriers(mock.Anything).Return(testCouriers, nil)
+
+	// Для первого заказа результат не изменился с прошлого запуска, второй рассматривается впервые
+	previousDecision := newDecision(t, testOrders[0])
+	previousDecision.AddCandidate(dispatch.NewCandidate(testCouriers[0].ID(), 3, dispatch.RejectionReasonNoStoragePlace))
+	unchangedDecision := newDecision(t, testOrders[0])
+	unchangedDecision.AddCandidate(dispatch.NewCandidate(testCouriers[0].ID(), 2, dispatch.RejectionReasonNoStoragePlace))
+	newDecisionForOrder := newDecision(t, testOrders[1])
+	newDecisionForOrder.AddCandidate(dispatch.NewCandidate(testCouriers[0].ID(), 4, dispatch.RejectionReasonNoStoragePlace))
+
+	mockDispatcher := mocks.NewBatchOrderDispatcher(t)
+	mockDispatcher.EXPECT().DispatchAll(testOrders, testCouriers).Return([]*order.Order{}, []*dispatch.Decision{unchangedDecision, newDecisionForOrder}, nil)
+
+	mockDispatchDecisionRepo := mocks.NewDispatchDecisionRepo(t)
+	mockDispatchDecisionRepo.EXPECT().GetLatestByOrderIDs(mock.Anything, mock.Anything).Return(map[uuid.UUID]*dispatch.Decision{
+		testOrders[0].ID(): previousDecision,
+	}, nil)
+	mockDispatchDecisionRepo.EXPECT().Add(mock.Anything, newDecisionForOrder).Return(nil)
+
+	mockUoW := setupSuccessfulUoWForBatchAssignment(t, mockOrderRepo, mockCourierRepo)
+	mockUoW.EXPECT().DispatchDecisionRepo().Return(mockDispatchDecisionRepo)
+	handler := NewBatchAssignOrdersHandler(setupUoWFactoryForBatchAssignment(t, mockUoW), mockDispatcher)
+
+	// Act
+	err := handler.Handle(context.Background(), NewBatchAssignOrdersCommand())
+
+	// Assert
+	assert.NoError(t, err)
+	mockDispatchDecisionRepo.AssertNumberOfCalls(t, "Add", 1)
+}
+
+func TestBatchAssignOrdersHandler_Handle_NoOrdersToAssign(t *testing.T) {
+	// Arrange
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllInCreatedStatus(mock.Anything, uint64(ordersBatchSize)).Return(nil, nil)
+
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().OrderRepo().Return(mockOrderRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	handler := NewBatchAssignOrdersHandler(setupUoWFactoryForBatchAssignment(t, mockUoW), mocks.NewBatchOrderDispatcher(t))
+
+	// Act
+	err := handler.Handle(context.Background(), NewBatchAssignOrdersCommand())
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestBatchAssignOrdersHandler_Handle_InvalidCommand(t *testing.T) {
+	// Arrange
+	handler := NewBatchAssignOrdersHandler(mocks.NewUnitOfWorkFactory(t), mocks.NewBatchOrderDispatcher(t))
+
+	// Act
+	err := handler.Handle(context.Background(), BatchAssignOrdersCommand{})
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrCommandIsInvalid)
+}
+
+func TestBatchAssignOrdersHandler_Handle_DispatcherError(t *testing.T) {
+	// Arrange
+	testOrders := []*order.Order{newValidOrder(t)}
+	testCouriers := []*courier.Courier{newValidCourier(t)}
+	expectedError := errors.New("dispatch error")
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllInCreatedStatus(mock.Anything, uint64(ordersBatchSize)).Return(testOrders, nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().GetAllFreeCouriers(mock.Anything).Return(testCouriers, nil)
+
+	mockDispatcher := mocks.NewBatchOrderDispatcher(t)
+	mockDispatcher.EXPECT().DispatchAll(testOrders, testCouriers).Return(nil, nil, expectedError)
+
+	mockUoW := setupSuccessfulUoWForBatchAssignment(t, mockOrderRepo, mockCourierRepo)
+	handler := NewBatchAssignOrdersHandler(setupUoWFactoryForBatchAssignment(t, mockUoW), mockDispatcher)
+
+	// Act
+	err := handler.Handle(context.Background(), NewBatchAssignOrdersCommand())
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestBatchAssignOrdersHandler_Handle_GetOrdersError(t *testing.T) {
+	// Arrange
+	expectedError := errors.New("get orders error")
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllInCreatedStatus(mock.Anything, uint64(ordersBatchSize)).Return(nil, expectedError)
+
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().OrderRepo().Return(mockOrderRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	handler := NewBatchAssignOrdersHandler(setupUoWFactoryForBatchAssignment(t, mockUoW), mocks.NewBatchOrderDispatcher(t))
+
+	// Act
+	err := handler.Handle(context.Background(), NewBatchAssignOrdersCommand())
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+// Helper functions
+func setupSuccessfulUoWForBatchAssignment(t *testing.T, orderRepo *mocks.OrderRepo, courierRepo *mocks.CourierRepo) *mocks.UnitOfWork {
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().OrderRepo().Return(orderRepo)
+	mockUoW.EXPECT().CourierRepo().Return(courierRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	return mockUoW
+}
+
+func setupUoWFactoryForBatchAssignment(t *testing.T, uow *mocks.UnitOfWork) *mocks.UnitOfWorkFactory {
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(uow)
+	return mockUoWFactory
+}
+
+func newDecision(t *testing.T, testOrder *order.Order) *dispatch.Decision {
+	t.Helper()
+
+	decision, err := dispatch.NewDecision(testOrder.ID(), "optimal", time.Now())
+	if err != nil {
+		t.Fatalf("failed to create dispatch decision: %v", err)
+	}
+
+	return decision
+}
+
+func newValidOrder(t *testing.T) *order.Order {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testOrder, err := order.NewOrder(uuid.New(), location, 5)
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	return testOrder
+}
+
+func newValidCourier(t *testing.T) *courier.Courier {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testCourier, err := courier.NewCourier("Test Courier", 2, location)
+	if err != nil {
+		t.Fatalf("failed to create courier: %v", err)
+	}
+
+	return testCourier
+}
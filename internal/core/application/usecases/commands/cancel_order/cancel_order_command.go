@@ -0,0 +1,35 @@
+package cancel_order
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type CancelOrderCommand struct {
+	orderID uuid.UUID
+
+	isValid bool
+}
+
+func NewCancelOrderCommand(orderID uuid.UUID) (CancelOrderCommand, error) {
+	if orderID == uuid.Nil {
+		return CancelOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("orderID", errors.New("orderID is required"))
+	}
+
+	return CancelOrderCommand{orderID: orderID, isValid: true}, nil
+}
+
+func (c CancelOrderCommand) CommandName() string {
+	return "CancelOrderCommand"
+}
+
+func (c CancelOrderCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c CancelOrderCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
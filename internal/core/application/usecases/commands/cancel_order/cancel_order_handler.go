@@ -0,0 +1,84 @@
+package cancel_order
+
+import (
+	"context"
+	"errors"
+
+	modelOrder "delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/audit"
+	"delivery/internal/pkg/errs"
+)
+
+type CancelOrderHandler interface {
+	Handle(ctx context.Context, command CancelOrderCommand) error
+}
+
+var _ CancelOrderHandler = (*cancelOrderHandler)(nil)
+
+type cancelOrderHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewCancelOrderHandler(uowFactory ports.UnitOfWorkFactory) CancelOrderHandler {
+	return &cancelOrderHandler{uowFactory: uowFactory}
+}
+
+func (h *cancelOrderHandler) Handle(ctx context.Context, command CancelOrderCommand) error {
+	if !command.IsValid() {
+		return errs.NewCommandIsInvalidErrorWithCause(command.CommandName(), errors.New("should use NewCancelOrderCommand to create a command"))
+	}
+
+	ctx = audit.WithCommand(ctx, command.CommandName())
+
+	uow := h.uowFactory.NewUOW()
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		order, uowErr := uow.OrderRepo().Get(ctx, command.OrderID())
+		if uowErr != nil {
+			return uowErr
+		}
+
+		// Повторная отмена, например повтор события из Kafka, ничего не меняет
+		if order.Status().Equals(modelOrder.StatusCancelled) {
+			return nil
+		}
+
+		wasAssigned := order.Status().Equals(modelOrder.StatusAssigned)
+		if err := order.Cancel(); err != nil {
+			return err
+		}
+
+		// Назначенный заказ занимает место хранения у курьера, его нужно освободить.
+		// ETA отмененного заказа больше не нужна.
+		if wasAssigned {
+			courier, uowErr := uow.CourierRepo().Get(ctx, *order.CourierID())
+			if uowErr != nil {
+				return uowErr
+			}
+
+			if err := courier.UnassignOrder(order); err != nil {
+				return err
+			}
+
+			if uowErr := uow.CourierRepo().Update(ctx, courier); uowErr != nil {
+				return uowErr
+			}
+
+			if uowErr := uow.OrderEtaRepo().Delete(ctx, order.ID()); uowErr != nil {
+				return uowErr
+			}
+		}
+
+		if uowErr := uow.OrderRepo().Update(ctx, order); uowErr != nil {
+			return uowErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
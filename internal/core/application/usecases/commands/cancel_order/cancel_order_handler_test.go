@@ -0,0 +1,262 @@
+package cancel_order
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCancelOrderHandler_Handle_CancelCreatedOrder(t *testing.T) {
+	// Arrange
+	testOrder := newValidOrder(t)
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrder).Return(nil)
+
+	mockUoW := setupSuccessfulUoWForCancelOrder(t, mockOrderRepo, nil, nil)
+	mockUoWFactory := setupUoWFactoryForCancelOrder(t, mockUoW)
+
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := createValidCancelOrderCommand(testOrder.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, order.StatusCancelled, testOrder.Status())
+}
+
+func TestCancelOrderHandler_Handle_CancelAssignedOrderReleasesStoragePlace(t *testing.T) {
+	// Arrange
+	testOrder := newValidOrder(t)
+	testCourier := newValidCourier(t)
+	_ = testCourier.TakeOrder(shared_kernel.NewGridRoadNetwork(), testOrder)
+	_ = testOrder.Assign(testCourier.ID())
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrder).Return(nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+	mockCourierRepo.EXPECT().Update(mock.Anything, testCourier).Return(nil)
+
+	mockOrderEtaRepo := mocks.NewOrderEtaRepo(t)
+	mockOrderEtaRepo.EXPECT().Delete(mock.Anything, testOrder.ID()).Return(nil)
+
+	mockUoW := setupSuccessfulUoWForCancelOrder(t, mockOrderRepo, mockCourierRepo, mockOrderEtaRepo)
+	mockUoWFactory := setupUoWFactoryForCancelOrder(t, mockUoW)
+
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := createValidCancelOrderCommand(testOrder.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, order.StatusCancelled, testOrder.Status())
+	assert.Nil(t, testCourier.StoragePlaces()[0].OrderID())
+}
+
+func TestCancelOrderHandler_Handle_CannotCancelCompletedOrder(t *testing.T) {
+	// Arrange
+	testOrder := newValidOrder(t)
+	_ = testOrder.Assign(uuid.New())
+	_ = testOrder.Complete()
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+
+	mockUoW := setupSuccessfulUoWForCancelOrder(t, mockOrderRepo, nil, nil)
+	mockUoWFactory := setupUoWFactoryForCancelOrder(t, mockUoW)
+
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := createValidCancelOrderCommand(testOrder.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Equal(t, order.StatusCompleted, testOrder.Status())
+}
+
+func TestCancelOrderHandler_Handle_AlreadyCancelledOrderIsNoOp(t *testing.T) {
+	// Arrange
+	testOrder := newValidOrder(t)
+	_ = testOrder.Cancel()
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+
+	mockUoW := setupSuccessfulUoWForCancelOrder(t, mockOrderRepo, nil, nil)
+	mockUoWFactory := setupUoWFactoryForCancelOrder(t, mockUoW)
+
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := createValidCancelOrderCommand(testOrder.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, order.StatusCancelled, testOrder.Status())
+	mockOrderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestCancelOrderHandler_Handle_CannotCancelPickedUpOrder(t *testing.T) {
+	// Arrange
+	pickupLocation, _ := shared_kernel.NewLocation(1, 1)
+	testOrder := newValidOrder(t)
+	_ = testOrder.SetPickupLocation(pickupLocation)
+	_ = testOrder.Assign(uuid.New())
+	_ = testOrder.PickUp()
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+
+	mockUoW := setupSuccessfulUoWForCancelOrder(t, mockOrderRepo, nil, nil)
+	mockUoWFactory := setupUoWFactoryForCancelOrder(t, mockUoW)
+
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := createValidCancelOrderCommand(testOrder.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Equal(t, order.StatusPickedUp, testOrder.Status())
+}
+
+func TestCancelOrderHandler_Handle_InvalidCommand(t *testing.T) {
+	// Arrange
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := CancelOrderCommand{orderID: uuid.New(), isValid: false}
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrCommandIsInvalid)
+}
+
+func TestCancelOrderHandler_Handle_OrderNotFound(t *testing.T) {
+	// Arrange
+	orderID := uuid.New()
+	expectedError := errs.NewObjectNotFoundError("order", orderID)
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, orderID).Return(nil, expectedError)
+
+	mockUoW := setupSuccessfulUoWForCancelOrder(t, mockOrderRepo, nil, nil)
+	mockUoWFactory := setupUoWFactoryForCancelOrder(t, mockUoW)
+
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := createValidCancelOrderCommand(orderID)
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestCancelOrderHandler_Handle_OrderRepositoryUpdateError(t *testing.T) {
+	// Arrange
+	testOrder := newValidOrder(t)
+	expectedError := errors.New("update error")
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrder).Return(expectedError)
+
+	mockUoW := setupSuccessfulUoWForCancelOrder(t, mockOrderRepo, nil, nil)
+	mockUoWFactory := setupUoWFactoryForCancelOrder(t, mockUoW)
+
+	handler := NewCancelOrderHandler(mockUoWFactory)
+	command := createValidCancelOrderCommand(testOrder.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+// Helper functions
+func setupSuccessfulUoWForCancelOrder(t *testing.T, orderRepo *mocks.OrderRepo, courierRepo *mocks.CourierRepo, orderEtaRepo *mocks.OrderEtaRepo) *mocks.UnitOfWork {
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().OrderRepo().Return(orderRepo)
+	if courierRepo != nil {
+		mockUoW.EXPECT().CourierRepo().Return(courierRepo)
+	}
+	if orderEtaRepo != nil {
+		mockUoW.EXPECT().OrderEtaRepo().Return(orderEtaRepo)
+	}
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	return mockUoW
+}
+
+func setupUoWFactoryForCancelOrder(t *testing.T, uow *mocks.UnitOfWork) *mocks.UnitOfWorkFactory {
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(uow)
+	return mockUoWFactory
+}
+
+func createValidCancelOrderCommand(orderID uuid.UUID) CancelOrderCommand {
+	command, _ := NewCancelOrderCommand(orderID)
+	return command
+}
+
+func newValidOrder(t *testing.T) *order.Order {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testOrder, err := order.NewOrder(uuid.New(), location, 5)
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	return testOrder
+}
+
+func newValidCourier(t *testing.T) *courier.Courier {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testCourier, err := courier.NewCourier("Test Courier", 2, location)
+	if err != nil {
+		t.Fatalf("failed to create courier: %v", err)
+	}
+
+	return testCourier
+}
@@ -3,26 +3,38 @@ package create_courier
 import (
 	"errors"
 
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/pkg/errs"
 )
 
 type CreateCourierCommand struct {
-	name  string
-	speed int64
+	name          string
+	transportType courier.TransportType
+	speed         int64
 
 	isValid bool
 }
 
-func NewCreateCourierCommand(name string, speed int64) (CreateCourierCommand, error) {
+// NewCreateCourierCommand - пустой transportType означает Foot, нулевая speed - скорость транспорта по умолчанию
+func NewCreateCourierCommand(name string, transportType string, speed int64) (CreateCourierCommand, error) {
 	if name == "" {
 		return CreateCourierCommand{}, errs.NewValueIsInvalidErrorWithCause("name", errors.New("name is required"))
 	}
 
-	if speed <= 0 {
+	if speed < 0 {
 		return CreateCourierCommand{}, errs.NewValueIsInvalidErrorWithCause("speed", errors.New("speed must be greater than 0"))
 	}
 
-	return CreateCourierCommand{name: name, speed: speed, isValid: true}, nil
+	courierTransportType := courier.TransportTypeFoot
+	if transportType != "" {
+		var err error
+		courierTransportType, err = courier.NewTransportType(transportType)
+		if err != nil {
+			return CreateCourierCommand{}, err
+		}
+	}
+
+	return CreateCourierCommand{name: name, transportType: courierTransportType, speed: speed, isValid: true}, nil
 }
 
 func (c CreateCourierCommand) CommandName() string {
@@ -37,6 +49,10 @@ func (c CreateCourierCommand) Name() string {
 	return c.name
 }
 
+func (c CreateCourierCommand) TransportType() courier.TransportType {
+	return c.transportType
+}
+
 func (c CreateCourierCommand) Speed() int64 {
 	return c.speed
 }
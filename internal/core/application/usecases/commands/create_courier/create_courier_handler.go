@@ -18,10 +18,12 @@ var _ CreateCourierHandler = (*createCourierHandler)(nil)
 
 type createCourierHandler struct {
 	uowFactory ports.UnitOfWorkFactory
+	mapBounds  shared_kernel.MapBounds
 }
 
-func NewCreateCourierHandler(uowFactory ports.UnitOfWorkFactory) CreateCourierHandler {
-	return &createCourierHandler{uowFactory: uowFactory}
+// NewCreateCourierHandler - новый курьер появляется в случайной точке внутри mapBounds
+func NewCreateCourierHandler(uowFactory ports.UnitOfWorkFactory, mapBounds shared_kernel.MapBounds) CreateCourierHandler {
+	return &createCourierHandler{uowFactory: uowFactory, mapBounds: mapBounds}
 }
 
 func (h *createCourierHandler) Handle(ctx context.Context, command CreateCourierCommand) error {
@@ -33,12 +35,12 @@ func (h *createCourierHandler) Handle(ctx context.Context, command CreateCourier
 
 	err := uow.Do(ctx, func(ctx context.Context) error {
 		// TODO: потом перейдем на другой способ генерации локации
-		randomLocation, uowErr := shared_kernel.NewRandomLocation()
+		randomLocation, uowErr := h.mapBounds.NewRandomLocation()
 		if uowErr != nil {
 			return uowErr
 		}
 
-		courier, uowErr := courier.NewCourier(command.Name(), command.Speed(), randomLocation)
+		courier, uowErr := courier.NewCourierWithTransport(command.Name(), command.TransportType(), command.Speed(), randomLocation)
 		if uowErr != nil {
 			return uowErr
 		}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports/mocks"
 	"delivery/internal/pkg/errs"
 
@@ -18,7 +19,7 @@ func TestCreateCourierHandler_Handle_SuccessfulCourierCreation(t *testing.T) {
 	mockUoW := setupSuccessfulUoWForCourier(t, mockCourierRepo)
 	mockUoWFactory := setupUoWFactoryForCourier(t, mockUoW)
 
-	handler := NewCreateCourierHandler(mockUoWFactory)
+	handler := NewCreateCourierHandler(mockUoWFactory, shared_kernel.DefaultMapBounds())
 	command := createValidCourierCommand()
 
 	// Act
@@ -31,7 +32,7 @@ func TestCreateCourierHandler_Handle_SuccessfulCourierCreation(t *testing.T) {
 func TestCreateCourierHandler_Handle_InvalidCommand(t *testing.T) {
 	// Arrange
 	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
-	handler := NewCreateCourierHandler(mockUoWFactory)
+	handler := NewCreateCourierHandler(mockUoWFactory, shared_kernel.DefaultMapBounds())
 	command := createInvalidCourierCommand()
 
 	// Act
@@ -49,7 +50,7 @@ func TestCreateCourierHandler_Handle_CourierRepositoryError(t *testing.T) {
 	mockUoW := setupSuccessfulUoWForCourier(t, mockCourierRepo)
 	mockUoWFactory := setupUoWFactoryForCourier(t, mockUoW)
 
-	handler := NewCreateCourierHandler(mockUoWFactory)
+	handler := NewCreateCourierHandler(mockUoWFactory, shared_kernel.DefaultMapBounds())
 	command := createValidCourierCommand()
 
 	// Act
@@ -66,7 +67,7 @@ func TestCreateCourierHandler_Handle_UnitOfWorkDoError(t *testing.T) {
 	mockUoW := setupFailingUoWForCourier(t, expectedError)
 	mockUoWFactory := setupUoWFactoryForCourier(t, mockUoW)
 
-	handler := NewCreateCourierHandler(mockUoWFactory)
+	handler := NewCreateCourierHandler(mockUoWFactory, shared_kernel.DefaultMapBounds())
 	command := createValidCourierCommand()
 
 	// Act
@@ -77,6 +78,15 @@ func TestCreateCourierHandler_Handle_UnitOfWorkDoError(t *testing.T) {
 	assert.ErrorIs(t, err, expectedError)
 }
 
+func TestNewCreateCourierCommand_UnknownTransportType(t *testing.T) {
+	// Arrange & Act
+	_, err := NewCreateCourierCommand("Test Courier", "Rocket", 0)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
 // Helper functions
 func setupSuccessfulCourierRepo(t *testing.T) *mocks.CourierRepo {
 	mockCourierRepo := mocks.NewCourierRepo(t)
@@ -112,7 +122,7 @@ func setupUoWFactoryForCourier(t *testing.T, uow *mocks.UnitOfWork) *mocks.UnitO
 }
 
 func createValidCourierCommand() CreateCourierCommand {
-	command, _ := NewCreateCourierCommand("Test Courier", 50)
+	command, _ := NewCreateCourierCommand("Test Courier", "Bicycle", 50)
 	return command
 }
 
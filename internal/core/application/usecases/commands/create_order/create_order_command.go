@@ -3,6 +3,7 @@ package create_order
 import (
 	"errors"
 
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -10,26 +11,29 @@ import (
 
 type CreateOrderCommand struct {
 	orderID uuid.UUID
-	street  string
+	address order.Address
 	volume  int64
 
+	deliveryPeriod order.DeliveryPeriod
+
 	isValid bool
 }
 
-func NewCreateOrderCommand(orderID uuid.UUID, street string, volume int64) (CreateOrderCommand, error) {
+// NewCreateOrderCommand - создает команду. deliveryPeriod может быть не задан, тогда заказ доставляется без окна.
+func NewCreateOrderCommand(orderID uuid.UUID, address order.Address, volume int64, deliveryPeriod order.DeliveryPeriod) (CreateOrderCommand, error) {
 	if orderID == uuid.Nil {
 		return CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("orderID", errors.New("orderID is required"))
 	}
 
-	if street == "" {
-		return CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("street", errors.New("street is required"))
+	if !address.IsSet() {
+		return CreateOrderCommand{}, errs.NewValueIsRequiredError("address")
 	}
 
 	if volume <= 0 {
 		return CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("volume", errors.New("volume must be greater than 0"))
 	}
 
-	return CreateOrderCommand{orderID: orderID, street: street, volume: volume, isValid: true}, nil
+	return CreateOrderCommand{orderID: orderID, address: address, volume: volume, deliveryPeriod: deliveryPeriod, isValid: true}, nil
 }
 
 func (c CreateOrderCommand) CommandName() string {
@@ -44,10 +48,14 @@ func (c CreateOrderCommand) OrderID() uuid.UUID {
 	return c.orderID
 }
 
-func (c CreateOrderCommand) Street() string {
-	return c.street
+func (c CreateOrderCommand) Address() order.Address {
+	return c.address
 }
 
 func (c CreateOrderCommand) Volume() int64 {
 	return c.volume
 }
+
+func (c CreateOrderCommand) DeliveryPeriod() order.DeliveryPeriod {
+	return c.deliveryPeriod
+}
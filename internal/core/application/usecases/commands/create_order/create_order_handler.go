@@ -7,6 +7,7 @@ import (
 	"delivery/internal/core/domain/model/order"
 	sharedKernel "delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/audit"
 	"delivery/internal/pkg/errs"
 )
 
@@ -17,14 +18,23 @@ type CreateOrderHandler interface {
 var _ CreateOrderHandler = (*createOrderHandler)(nil)
 
 type createOrderHandler struct {
-	uowFactory ports.UnitOfWorkFactory
-	geoClient  ports.GeoClient
+	uowFactory     ports.UnitOfWorkFactory
+	geoClient      ports.GeoClient
+	pickupLocation sharedKernel.Location
+	mapBounds      sharedKernel.MapBounds
+	roadNetwork    sharedKernel.RoadNetwork
 }
 
-func NewCreateOrderHandler(uowFactory ports.UnitOfWorkFactory, geoClient ports.GeoClient) CreateOrderHandler {
+// NewCreateOrderHandler - pickupLocation - склад, откуда курьеры забирают заказы.
+// Если он не задан, заказ везут клиенту сразу. В mapBounds выбирается адрес, если геосервис недоступен.
+// Адреса заказа переносятся на ближайшую точку roadNetwork, иначе курьер до них не доедет.
+func NewCreateOrderHandler(uowFactory ports.UnitOfWorkFactory, geoClient ports.GeoClient, pickupLocation sharedKernel.Location, mapBounds sharedKernel.MapBounds, roadNetwork sharedKernel.RoadNetwork) CreateOrderHandler {
 	return &createOrderHandler{
-		uowFactory: uowFactory,
-		geoClient:  geoClient,
+		uowFactory:     uowFactory,
+		geoClient:      geoClient,
+		pickupLocation: pickupLocation,
+		mapBounds:      mapBounds,
+		roadNetwork:    roadNetwork,
 	}
 }
 
@@ -33,22 +43,45 @@ func (h *createOrderHandler) Handle(ctx context.Context, command CreateOrderComm
 		return errs.NewCommandIsInvalidErrorWithCause(command.CommandName(), errors.New("should use NewCreateOrderCommand to create a command"))
 	}
 
+	ctx = audit.WithCommand(ctx, command.CommandName())
+
 	uow := h.uowFactory.NewUOW()
 
 	err := uow.Do(ctx, func(ctx context.Context) error {
-		location, uowErr := h.geoClient.GetGeolocation(command.Street())
+		// Идентификатор приходит от клиента, повтор с тем же идентификатором - конфликт, а не новый заказ
+		_, uowErr := uow.OrderRepo().Get(ctx, command.OrderID())
+		if uowErr == nil {
+			return errs.NewObjectAlreadyExistsError("order", command.OrderID())
+		}
+		if !errors.Is(uowErr, errs.ErrObjectNotFound) {
+			return uowErr
+		}
+
+		location, uowErr := h.geoClient.GetGeolocation(command.Address())
 		if uowErr != nil {
-			location, uowErr = sharedKernel.NewRandomLocation()
+			location, uowErr = h.mapBounds.NewRandomLocation()
 			if uowErr != nil {
 				return uowErr
 			}
 		}
 
-		order, uowErr := order.NewOrder(command.OrderID(), location, command.Volume())
+		order, uowErr := order.NewOrder(command.OrderID(), h.roadNetwork.NearestLocation(location), command.Volume())
 		if uowErr != nil {
 			return uowErr
 		}
 
+		if h.pickupLocation.IsSet() {
+			if uowErr := order.SetPickupLocation(h.roadNetwork.NearestLocation(h.pickupLocation)); uowErr != nil {
+				return uowErr
+			}
+		}
+
+		if command.DeliveryPeriod().IsSet() {
+			if uowErr := order.SetDeliveryPeriod(command.DeliveryPeriod()); uowErr != nil {
+				return uowErr
+			}
+		}
+
 		uowErr = uow.OrderRepo().Add(ctx, order)
 		if uowErr != nil {
 			return uowErr
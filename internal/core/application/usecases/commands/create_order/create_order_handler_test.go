@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports/mocks"
 	"delivery/internal/pkg/errs"
@@ -21,7 +22,7 @@ func TestCreateOrderHandler_Handle_SuccessfulOrderCreation(t *testing.T) {
 	mockUoW := setupSuccessfulUoW(t, mockOrderRepo)
 	mockUoWFactory := setupUoWFactory(t, mockUoW)
 
-	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient)
+	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient, shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
 	command := createValidCommand()
 
 	// Act
@@ -31,11 +32,37 @@ func TestCreateOrderHandler_Handle_SuccessfulOrderCreation(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateOrderHandler_Handle_OrderGetsPickupLocation(t *testing.T) {
+	// Arrange
+	pickupLocation, _ := shared_kernel.NewLocation(1, 1)
+	var createdOrder *order.Order
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.On("Get", mock.Anything, mock.Anything).Return(nil, errs.NewObjectNotFoundError("order", uuid.Nil))
+	mockOrderRepo.On("Add", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		createdOrder = args.Get(1).(*order.Order)
+	}).Return(nil)
+	mockGeoClient := setupSuccessfulGeoClient(t)
+	mockUoW := setupSuccessfulUoW(t, mockOrderRepo)
+	mockUoWFactory := setupUoWFactory(t, mockUoW)
+
+	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient, pickupLocation, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
+	command := createValidCommand()
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, pickupLocation, createdOrder.PickupLocation())
+	assert.Equal(t, pickupLocation, createdOrder.CourierTarget())
+}
+
 func TestCreateOrderHandler_Handle_InvalidCommand(t *testing.T) {
 	// Arrange
 	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
 	mockGeoClient := mocks.NewGeoClient(t)
-	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient)
+	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient, shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
 	command := createInvalidCommand()
 
 	// Act
@@ -54,7 +81,7 @@ func TestCreateOrderHandler_Handle_OrderRepositoryError(t *testing.T) {
 	mockUoW := setupSuccessfulUoW(t, mockOrderRepo)
 	mockUoWFactory := setupUoWFactory(t, mockUoW)
 
-	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient)
+	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient, shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
 	command := createValidCommand()
 
 	// Act
@@ -72,7 +99,7 @@ func TestCreateOrderHandler_Handle_UnitOfWorkDoError(t *testing.T) {
 	mockUoWFactory := setupUoWFactory(t, mockUoW)
 	mockGeoClient := mocks.NewGeoClient(t)
 
-	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient)
+	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient, shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
 	command := createValidCommand()
 
 	// Act
@@ -83,37 +110,141 @@ func TestCreateOrderHandler_Handle_UnitOfWorkDoError(t *testing.T) {
 	assert.ErrorIs(t, err, expectedError)
 }
 
-func TestCreateOrderHandler_Handle_GeoClientError(t *testing.T) {
+func TestCreateOrderHandler_Handle_GeoClientErrorFallsBackToRandomLocation(t *testing.T) {
 	// Arrange
-	expectedError := errors.New("geo service error")
-	mockGeoClient := setupFailingGeoClient(t, expectedError)
-	mockUoW := mocks.NewUnitOfWork(t)
+	var createdOrder *order.Order
+	mockGeoClient := setupFailingGeoClient(t, errors.New("geo service error"))
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.On("Get", mock.Anything, mock.Anything).Return(nil, errs.NewObjectNotFoundError("order", uuid.Nil))
+	mockOrderRepo.On("Add", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		createdOrder = args.Get(1).(*order.Order)
+	}).Return(nil)
+	mockUoW := setupSuccessfulUoW(t, mockOrderRepo)
 	mockUoWFactory := setupUoWFactory(t, mockUoW)
 
-	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient)
+	mapBounds := shared_kernel.DefaultMapBounds()
+	handler := NewCreateOrderHandler(mockUoWFactory, mockGeoClient, shared_kernel.Location{}, mapBounds, shared_kernel.NewGridRoadNetwork())
 	command := createValidCommand()
 
-	mockUoW.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
-		return fn(ctx)
-	})
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, command.OrderID(), createdOrder.ID())
+	assert.True(t, mapBounds.Contains(createdOrder.Location().X(), createdOrder.Location().Y()))
+}
+
+func TestCreateOrderHandler_Handle_PassesFullAddressToGeoClient(t *testing.T) {
+	// Arrange
+	command := createValidCommand()
+	location, _ := shared_kernel.NewLocation(5, 5)
+
+	var passedAddress order.Address
+
+	mockGeoClient := mocks.NewGeoClient(t)
+	mockGeoClient.On("GetGeolocation", mock.Anything).Run(func(args mock.Arguments) {
+		passedAddress = args.Get(0).(order.Address)
+	}).Return(location, nil)
+	mockUoW := setupSuccessfulUoW(t, setupSuccessfulOrderRepo(t))
+	handler := NewCreateOrderHandler(setupUoWFactory(t, mockUoW), mockGeoClient, shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
 
 	// Act
 	err := handler.Handle(context.Background(), command)
 
 	// Assert
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.Equal(t, "Россия", passedAddress.Country())
+	assert.Equal(t, "Москва", passedAddress.City())
+	assert.Equal(t, "test street", passedAddress.Street())
+	assert.Equal(t, "1", passedAddress.House())
+	assert.Equal(t, "12", passedAddress.Apartment())
+}
+
+func TestCreateOrderHandler_Handle_SnapsLocationsToRoadNetwork(t *testing.T) {
+	// Arrange
+	roadStart, _ := shared_kernel.NewLocation(1, 1)
+	roadEnd, _ := shared_kernel.NewLocation(10, 1)
+	road, _ := shared_kernel.NewRoadEdge(roadStart, roadEnd, 9, false)
+	graph, _ := shared_kernel.NewRoadGraph(shared_kernel.DefaultMapBounds(), false, []shared_kernel.RoadEdge{road}, nil)
+	geoLocation, _ := shared_kernel.NewLocation(9, 5)
+	pickupLocation, _ := shared_kernel.NewLocation(2, 3)
+
+	var createdOrder *order.Order
+	mockGeoClient := mocks.NewGeoClient(t)
+	mockGeoClient.On("GetGeolocation", mock.Anything).Return(geoLocation, nil)
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.On("Get", mock.Anything, mock.Anything).Return(nil, errs.NewObjectNotFoundError("order", uuid.Nil))
+	mockOrderRepo.On("Add", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		createdOrder = args.Get(1).(*order.Order)
+	}).Return(nil)
+	mockUoW := setupSuccessfulUoW(t, mockOrderRepo)
+	handler := NewCreateOrderHandler(setupUoWFactory(t, mockUoW), mockGeoClient, pickupLocation, shared_kernel.DefaultMapBounds(), graph)
+
+	// Act
+	err := handler.Handle(context.Background(), createValidCommand())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, roadEnd, createdOrder.Location())
+	assert.Equal(t, roadStart, createdOrder.PickupLocation())
+}
+
+func TestCreateOrderHandler_Handle_OrderWithSameIDAlreadyExists(t *testing.T) {
+	// Arrange
+	command := createValidCommand()
+	location, _ := shared_kernel.NewLocation(5, 5)
+	existingOrder, _ := order.NewOrder(command.OrderID(), location, 10)
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.On("Get", mock.Anything, command.OrderID()).Return(existingOrder, nil)
+	mockGeoClient := mocks.NewGeoClient(t)
+	mockUoW := setupSuccessfulUoW(t, mockOrderRepo)
+	handler := NewCreateOrderHandler(setupUoWFactory(t, mockUoW), mockGeoClient, shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrObjectAlreadyExists)
+	mockOrderRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	mockGeoClient.AssertNotCalled(t, "GetGeolocation", mock.Anything)
+}
+
+func TestCreateOrderHandler_Handle_OrderRepositoryGetError(t *testing.T) {
+	// Arrange
+	expectedError := errors.New("db is down")
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.On("Get", mock.Anything, mock.Anything).Return(nil, expectedError)
+	mockUoW := setupSuccessfulUoW(t, mockOrderRepo)
+	handler := NewCreateOrderHandler(setupUoWFactory(t, mockUoW), mocks.NewGeoClient(t), shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
+
+	// Act
+	err := handler.Handle(context.Background(), createValidCommand())
+
+	// Assert
 	assert.ErrorIs(t, err, expectedError)
 }
 
+func TestNewCreateOrderCommand_RequiresAddress(t *testing.T) {
+	// Act
+	_, err := NewCreateOrderCommand(uuid.New(), order.Address{}, 10, order.DeliveryPeriod{})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsRequired)
+}
+
 // Helper functions
 func setupSuccessfulOrderRepo(t *testing.T) *mocks.OrderRepo {
 	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.On("Get", mock.Anything, mock.Anything).Return(nil, errs.NewObjectNotFoundError("order", uuid.Nil))
 	mockOrderRepo.On("Add", mock.Anything, mock.Anything).Return(nil)
 	return mockOrderRepo
 }
 
 func setupFailingOrderRepo(t *testing.T, expectedError error) *mocks.OrderRepo {
 	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.On("Get", mock.Anything, mock.Anything).Return(nil, errs.NewObjectNotFoundError("order", uuid.Nil))
 	mockOrderRepo.On("Add", mock.Anything, mock.Anything).Return(expectedError)
 	return mockOrderRepo
 }
@@ -140,19 +271,24 @@ func setupUoWFactory(t *testing.T, uow *mocks.UnitOfWork) *mocks.UnitOfWorkFacto
 }
 
 func createValidCommand() CreateOrderCommand {
-	command, _ := NewCreateOrderCommand(uuid.New(), "test street", 10)
+	command, _ := NewCreateOrderCommand(uuid.New(), createValidAddress(), 10, order.DeliveryPeriod{})
 	return command
 }
 
 func createInvalidCommand() CreateOrderCommand {
 	return CreateOrderCommand{
 		orderID: uuid.New(),
-		street:  "test street",
+		address: createValidAddress(),
 		volume:  10,
 		isValid: false,
 	}
 }
 
+func createValidAddress() order.Address {
+	address, _ := order.NewAddress("Россия", "Москва", "test street", "1", "12")
+	return address
+}
+
 func setupSuccessfulGeoClient(t *testing.T) *mocks.GeoClient {
 	mockGeoClient := mocks.NewGeoClient(t)
 	location, _ := shared_kernel.NewLocation(5, 5)
@@ -0,0 +1,35 @@
+package end_courier_shift
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type EndCourierShiftCommand struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewEndCourierShiftCommand(courierID uuid.UUID) (EndCourierShiftCommand, error) {
+	if courierID == uuid.Nil {
+		return EndCourierShiftCommand{}, errs.NewValueIsInvalidErrorWithCause("courierID", errors.New("courierID is required"))
+	}
+
+	return EndCourierShiftCommand{courierID: courierID, isValid: true}, nil
+}
+
+func (c EndCourierShiftCommand) CommandName() string {
+	return "EndCourierShiftCommand"
+}
+
+func (c EndCourierShiftCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c EndCourierShiftCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
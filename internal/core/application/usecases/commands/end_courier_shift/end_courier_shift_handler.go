@@ -0,0 +1,87 @@
+package end_courier_shift
+
+import (
+	"context"
+	"errors"
+
+	modelOrder "delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/audit"
+	"delivery/internal/pkg/errs"
+)
+
+type EndCourierShiftHandler interface {
+	Handle(ctx context.Context, command EndCourierShiftCommand) error
+}
+
+var _ EndCourierShiftHandler = (*endCourierShiftHandler)(nil)
+
+type endCourierShiftHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewEndCourierShiftHandler(uowFactory ports.UnitOfWorkFactory) EndCourierShiftHandler {
+	return &endCourierShiftHandler{uowFactory: uowFactory}
+}
+
+// Handle - курьер уходит со смены, назначенные ему заказы возвращаются в статус Created
+// и на следующем тике распределяются между другими курьерами. Курьер с забранным заказом уйти не может.
+func (h *endCourierShiftHandler) Handle(ctx context.Context, command EndCourierShiftCommand) error {
+	if !command.IsValid() {
+		return errs.NewCommandIsInvalidErrorWithCause(command.CommandName(), errors.New("should use NewEndCourierShiftCommand to create a command"))
+	}
+
+	ctx = audit.WithCommand(ctx, command.CommandName())
+
+	uow := h.uowFactory.NewUOW()
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courier, uowErr := uow.CourierRepo().Get(ctx, command.CourierID())
+		if uowErr != nil {
+			return uowErr
+		}
+
+		orders := make([]*modelOrder.Order, 0, len(courier.AssignedOrderIDs()))
+		for _, orderID := range courier.AssignedOrderIDs() {
+			order, uowErr := uow.OrderRepo().Get(ctx, orderID)
+			if uowErr != nil {
+				return uowErr
+			}
+			orders = append(orders, order)
+		}
+
+		if err := courier.EndShift(orders); err != nil {
+			return err
+		}
+
+		for _, order := range orders {
+			if err := courier.UnassignOrder(order); err != nil {
+				return err
+			}
+
+			if err := order.Unassign(); err != nil {
+				return err
+			}
+
+			if uowErr := uow.OrderRepo().Update(ctx, order); uowErr != nil {
+				return uowErr
+			}
+
+			// ETA снова появится, когда заказ назначат другому курьеру
+			if uowErr := uow.OrderEtaRepo().Delete(ctx, order.ID()); uowErr != nil {
+				return uowErr
+			}
+		}
+
+		if uowErr := uow.CourierRepo().Update(ctx, courier); uowErr != nil {
+			return uowErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package end_courier_shift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEndCourierShiftHandler_Handle_AssignedOrderReturnsToCreated(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+	testOrder := newValidOrder(t)
+	_ = testCourier.TakeOrder(shared_kernel.NewGridRoadNetwork(), testOrder)
+	_ = testOrder.Assign(testCourier.ID())
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+	mockCourierRepo.EXPECT().Update(mock.Anything, testCourier).Return(nil)
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrder).Return(nil)
+
+	mockOrderEtaRepo := mocks.NewOrderEtaRepo(t)
+	mockOrderEtaRepo.EXPECT().Delete(mock.Anything, testOrder.ID()).Return(nil)
+
+	mockUoW := setupSuccessfulUoWForEndCourierShift(t, mockCourierRepo, mockOrderRepo, mockOrderEtaRepo)
+	handler := NewEndCourierShiftHandler(setupUoWFactoryForEndCourierShift(t, mockUoW))
+	command := createValidEndCourierShiftCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, courier.WorkStatusOffline, testCourier.WorkStatus())
+	assert.Empty(t, testCourier.AssignedOrderIDs())
+	assert.Equal(t, order.StatusCreated, testOrder.Status())
+	assert.Nil(t, testOrder.CourierID())
+}
+
+func TestEndCourierShiftHandler_Handle_CourierWithoutOrders(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+	mockCourierRepo.EXPECT().Update(mock.Anything, testCourier).Return(nil)
+
+	mockUoW := setupSuccessfulUoWForEndCourierShift(t, mockCourierRepo, nil, nil)
+	handler := NewEndCourierShiftHandler(setupUoWFactoryForEndCourierShift(t, mockUoW))
+	command := createValidEndCourierShiftCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, courier.WorkStatusOffline, testCourier.WorkStatus())
+}
+
+func TestEndCourierShiftHandler_Handle_CourierAlreadyOffline(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+	_ = testCourier.EndShift(nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+
+	mockUoW := setupSuccessfulUoWForEndCourierShift(t, mockCourierRepo, nil, nil)
+	handler := NewEndCourierShiftHandler(setupUoWFactoryForEndCourierShift(t, mockUoW))
+	command := createValidEndCourierShiftCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestEndCourierShiftHandler_Handle_CourierWithPickedUpOrder(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+	testOrder := newValidOrder(t)
+	pickupLocation, _ := shared_kernel.NewRandomLocation()
+	_ = testOrder.SetPickupLocation(pickupLocation)
+	_ = testCourier.TakeOrder(shared_kernel.NewGridRoadNetwork(), testOrder)
+	_ = testOrder.Assign(testCourier.ID())
+	_ = testOrder.PickUp()
+	_ = testCourier.PickUpOrder(testOrder)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+
+	mockUoW := setupSuccessfulUoWForEndCourierShift(t, mockCourierRepo, mockOrderRepo, nil)
+	handler := NewEndCourierShiftHandler(setupUoWFactoryForEndCourierShift(t, mockUoW))
+	command := createValidEndCourierShiftCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Equal(t, courier.WorkStatusOnline, testCourier.WorkStatus())
+	assert.Equal(t, []uuid.UUID{testOrder.ID()}, testCourier.AssignedOrderIDs())
+	assert.Equal(t, order.StatusPickedUp, testOrder.Status())
+}
+
+func TestEndCourierShiftHandler_Handle_OrderRepositoryUpdateError(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+	testOrder := newValidOrder(t)
+	_ = testCourier.TakeOrder(shared_kernel.NewGridRoadNetwork(), testOrder)
+	_ = testOrder.Assign(testCourier.ID())
+	expectedError := errors.New("update error")
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().Get(mock.Anything, testOrder.ID()).Return(testOrder, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, testOrder).Return(expectedError)
+
+	mockUoW := setupSuccessfulUoWForEndCourierShift(t, mockCourierRepo, mockOrderRepo, nil)
+	handler := NewEndCourierShiftHandler(setupUoWFactoryForEndCourierShift(t, mockUoW))
+	command := createValidEndCourierShiftCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestEndCourierShiftHandler_Handle_InvalidCommand(t *testing.T) {
+	// Arrange
+	handler := NewEndCourierShiftHandler(mocks.NewUnitOfWorkFactory(t))
+
+	// Act
+	err := handler.Handle(context.Background(), EndCourierShiftCommand{})
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrCommandIsInvalid)
+}
+
+// Helper functions
+func setupSuccessfulUoWForEndCourierShift(t *testing.T, courierRepo *mocks.CourierRepo, orderRepo *mocks.OrderRepo, orderEtaRepo *mocks.OrderEtaRepo) *mocks.UnitOfWork {
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().CourierRepo().Return(courierRepo)
+	if orderRepo != nil {
+		mockUoW.EXPECT().OrderRepo().Return(orderRepo)
+	}
+	if orderEtaRepo != nil {
+		mockUoW.EXPECT().OrderEtaRepo().Return(orderEtaRepo)
+	}
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	return mockUoW
+}
+
+func setupUoWFactoryForEndCourierShift(t *testing.T, uow *mocks.UnitOfWork) *mocks.UnitOfWorkFactory {
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(uow)
+	return mockUoWFactory
+}
+
+func createValidEndCourierShiftCommand(courierID uuid.UUID) EndCourierShiftCommand {
+	command, _ := NewEndCourierShiftCommand(courierID)
+	return command
+}
+
+func newValidOrder(t *testing.T) *order.Order {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testOrder, err := order.NewOrder(uuid.New(), location, 5)
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	return testOrder
+}
+
+func newValidCourier(t *testing.T) *courier.Courier {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testCourier, err := courier.NewCourier("Test Courier", 2, location)
+	if err != nil {
+		t.Fatalf("failed to create courier: %v", err)
+	}
+
+	return testCourier
+}
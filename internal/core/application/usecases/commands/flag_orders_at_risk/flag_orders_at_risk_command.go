@@ -0,0 +1,19 @@
+package flag_orders_at_risk
+
+type FlagOrdersAtRiskCommand struct {
+	isValid bool
+}
+
+func NewFlagOrdersAtRiskCommand() FlagOrdersAtRiskCommand {
+	return FlagOrdersAtRiskCommand{
+		isValid: true,
+	}
+}
+
+func (c FlagOrdersAtRiskCommand) CommandName() string {
+	return "FlagOrdersAtRiskCommand"
+}
+
+func (c FlagOrdersAtRiskCommand) IsValid() bool {
+	return c.isValid
+}
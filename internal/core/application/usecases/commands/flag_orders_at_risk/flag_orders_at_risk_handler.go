@@ -0,0 +1,89 @@
+package flag_orders_at_risk
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	modelOrder "delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/audit"
+	"delivery/internal/pkg/errs"
+)
+
+// unassignedOrderDeliveryReserve - неназначенному заказу нужно время, чтобы найти курьера и доехать до клиента
+const unassignedOrderDeliveryReserve = time.Minute
+
+type FlagOrdersAtRiskHandler interface {
+	Handle(ctx context.Context, command FlagOrdersAtRiskCommand) error
+}
+
+var _ FlagOrdersAtRiskHandler = (*flagOrdersAtRiskHandler)(nil)
+
+type flagOrdersAtRiskHandler struct {
+	uowFactory  ports.UnitOfWorkFactory
+	roadNetwork kernel.RoadNetwork
+	now         func() time.Time
+}
+
+func NewFlagOrdersAtRiskHandler(uowFactory ports.UnitOfWorkFactory, roadNetwork kernel.RoadNetwork) FlagOrdersAtRiskHandler {
+	return &flagOrdersAtRiskHandler{uowFactory: uowFactory, roadNetwork: roadNetwork, now: time.Now}
+}
+
+func (h *flagOrdersAtRiskHandler) Handle(ctx context.Context, command FlagOrdersAtRiskCommand) error {
+	if !command.IsValid() {
+		return errs.NewCommandIsInvalidErrorWithCause(command.CommandName(), errors.New("should use NewFlagOrdersAtRiskCommand to create a command"))
+	}
+
+	ctx = audit.WithCommand(ctx, command.CommandName())
+
+	uow := h.uowFactory.NewUOW()
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orders, uowErr := uow.OrderRepo().GetAllWithDeliveryPeriodNotAtRisk(ctx)
+		if uowErr != nil {
+			return uowErr
+		}
+
+		// У курьера с несколькими заказами плечи маршрута общие
+		network := kernel.NewCachedRoadNetwork(h.roadNetwork)
+
+		now := h.now()
+
+		for _, order := range orders {
+			expectedDeliveryTime, uowErr := h.expectedDeliveryTime(ctx, uow, network, order, now)
+			if uowErr != nil {
+				return uowErr
+			}
+
+			if !order.FlagDeliveryAtRiskIfLate(expectedDeliveryTime) {
+				continue
+			}
+
+			if uowErr := uow.OrderRepo().Update(ctx, order); uowErr != nil {
+				return uowErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *flagOrdersAtRiskHandler) expectedDeliveryTime(ctx context.Context, uow ports.UnitOfWork, network kernel.RoadNetwork, order *modelOrder.Order, now time.Time) (time.Time, error) {
+	if !order.Status().Equals(modelOrder.StatusAssigned) && !order.Status().Equals(modelOrder.StatusPickedUp) {
+		return now.Add(unassignedOrderDeliveryReserve), nil
+	}
+
+	courier, err := uow.CourierRepo().Get(ctx, *order.CourierID())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return courier.EstimateDeliveryTime(network, order, now), nil
+}
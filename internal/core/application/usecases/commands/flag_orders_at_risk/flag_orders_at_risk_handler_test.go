@@ -0,0 +1,221 @@
+package flag_orders_at_risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	modelCourier "delivery/internal/core/domain/model/courier"
+	modelOrder "delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/pkg/audit"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testNow = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+func TestFlagOrdersAtRiskHandler_Handle_FlagsCreatedOrderWithClosingWindow(t *testing.T) {
+	// Arrange
+	order := newOrderWithDeliveryPeriod(t, testNow.Add(-time.Hour), testNow.Add(30*time.Second))
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllWithDeliveryPeriodNotAtRisk(mock.Anything).Return([]*modelOrder.Order{order}, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, order).Return(nil)
+
+	mockUoW := setupUoWForFlagOrders(t, mockOrderRepo, nil)
+	handler := newHandlerWithFixedTime(t, mockUoW)
+
+	// Act
+	err := handler.Handle(context.Background(), NewFlagOrdersAtRiskCommand())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, order.DeliveryAtRisk())
+}
+
+func TestFlagOrdersAtRiskHandler_Handle_SavesOrderWithCommandInContext(t *testing.T) {
+	// Arrange
+	order := newOrderWithDeliveryPeriod(t, testNow.Add(-time.Hour), testNow.Add(30*time.Second))
+	command := NewFlagOrdersAtRiskCommand()
+
+	var savedWithCommand string
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllWithDeliveryPeriodNotAtRisk(mock.Anything).Return([]*modelOrder.Order{order}, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, order).RunAndReturn(func(ctx context.Context, _ *modelOrder.Order) error {
+		savedWithCommand = audit.CommandFromContext(ctx)
+		return nil
+	})
+
+	mockUoW := setupUoWForFlagOrders(t, mockOrderRepo, nil)
+	handler := newHandlerWithFixedTime(t, mockUoW)
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, command.CommandName(), savedWithCommand)
+}
+
+func TestFlagOrdersAtRiskHandler_Handle_DoesNotFlagCreatedOrderWithOpenWindow(t *testing.T) {
+	// Arrange
+	order := newOrderWithDeliveryPeriod(t, testNow, testNow.Add(time.Hour))
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllWithDeliveryPeriodNotAtRisk(mock.Anything).Return([]*modelOrder.Order{order}, nil)
+
+	mockUoW := setupUoWForFlagOrders(t, mockOrderRepo, nil)
+	handler := newHandlerWithFixedTime(t, mockUoW)
+
+	// Act
+	err := handler.Handle(context.Background(), NewFlagOrdersAtRiskCommand())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, order.DeliveryAtRisk())
+}
+
+func TestFlagOrdersAtRiskHandler_Handle_FlagsAssignedOrderWhenCourierIsLate(t *testing.T) {
+	// Arrange
+	courierLocation, _ := shared_kernel.NewLocation(1, 1)
+	orderLocation, _ := shared_kernel.NewLocation(10, 10)
+	courier, _ := modelCourier.NewCourier("Медленный", 1, courierLocation)
+
+	// Курьеру нужно 18 перемещений, а окно закрывается через 10
+	order := newOrderWithDeliveryPeriodAndLocation(t, orderLocation, testNow.Add(-time.Hour), testNow.Add(10*modelCourier.MoveInterval))
+	_ = order.Assign(courier.ID())
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllWithDeliveryPeriodNotAtRisk(mock.Anything).Return([]*modelOrder.Order{order}, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, order).Return(nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, courier.ID()).Return(courier, nil)
+
+	mockUoW := setupUoWForFlagOrders(t, mockOrderRepo, mockCourierRepo)
+	handler := newHandlerWithFixedTime(t, mockUoW)
+
+	// Act
+	err := handler.Handle(context.Background(), NewFlagOrdersAtRiskCommand())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, order.DeliveryAtRisk())
+}
+
+func TestFlagOrdersAtRiskHandler_Handle_DoesNotFlagAssignedOrderWhenCourierIsInTime(t *testing.T) {
+	// Arrange
+	courierLocation, _ := shared_kernel.NewLocation(1, 1)
+	orderLocation, _ := shared_kernel.NewLocation(2, 2)
+	courier, _ := modelCourier.NewCourier("Быстрый", 2, courierLocation)
+
+	order := newOrderWithDeliveryPeriodAndLocation(t, orderLocation, testNow.Add(-time.Hour), testNow.Add(10*modelCourier.MoveInterval))
+	_ = order.Assign(courier.ID())
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllWithDeliveryPeriodNotAtRisk(mock.Anything).Return([]*modelOrder.Order{order}, nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, courier.ID()).Return(courier, nil)
+
+	mockUoW := setupUoWForFlagOrders(t, mockOrderRepo, mockCourierRepo)
+	handler := newHandlerWithFixedTime(t, mockUoW)
+
+	// Act
+	err := handler.Handle(context.Background(), NewFlagOrdersAtRiskCommand())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, order.DeliveryAtRisk())
+}
+
+func TestFlagOrdersAtRiskHandler_Handle_InvalidCommand(t *testing.T) {
+	// Arrange
+	handler := NewFlagOrdersAtRiskHandler(mocks.NewUnitOfWorkFactory(t), shared_kernel.NewGridRoadNetwork())
+
+	// Act
+	err := handler.Handle(context.Background(), FlagOrdersAtRiskCommand{})
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrCommandIsInvalid)
+}
+
+func TestFlagOrdersAtRiskHandler_Handle_GetOrdersError(t *testing.T) {
+	// Arrange
+	expectedError := errors.New("get orders error")
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllWithDeliveryPeriodNotAtRisk(mock.Anything).Return(nil, expectedError)
+
+	mockUoW := setupUoWForFlagOrders(t, mockOrderRepo, nil)
+	handler := newHandlerWithFixedTime(t, mockUoW)
+
+	// Act
+	err := handler.Handle(context.Background(), NewFlagOrdersAtRiskCommand())
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+// Helper functions
+func newHandlerWithFixedTime(t *testing.T, uow *mocks.UnitOfWork) *flagOrdersAtRiskHandler {
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(uow)
+
+	return &flagOrdersAtRiskHandler{
+		uowFactory:  mockUoWFactory,
+		roadNetwork: shared_kernel.NewGridRoadNetwork(),
+		now:         func() time.Time { return testNow },
+	}
+}
+
+func setupUoWForFlagOrders(t *testing.T, orderRepo *mocks.OrderRepo, courierRepo *mocks.CourierRepo) *mocks.UnitOfWork {
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().OrderRepo().Return(orderRepo)
+	if courierRepo != nil {
+		mockUoW.EXPECT().CourierRepo().Return(courierRepo)
+	}
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	return mockUoW
+}
+
+func newOrderWithDeliveryPeriod(t *testing.T, from time.Time, to time.Time) *modelOrder.Order {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	return newOrderWithDeliveryPeriodAndLocation(t, location, from, to)
+}
+
+func newOrderWithDeliveryPeriodAndLocation(t *testing.T, location shared_kernel.Location, from time.Time, to time.Time) *modelOrder.Order {
+	t.Helper()
+
+	order, err := modelOrder.NewOrder(uuid.New(), location, 5)
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	deliveryPeriod, err := modelOrder.NewDeliveryPeriod(from, to)
+	if err != nil {
+		t.Fatalf("failed to create delivery period: %v", err)
+	}
+
+	if err := order.SetDeliveryPeriod(deliveryPeriod); err != nil {
+		t.Fatalf("failed to set delivery period: %v", err)
+	}
+
+	return order
+}
@@ -3,11 +3,18 @@ package move_couriers_and_complete_order
 import (
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	modelCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/eta"
 	modelOrder "delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/audit"
 	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
 )
 
 type MoveCouriersAndCompleteOrderHandler interface {
@@ -17,11 +24,13 @@ type MoveCouriersAndCompleteOrderHandler interface {
 var _ MoveCouriersAndCompleteOrderHandler = (*moveCouriersAndCompleteOrderHandler)(nil)
 
 type moveCouriersAndCompleteOrderHandler struct {
-	uowFactory ports.UnitOfWorkFactory
+	uowFactory  ports.UnitOfWorkFactory
+	roadNetwork kernel.RoadNetwork
+	now         func() time.Time
 }
 
-func NewMoveCouriersAndCompleteOrderHandler(uowFactory ports.UnitOfWorkFactory) MoveCouriersAndCompleteOrderHandler {
-	return &moveCouriersAndCompleteOrderHandler{uowFactory: uowFactory}
+func NewMoveCouriersAndCompleteOrderHandler(uowFactory ports.UnitOfWorkFactory, roadNetwork kernel.RoadNetwork) MoveCouriersAndCompleteOrderHandler {
+	return &moveCouriersAndCompleteOrderHandler{uowFactory: uowFactory, roadNetwork: roadNetwork, now: time.Now}
 }
 
 func (h *moveCouriersAndCompleteOrderHandler) Handle(ctx context.Context, command MoveCouriersAndFinishOrderCommand) error {
@@ -32,28 +41,57 @@ func (h *moveCouriersAndCompleteOrderHandler) Handle(ctx context.Context, comman
 		)
 	}
 
+	ctx = audit.WithCommand(ctx, command.CommandName())
+
 	uow := h.uowFactory.NewUOW()
 
 	err := uow.Do(ctx, func(ctx context.Context) error {
-		assignedOrders, uowErr := uow.OrderRepo().GetAllInAssignedStatus(ctx)
+		assignedOrders, uowErr := uow.OrderRepo().GetAllInDelivery(ctx)
 		if uowErr != nil {
 			return uowErr
 		}
 
+		// Курьер с несколькими заказами за тик двигается один раз - к следующей остановке своего маршрута
+		courierIDs := make([]uuid.UUID, 0, len(assignedOrders))
+		ordersByCourier := make(map[uuid.UUID][]*modelOrder.Order, len(assignedOrders))
 		for _, order := range assignedOrders {
-			courier, uowErr := uow.CourierRepo().Get(ctx, *order.CourierID())
+			courierID := *order.CourierID()
+			if _, ok := ordersByCourier[courierID]; !ok {
+				courierIDs = append(courierIDs, courierID)
+			}
+			ordersByCourier[courierID] = append(ordersByCourier[courierID], order)
+		}
+
+		// Маршрут, проверенный перед шагом, и плечи для ETA за тик ищутся один раз
+		network := kernel.NewCachedRoadNetwork(h.roadNetwork)
+
+		now := h.now()
+		for _, courierID := range courierIDs {
+			courier, uowErr := uow.CourierRepo().Get(ctx, courierID)
 			if uowErr != nil {
 				return uowErr
 			}
 
-			if uowErr := h.moveCourierAndCompleteOrder(courier, order); uowErr != nil {
+			// Курьер на перерыве стоит на месте
+			if !courier.WorkStatus().Equals(modelCourier.WorkStatusOnline) {
+				continue
+			}
+
+			changedOrders, uowErr := h.moveCourierAndCompleteOrders(network, courier, ordersByCourier[courierID])
+			if uowErr != nil {
 				return uowErr
 			}
 
 			if uowErr := uow.CourierRepo().Update(ctx, courier); uowErr != nil {
 				return uowErr
 			}
-			if uowErr := uow.OrderRepo().Update(ctx, order); uowErr != nil {
+			for _, order := range changedOrders {
+				if uowErr := uow.OrderRepo().Update(ctx, order); uowErr != nil {
+					return uowErr
+				}
+			}
+
+			if uowErr := h.updateEstimates(ctx, uow, network, courier, ordersByCourier[courierID], now); uowErr != nil {
 				return uowErr
 			}
 		}
@@ -67,20 +105,92 @@ func (h *moveCouriersAndCompleteOrderHandler) Handle(ctx context.Context, comman
 	return nil
 }
 
-func (h *moveCouriersAndCompleteOrderHandler) moveCourierAndCompleteOrder(courier *modelCourier.Courier, order *modelOrder.Order) error {
-	if err := courier.Move(order.Location()); err != nil {
+func (h *moveCouriersAndCompleteOrderHandler) moveCourierAndCompleteOrders(network kernel.RoadNetwork, courier *modelCourier.Courier, orders []*modelOrder.Order) ([]*modelOrder.Order, error) {
+	// Маршрут курьеров, взявших заказы до появления планирования, строится при первом перемещении
+	if !courier.RoutePlanMatches(orders) {
+		if err := courier.PlanRoute(network, orders); err != nil {
+			return nil, err
+		}
+	}
+
+	stop, ok := courier.NextStop()
+	if !ok {
+		return nil, nil
+	}
+
+	// После смены дорожной сети заказ может оказаться недостижим - курьер ждет, остальные двигаются
+	if !courier.CanReach(network, stop.Location()) {
+		return nil, nil
+	}
+
+	if err := courier.Move(network, stop.Location()); err != nil {
+		return nil, err
+	}
+
+	// На одной точке может быть несколько остановок - забираем и отдаем все заказы сразу
+	var changedOrders []*modelOrder.Order
+	for stop, ok := courier.NextStop(); ok && courier.IsAt(stop.Location()); stop, ok = courier.NextStop() {
+		order := findOrder(orders, stop.OrderID())
+
+		if err := h.handleStop(courier, order, stop); err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(changedOrders, order) {
+			changedOrders = append(changedOrders, order)
+		}
+	}
+
+	return changedOrders, nil
+}
+
+func (h *moveCouriersAndCompleteOrderHandler) handleStop(courier *modelCourier.Courier, order *modelOrder.Order, stop modelCourier.RouteStop) error {
+	if stop.IsPickup() {
+		if err := order.PickUp(); err != nil {
+			return err
+		}
+
+		return courier.PickUpOrder(order)
+	}
+
+	if err := order.Complete(); err != nil {
 		return err
 	}
 
-	if courier.Location().Equals(order.Location()) {
-		if err := order.Complete(); err != nil {
+	return courier.CompleteOrder(order)
+}
+
+// updateEstimates - пересчитывает ETA заказов курьера после перемещения. У доставленных
+// и недостижимых заказов ETA нет.
+func (h *moveCouriersAndCompleteOrderHandler) updateEstimates(ctx context.Context, uow ports.UnitOfWork, network kernel.RoadNetwork, courier *modelCourier.Courier, orders []*modelOrder.Order, now time.Time) error {
+	for _, order := range orders {
+		moves, ok := courier.CalculateMovesToDeliver(network, order.ID())
+		if !ok || order.Status().Equals(modelOrder.StatusCompleted) {
+			if err := uow.OrderEtaRepo().Delete(ctx, order.ID()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		estimate, err := eta.NewEstimate(order.ID(), courier.ID(), moves, now)
+		if err != nil {
 			return err
 		}
 
-		if err := courier.CompleteOrder(order); err != nil {
+		if err := uow.OrderEtaRepo().Save(ctx, estimate); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+func findOrder(orders []*modelOrder.Order, orderID uuid.UUID) *modelOrder.Order {
+	for _, order := range orders {
+		if order.ID() == orderID {
+			return order
+		}
+	}
+
+	return nil
+}
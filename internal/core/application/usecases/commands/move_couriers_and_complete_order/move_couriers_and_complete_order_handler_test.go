@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"delivery/internal/core/domain/model/eta"
 	"delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports/mocks"
 	"delivery/internal/pkg/errs"
@@ -27,7 +29,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_SuccessfulMovementAndCompletio
 	mockUoW := setupSuccessfulUoWForMovement(t, mockOrderRepo, mockCourierRepo)
 	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
 
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createValidMoveCouriersCommand()
 
 	// Act
@@ -40,7 +42,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_SuccessfulMovementAndCompletio
 func TestMoveCouriersAndFinishOrderHandler_Handle_InvalidCommand(t *testing.T) {
 	// Arrange
 	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createInvalidMoveCouriersCommand()
 
 	// Act
@@ -58,7 +60,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_GetAssignedOrdersError(t *test
 	mockUoW := setupUoWWithOrderRepo(t, mockOrderRepo)
 	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
 
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createValidMoveCouriersCommand()
 
 	// Act
@@ -79,7 +81,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_GetCourierError(t *testing.T)
 	mockUoW := setupUoWWithBothRepos(t, mockOrderRepo, mockCourierRepo)
 	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
 
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createValidMoveCouriersCommand()
 
 	// Act
@@ -101,7 +103,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_UpdateCourierError(t *testing.
 	mockUoW := setupUoWWithBothRepos(t, mockOrderRepo, mockCourierRepo)
 	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
 
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createValidMoveCouriersCommand()
 
 	// Act
@@ -123,7 +125,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_UpdateOrderError(t *testing.T)
 	mockUoW := setupUoWWithBothRepos(t, mockOrderRepo, mockCourierRepo)
 	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
 
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createValidMoveCouriersCommand()
 
 	// Act
@@ -140,7 +142,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_UnitOfWorkDoError(t *testing.T
 	mockUoW := setupFailingUoWForMovement(t, expectedError)
 	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
 
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createValidMoveCouriersCommand()
 
 	// Act
@@ -157,7 +159,7 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_NoAssignedOrders(t *testing.T)
 	mockUoW := setupUoWWithOrderRepo(t, mockOrderRepo)
 	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
 
-	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory)
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
 	command := createValidMoveCouriersCommand()
 
 	// Act
@@ -167,7 +169,140 @@ func TestMoveCouriersAndFinishOrderHandler_Handle_NoAssignedOrders(t *testing.T)
 	assert.NoError(t, err)
 }
 
+func TestMoveCouriersAndFinishOrderHandler_Handle_CourierOnBreakDoesNotMove(t *testing.T) {
+	// Arrange
+	order := newValidAssignedOrder(t)
+	courier := newValidCourierForMovement(t, order)
+	_ = courier.TakeBreak()
+	startLocation := courier.Location()
+
+	mockOrderRepo := setupSuccessfulOrderRepoWithAssignedOrders(t, []*modelOrder.Order{order})
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, mock.Anything).Return(courier, nil)
+	mockUoW := setupSuccessfulUoWForMovement(t, mockOrderRepo, mockCourierRepo)
+	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
+
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
+	command := createValidMoveCouriersCommand()
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, startLocation.Equals(courier.Location()))
+	assert.Equal(t, modelOrder.StatusAssigned, order.Status())
+}
+
+func TestMoveCouriersAndFinishOrderHandler_Handle_CourierWithSeveralOrdersFollowsRoutePlan(t *testing.T) {
+	// Arrange
+	courierLocation, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := modelCourier.NewCourier("Test Courier", 1, courierLocation)
+	_ = courier.AddStoragePlace("Ящик", 10)
+
+	farOrder := newAssignedOrderWithLocation(t, 10, 10, courier.ID())
+	nearOrder := newAssignedOrderWithLocation(t, 1, 3, courier.ID())
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), farOrder)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), nearOrder)
+
+	mockOrderRepo := setupSuccessfulOrderRepoWithAssignedOrders(t, []*modelOrder.Order{farOrder, nearOrder})
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, courier.ID()).Return(courier, nil).Once()
+	mockCourierRepo.EXPECT().Update(mock.Anything, courier).Return(nil).Once()
+	mockUoW := setupSuccessfulUoWForMovement(t, mockOrderRepo, mockCourierRepo)
+	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
+
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
+	command := createValidMoveCouriersCommand()
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	expectedLocation, _ := shared_kernel.NewLocation(1, 2)
+	assert.Equal(t, expectedLocation, courier.Location())
+	assert.Equal(t, modelOrder.StatusAssigned, nearOrder.Status())
+	assert.Equal(t, modelOrder.StatusAssigned, farOrder.Status())
+}
+
+func TestMoveCouriersAndFinishOrderHandler_Handle_CourierPicksUpOrderBeforeDelivery(t *testing.T) {
+	// Arrange
+	courierLocation, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := modelCourier.NewCourier("Test Courier", 1, courierLocation)
+
+	orderLocation, _ := shared_kernel.NewLocation(5, 5)
+	pickupLocation, _ := shared_kernel.NewLocation(1, 2)
+	order, _ := modelOrder.NewOrder(uuid.New(), orderLocation, 5)
+	_ = order.SetPickupLocation(pickupLocation)
+	_ = order.Assign(courier.ID())
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
+
+	mockOrderRepo := mocks.NewOrderRepo(t)
+	mockOrderRepo.EXPECT().GetAllInDelivery(mock.Anything).Return([]*modelOrder.Order{order}, nil)
+	mockOrderRepo.EXPECT().Update(mock.Anything, order).Return(nil).Once()
+	mockCourierRepo := setupSuccessfulCourierRepoForMovement(t, courier)
+	mockUoW := setupSuccessfulUoWForMovement(t, mockOrderRepo, mockCourierRepo)
+	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
+
+	handler := NewMoveCouriersAndCompleteOrderHandler(mockUoWFactory, shared_kernel.NewGridRoadNetwork())
+	command := createValidMoveCouriersCommand()
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, pickupLocation, courier.Location())
+	assert.Equal(t, modelOrder.StatusPickedUp, order.Status())
+	assert.Equal(t, orderLocation, order.CourierTarget())
+}
+
+func TestMoveCouriersAndFinishOrderHandler_Handle_RecalculatesEtaAfterMove(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	courierLocation, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := modelCourier.NewCourier("Test Courier", 1, courierLocation)
+	_ = courier.AddStoragePlace("Ящик", 10)
+
+	nearOrder := newAssignedOrderWithLocation(t, 1, 2, courier.ID())
+	farOrder := newAssignedOrderWithLocation(t, 1, 5, courier.ID())
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), nearOrder)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), farOrder)
+
+	mockOrderRepo := setupSuccessfulOrderRepoWithAssignedOrders(t, []*modelOrder.Order{nearOrder, farOrder})
+	mockCourierRepo := setupSuccessfulCourierRepoForMovement(t, courier)
+	mockOrderEtaRepo := mocks.NewOrderEtaRepo(t)
+	mockOrderEtaRepo.EXPECT().Delete(mock.Anything, nearOrder.ID()).Return(nil).Once()
+	mockOrderEtaRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(estimate *eta.Estimate) bool {
+		return estimate.OrderID() == farOrder.ID() &&
+			estimate.CourierID() == courier.ID() &&
+			estimate.Moves() == 3 &&
+			estimate.ArrivalAt().Equal(now.Add(3*modelCourier.MoveInterval))
+	})).Return(nil).Once()
+	mockUoW := setupSuccessfulUoWForMovementWithEta(t, mockOrderRepo, mockCourierRepo, mockOrderEtaRepo)
+	mockUoWFactory := setupUoWFactoryForMovement(t, mockUoW)
+
+	handler := &moveCouriersAndCompleteOrderHandler{uowFactory: mockUoWFactory, roadNetwork: shared_kernel.NewGridRoadNetwork(), now: func() time.Time { return now }}
+	command := createValidMoveCouriersCommand()
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, modelOrder.StatusCompleted, nearOrder.Status())
+}
+
 // Helper functions
+func newAssignedOrderWithLocation(t *testing.T, x, y int64, courierID uuid.UUID) *modelOrder.Order {
+	t.Helper()
+	orderLocation, _ := shared_kernel.NewLocation(x, y)
+	order, _ := modelOrder.NewOrder(uuid.New(), orderLocation, 5)
+	_ = order.Assign(courierID)
+	return order
+}
+
 func newValidAssignedOrder(t *testing.T) *modelOrder.Order {
 	t.Helper()
 	orderLocation, _ := shared_kernel.NewLocation(5, 5)
@@ -181,26 +316,26 @@ func newValidCourierForMovement(t *testing.T, order *modelOrder.Order) *modelCou
 	t.Helper()
 	courierLocation, _ := shared_kernel.NewLocation(1, 1)
 	courier, _ := modelCourier.NewCourier("Test Courier", 10, courierLocation)
-	_ = courier.TakeOrder(order)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
 	return courier
 }
 
 func setupSuccessfulOrderRepoWithAssignedOrders(t *testing.T, orders []*modelOrder.Order) *mocks.OrderRepo {
 	mockOrderRepo := mocks.NewOrderRepo(t)
-	mockOrderRepo.EXPECT().GetAllInAssignedStatus(mock.Anything).Return(orders, nil)
+	mockOrderRepo.EXPECT().GetAllInDelivery(mock.Anything).Return(orders, nil)
 	mockOrderRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Maybe()
 	return mockOrderRepo
 }
 
 func setupFailingOrderRepoForGetAssigned(t *testing.T, expectedError error) *mocks.OrderRepo {
 	mockOrderRepo := mocks.NewOrderRepo(t)
-	mockOrderRepo.EXPECT().GetAllInAssignedStatus(mock.Anything).Return(nil, expectedError)
+	mockOrderRepo.EXPECT().GetAllInDelivery(mock.Anything).Return(nil, expectedError)
 	return mockOrderRepo
 }
 
 func setupOrderRepoWithGetSuccessUpdateFailure(t *testing.T, orders []*modelOrder.Order, expectedError error) *mocks.OrderRepo {
 	mockOrderRepo := mocks.NewOrderRepo(t)
-	mockOrderRepo.EXPECT().GetAllInAssignedStatus(mock.Anything).Return(orders, nil)
+	mockOrderRepo.EXPECT().GetAllInDelivery(mock.Anything).Return(orders, nil)
 	mockOrderRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(expectedError)
 	return mockOrderRepo
 }
@@ -225,10 +360,22 @@ func setupCourierRepoWithGetSuccessUpdateFailure(t *testing.T, courier *modelCou
 	return mockCourierRepo
 }
 
+func setupSuccessfulOrderEtaRepo(t *testing.T) *mocks.OrderEtaRepo {
+	mockOrderEtaRepo := mocks.NewOrderEtaRepo(t)
+	mockOrderEtaRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrderEtaRepo.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return mockOrderEtaRepo
+}
+
 func setupSuccessfulUoWForMovement(t *testing.T, orderRepo *mocks.OrderRepo, courierRepo *mocks.CourierRepo) *mocks.UnitOfWork {
+	return setupSuccessfulUoWForMovementWithEta(t, orderRepo, courierRepo, setupSuccessfulOrderEtaRepo(t))
+}
+
+func setupSuccessfulUoWForMovementWithEta(t *testing.T, orderRepo *mocks.OrderRepo, courierRepo *mocks.CourierRepo, orderEtaRepo *mocks.OrderEtaRepo) *mocks.UnitOfWork {
 	mockUoW := mocks.NewUnitOfWork(t)
 	mockUoW.EXPECT().OrderRepo().Return(orderRepo)
 	mockUoW.EXPECT().CourierRepo().Return(courierRepo)
+	mockUoW.EXPECT().OrderEtaRepo().Return(orderEtaRepo).Maybe()
 	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
 		return fn(ctx)
 	})
@@ -0,0 +1,35 @@
+package start_courier_shift
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type StartCourierShiftCommand struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewStartCourierShiftCommand(courierID uuid.UUID) (StartCourierShiftCommand, error) {
+	if courierID == uuid.Nil {
+		return StartCourierShiftCommand{}, errs.NewValueIsInvalidErrorWithCause("courierID", errors.New("courierID is required"))
+	}
+
+	return StartCourierShiftCommand{courierID: courierID, isValid: true}, nil
+}
+
+func (c StartCourierShiftCommand) CommandName() string {
+	return "StartCourierShiftCommand"
+}
+
+func (c StartCourierShiftCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c StartCourierShiftCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
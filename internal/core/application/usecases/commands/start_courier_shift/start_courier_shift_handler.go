@@ -0,0 +1,54 @@
+package start_courier_shift
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type StartCourierShiftHandler interface {
+	Handle(ctx context.Context, command StartCourierShiftCommand) error
+}
+
+var _ StartCourierShiftHandler = (*startCourierShiftHandler)(nil)
+
+type startCourierShiftHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewStartCourierShiftHandler(uowFactory ports.UnitOfWorkFactory) StartCourierShiftHandler {
+	return &startCourierShiftHandler{uowFactory: uowFactory}
+}
+
+// Handle - курьер выходит на смену или возвращается с перерыва
+func (h *startCourierShiftHandler) Handle(ctx context.Context, command StartCourierShiftCommand) error {
+	if !command.IsValid() {
+		return errs.NewCommandIsInvalidErrorWithCause(command.CommandName(), errors.New("should use NewStartCourierShiftCommand to create a command"))
+	}
+
+	uow := h.uowFactory.NewUOW()
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courier, uowErr := uow.CourierRepo().Get(ctx, command.CourierID())
+		if uowErr != nil {
+			return uowErr
+		}
+
+		if err := courier.StartShift(); err != nil {
+			return err
+		}
+
+		if uowErr := uow.CourierRepo().Update(ctx, courier); uowErr != nil {
+			return uowErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package start_courier_shift
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartCourierShiftHandler_Handle_CourierReturnsFromBreak(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+	_ = testCourier.TakeBreak()
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+	mockCourierRepo.EXPECT().Update(mock.Anything, testCourier).Return(nil)
+
+	handler := NewStartCourierShiftHandler(setupUoWFactoryForStartCourierShift(t, mockCourierRepo))
+	command := createValidStartCourierShiftCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, courier.WorkStatusOnline, testCourier.WorkStatus())
+}
+
+func TestStartCourierShiftHandler_Handle_CourierAlreadyOnline(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+
+	handler := NewStartCourierShiftHandler(setupUoWFactoryForStartCourierShift(t, mockCourierRepo))
+	command := createValidStartCourierShiftCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestStartCourierShiftHandler_Handle_CourierNotFound(t *testing.T) {
+	// Arrange
+	courierID := uuid.New()
+	expectedError := errs.NewObjectNotFoundError("courier", courierID)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, courierID).Return(nil, expectedError)
+
+	handler := NewStartCourierShiftHandler(setupUoWFactoryForStartCourierShift(t, mockCourierRepo))
+	command := createValidStartCourierShiftCommand(courierID)
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestStartCourierShiftHandler_Handle_InvalidCommand(t *testing.T) {
+	// Arrange
+	handler := NewStartCourierShiftHandler(mocks.NewUnitOfWorkFactory(t))
+
+	// Act
+	err := handler.Handle(context.Background(), StartCourierShiftCommand{})
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrCommandIsInvalid)
+}
+
+// Helper functions
+func setupUoWFactoryForStartCourierShift(t *testing.T, courierRepo *mocks.CourierRepo) *mocks.UnitOfWorkFactory {
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().CourierRepo().Return(courierRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(mockUoW)
+	return mockUoWFactory
+}
+
+func createValidStartCourierShiftCommand(courierID uuid.UUID) StartCourierShiftCommand {
+	command, _ := NewStartCourierShiftCommand(courierID)
+	return command
+}
+
+func newValidCourier(t *testing.T) *courier.Courier {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testCourier, err := courier.NewCourier("Test Courier", 2, location)
+	if err != nil {
+		t.Fatalf("failed to create courier: %v", err)
+	}
+
+	return testCourier
+}
@@ -0,0 +1,35 @@
+package take_courier_break
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type TakeCourierBreakCommand struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewTakeCourierBreakCommand(courierID uuid.UUID) (TakeCourierBreakCommand, error) {
+	if courierID == uuid.Nil {
+		return TakeCourierBreakCommand{}, errs.NewValueIsInvalidErrorWithCause("courierID", errors.New("courierID is required"))
+	}
+
+	return TakeCourierBreakCommand{courierID: courierID, isValid: true}, nil
+}
+
+func (c TakeCourierBreakCommand) CommandName() string {
+	return "TakeCourierBreakCommand"
+}
+
+func (c TakeCourierBreakCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c TakeCourierBreakCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
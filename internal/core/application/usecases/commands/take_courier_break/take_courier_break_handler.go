@@ -0,0 +1,54 @@
+package take_courier_break
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type TakeCourierBreakHandler interface {
+	Handle(ctx context.Context, command TakeCourierBreakCommand) error
+}
+
+var _ TakeCourierBreakHandler = (*takeCourierBreakHandler)(nil)
+
+type takeCourierBreakHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewTakeCourierBreakHandler(uowFactory ports.UnitOfWorkFactory) TakeCourierBreakHandler {
+	return &takeCourierBreakHandler{uowFactory: uowFactory}
+}
+
+// Handle - курьер уходит на перерыв
+func (h *takeCourierBreakHandler) Handle(ctx context.Context, command TakeCourierBreakCommand) error {
+	if !command.IsValid() {
+		return errs.NewCommandIsInvalidErrorWithCause(command.CommandName(), errors.New("should use NewTakeCourierBreakCommand to create a command"))
+	}
+
+	uow := h.uowFactory.NewUOW()
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courier, uowErr := uow.CourierRepo().Get(ctx, command.CourierID())
+		if uowErr != nil {
+			return uowErr
+		}
+
+		if err := courier.TakeBreak(); err != nil {
+			return err
+		}
+
+		if uowErr := uow.CourierRepo().Update(ctx, courier); uowErr != nil {
+			return uowErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package take_courier_break
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTakeCourierBreakHandler_Handle_OnlineCourierTakesBreak(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+	mockCourierRepo.EXPECT().Update(mock.Anything, testCourier).Return(nil)
+
+	handler := NewTakeCourierBreakHandler(setupUoWFactoryForTakeCourierBreak(t, mockCourierRepo))
+	command := createValidTakeCourierBreakCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, courier.WorkStatusOnBreak, testCourier.WorkStatus())
+}
+
+func TestTakeCourierBreakHandler_Handle_OfflineCourierCannotTakeBreak(t *testing.T) {
+	// Arrange
+	testCourier := newValidCourier(t)
+	_ = testCourier.EndShift(nil)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, testCourier.ID()).Return(testCourier, nil)
+
+	handler := NewTakeCourierBreakHandler(setupUoWFactoryForTakeCourierBreak(t, mockCourierRepo))
+	command := createValidTakeCourierBreakCommand(testCourier.ID())
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestTakeCourierBreakHandler_Handle_CourierNotFound(t *testing.T) {
+	// Arrange
+	courierID := uuid.New()
+	expectedError := errs.NewObjectNotFoundError("courier", courierID)
+
+	mockCourierRepo := mocks.NewCourierRepo(t)
+	mockCourierRepo.EXPECT().Get(mock.Anything, courierID).Return(nil, expectedError)
+
+	handler := NewTakeCourierBreakHandler(setupUoWFactoryForTakeCourierBreak(t, mockCourierRepo))
+	command := createValidTakeCourierBreakCommand(courierID)
+
+	// Act
+	err := handler.Handle(context.Background(), command)
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestTakeCourierBreakHandler_Handle_InvalidCommand(t *testing.T) {
+	// Arrange
+	handler := NewTakeCourierBreakHandler(mocks.NewUnitOfWorkFactory(t))
+
+	// Act
+	err := handler.Handle(context.Background(), TakeCourierBreakCommand{})
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrCommandIsInvalid)
+}
+
+// Helper functions
+func setupUoWFactoryForTakeCourierBreak(t *testing.T, courierRepo *mocks.CourierRepo) *mocks.UnitOfWorkFactory {
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().CourierRepo().Return(courierRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(mockUoW)
+	return mockUoWFactory
+}
+
+func createValidTakeCourierBreakCommand(courierID uuid.UUID) TakeCourierBreakCommand {
+	command, _ := NewTakeCourierBreakCommand(courierID)
+	return command
+}
+
+func newValidCourier(t *testing.T) *courier.Courier {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	if err != nil {
+		t.Fatalf("failed to create random location: %v", err)
+	}
+
+	testCourier, err := courier.NewCourier("Test Courier", 2, location)
+	if err != nil {
+		t.Fatalf("failed to create courier: %v", err)
+	}
+
+	return testCourier
+}
@@ -38,7 +38,7 @@ func (h *getAllCouriersHandler) Handle(ctx context.Context, query GetAllCouriers
 
 	tx := h.txGetter.DefaultTrOrDB(ctx, h.db)
 
-	qry, args, err := squirrel.Select("id", "name", "location").
+	qry, args, err := squirrel.Select("id", "name", "location", "transport_type", "work_status").
 		From("courier").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
@@ -8,8 +8,8 @@ import (
 
 	"delivery/internal/adapters/out/postgre"
 	"delivery/internal/core/application/usecases/commands/create_courier"
+	"delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports"
-	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/testcnts"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
@@ -24,13 +24,6 @@ var uowFactory ports.UnitOfWorkFactory
 var handler GetAllCouriersHandler
 var createCourierHandler create_courier.CreateCourierHandler
 
-type fakeEventPublisher struct {
-}
-
-func (f *fakeEventPublisher) Publish(ctx context.Context, event ddd.DomainEvent) error {
-	return nil
-}
-
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -53,10 +46,9 @@ func TestMain(m *testing.M) {
 		}
 	}()
 
-	eventPublisher := &fakeEventPublisher{}
-	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, eventPublisher)
+	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, nil)
 	handler = NewGetAllCouriersHandler(db, trmsqlx.DefaultCtxGetter)
-	createCourierHandler = create_courier.NewCreateCourierHandler(uowFactory)
+	createCourierHandler = create_courier.NewCreateCourierHandler(uowFactory, shared_kernel.DefaultMapBounds())
 
 	dbURL = containerDBURL
 
@@ -95,7 +87,7 @@ func createValidQuery() GetAllCouriersQuery {
 
 func addCourierViaHandler(t *testing.T, name string, speed int64) {
 	t.Helper()
-	command, err := create_courier.NewCreateCourierCommand(name, speed)
+	command, err := create_courier.NewCreateCourierCommand(name, "", speed)
 	assert.NoError(t, err)
 
 	err = createCourierHandler.Handle(context.Background(), command)
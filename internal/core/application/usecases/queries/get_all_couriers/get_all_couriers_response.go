@@ -14,9 +14,11 @@ type GetAllCouriersResponse struct {
 }
 
 type CourierDTO struct {
-	ID       uuid.UUID   `db:"id"`
-	Name     string      `db:"name"`
-	Location LocationDTO `db:"location"`
+	ID            uuid.UUID   `db:"id"`
+	Name          string      `db:"name"`
+	Location      LocationDTO `db:"location"`
+	TransportType string      `db:"transport_type"`
+	WorkStatus    string      `db:"work_status"`
 }
 
 type LocationDTO struct {
@@ -38,11 +38,20 @@ func (h *getAllUncompletedOrdersHandler) Handle(ctx context.Context, query GetAl
 
 	tx := h.txGetter.DefaultTrOrDB(ctx, h.db)
 
-	qry, args, err := squirrel.Select("id", "location").
-		From("\"order\"").
+	// ETA пересчитывается после каждого перемещения курьеров. Оценка, посчитанная для другого курьера, устарела.
+	qry, args, err := squirrel.Select(
+		`o.id`,
+		`o.location`,
+		`e.moves AS eta_moves`,
+		`e.duration_seconds AS eta_seconds`,
+		`e.arrival_at_utc AS eta_arrival_at_utc`,
+	).
+		From(`"order" o`).
+		LeftJoin(`order_eta e ON e.order_id = o.id AND e.courier_id = o.courier_id`).
 		Where(squirrel.Or{
-			squirrel.Eq{"status": "Assigned"},
-			squirrel.Eq{"status": "Created"},
+			squirrel.Eq{"o.status": "Assigned"},
+			squirrel.Eq{"o.status": "PickedUp"},
+			squirrel.Eq{"o.status": "Created"},
 		}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
@@ -5,13 +5,16 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"delivery/internal/adapters/out/postgre"
 	"delivery/internal/core/application/usecases/commands/create_order"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/eta"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/core/ports"
 	"delivery/internal/core/ports/mocks"
-	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/testcnts"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
@@ -29,13 +32,6 @@ var handler GetAllUncompletedOrdersHandler
 var createOrderHandler create_order.CreateOrderHandler
 var geoClient ports.GeoClient
 
-type fakeEventPublisher struct {
-}
-
-func (f *fakeEventPublisher) Publish(ctx context.Context, event ddd.DomainEvent) error {
-	return nil
-}
-
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -58,14 +54,13 @@ func TestMain(m *testing.M) {
 		}
 	}()
 
-	eventPublisher := &fakeEventPublisher{}
-	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, eventPublisher)
+	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, nil)
 	handler = NewGetAllUncompletedOrdersHandler(db, trmsqlx.DefaultCtxGetter)
 
 	// Setup mock GeoClient for integration tests
 	mockGeoClient := setupMockGeoClient()
 	geoClient = mockGeoClient
-	createOrderHandler = create_order.NewCreateOrderHandler(uowFactory, geoClient)
+	createOrderHandler = create_order.NewCreateOrderHandler(uowFactory, geoClient, shared_kernel.Location{}, shared_kernel.DefaultMapBounds(), shared_kernel.NewGridRoadNetwork())
 
 	dbURL = containerDBURL
 
@@ -99,7 +94,7 @@ func cleanupDB(t *testing.T) {
 		}
 		defer db.Close()
 
-		_, err = db.Exec("TRUNCATE TABLE storage_place, \"order\", courier RESTART IDENTITY CASCADE")
+		_, err = db.Exec("TRUNCATE TABLE storage_place, \"order\", courier, order_eta RESTART IDENTITY CASCADE")
 		if err != nil {
 			t.Fatalf("failed to cleanup database: %v", err)
 		}
@@ -112,7 +107,9 @@ func createValidQuery() GetAllUncompletedOrdersQuery {
 
 func addOrderViaHandler(t *testing.T, orderID uuid.UUID, street string, volume int64) {
 	t.Helper()
-	command, err := create_order.NewCreateOrderCommand(orderID, street, volume)
+	address, err := order.NewAddress("Россия", "Москва", street, "1", "")
+	assert.NoError(t, err)
+	command, err := create_order.NewCreateOrderCommand(orderID, address, volume, order.DeliveryPeriod{})
 	assert.NoError(t, err)
 
 	err = createOrderHandler.Handle(context.Background(), command)
@@ -173,3 +170,51 @@ func Test_GetAllUncompletedOrdersHandler_Handle_InvalidQuery(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, response.Orders)
 }
+
+func Test_GetAllUncompletedOrdersHandler_Handle_ReturnsEtaOfAssignedOrder(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	ctx := context.Background()
+	uow := uowFactory.NewUOW()
+	calculatedAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	courierLocation, _ := shared_kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier("Test Courier", 1, courierLocation)
+	assert.NoError(t, uow.CourierRepo().Add(ctx, c))
+
+	assignedOrderID, createdOrderID := uuid.New(), uuid.New()
+	addOrderViaHandler(t, assignedOrderID, "Street 1", 5)
+	addOrderViaHandler(t, createdOrderID, "Street 2", 5)
+
+	assignedOrder, _ := uow.OrderRepo().Get(ctx, assignedOrderID)
+	_ = assignedOrder.Assign(c.ID())
+	assert.NoError(t, uow.OrderRepo().Update(ctx, assignedOrder))
+
+	estimate, _ := eta.NewEstimate(assignedOrderID, c.ID(), 3, calculatedAt)
+	assert.NoError(t, uow.OrderEtaRepo().Save(ctx, estimate))
+
+	// Оценка для курьера, у которого заказа уже нет, не попадает в ответ
+	staleEstimate, _ := eta.NewEstimate(createdOrderID, uuid.New(), 7, calculatedAt)
+	assert.NoError(t, uow.OrderEtaRepo().Save(ctx, staleEstimate))
+
+	// Act
+	response, err := handler.Handle(ctx, createValidQuery())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, response.Orders, 2)
+
+	for _, o := range response.Orders {
+		if o.ID == assignedOrderID {
+			assert.Equal(t, int64(3), *o.EtaMoves)
+			assert.Equal(t, int64(3), *o.EtaSeconds)
+			assert.True(t, calculatedAt.Add(3*courier.MoveInterval).Equal(*o.EtaArrivalAt))
+			continue
+		}
+
+		assert.Nil(t, o.EtaMoves)
+		assert.Nil(t, o.EtaSeconds)
+		assert.Nil(t, o.EtaArrivalAt)
+	}
+}
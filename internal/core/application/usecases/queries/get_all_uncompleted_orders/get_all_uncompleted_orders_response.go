@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,6 +17,11 @@ type GetAllUncompletedOrdersResponse struct {
 type OrderDTO struct {
 	ID       uuid.UUID
 	Location LocationDTO
+
+	// ETA есть только у заказов, которые везет курьер
+	EtaMoves     *int64     `db:"eta_moves"`
+	EtaSeconds   *int64     `db:"eta_seconds"`
+	EtaArrivalAt *time.Time `db:"eta_arrival_at_utc"`
 }
 
 type LocationDTO struct {
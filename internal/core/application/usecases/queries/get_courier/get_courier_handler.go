@@ -0,0 +1,86 @@
+package get_courier
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+)
+
+type GetCourierHandler interface {
+	Handle(ctx context.Context, query GetCourierQuery) (GetCourierResponse, error)
+}
+
+var _ GetCourierHandler = (*getCourierHandler)(nil)
+
+type txGetter interface {
+	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
+}
+
+type getCourierHandler struct {
+	db       *sqlx.DB
+	txGetter txGetter
+}
+
+func NewGetCourierHandler(db *sqlx.DB, txGetter txGetter) *getCourierHandler {
+	return &getCourierHandler{db: db, txGetter: txGetter}
+}
+
+// Handle - возвращает курьера вместе с местами хранения и их заполненностью
+func (h *getCourierHandler) Handle(ctx context.Context, query GetCourierQuery) (GetCourierResponse, error) {
+	if !query.IsValid() {
+		return GetCourierResponse{}, errs.NewQueryIsInvalidError(query.QueryName())
+	}
+
+	tx := h.txGetter.DefaultTrOrDB(ctx, h.db)
+
+	qry, args, err := squirrel.Select("id", "name", "location", "speed", "transport_type", "work_status").
+		From("courier").
+		Where(squirrel.Eq{"id": query.CourierID()}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return GetCourierResponse{}, err
+	}
+
+	var courier CourierDTO
+	err = tx.GetContext(ctx, &courier, qry, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetCourierResponse{}, errs.NewObjectNotFoundError("courier", query.CourierID())
+		}
+		return GetCourierResponse{}, err
+	}
+
+	qry, args, err = squirrel.Select(
+		`sp.id`,
+		`sp.name`,
+		`sp.volume AS total_volume`,
+		`sp.order_id`,
+		`COALESCE(o.volume, 0) AS occupied_volume`,
+	).
+		From(`storage_place sp`).
+		LeftJoin(`"order" o ON o.id = sp.order_id`).
+		Where(squirrel.Eq{"sp.courier_id": query.CourierID()}).
+		OrderBy("sp.name", "sp.id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return GetCourierResponse{}, err
+	}
+
+	courier.StoragePlaces = []StoragePlaceDTO{}
+	err = tx.SelectContext(ctx, &courier.StoragePlaces, qry, args...)
+	if err != nil {
+		return GetCourierResponse{}, err
+	}
+
+	return GetCourierResponse{
+		Courier: courier,
+	}, nil
+}
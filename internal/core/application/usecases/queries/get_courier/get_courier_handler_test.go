@@ -0,0 +1,157 @@
+package get_courier
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"delivery/internal/adapters/out/postgre"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/testcnts"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var dbURL string
+var uowFactory ports.UnitOfWorkFactory
+var handler GetCourierHandler
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	testcnts.SetupTestEnvironment()
+
+	postgresContainer, containerDBURL, err := testcnts.StartPostgresContainer(ctx)
+	if err != nil {
+		log.Fatalf("failed to start postgres container: %v", err)
+	}
+	defer func() {
+		if err := postgresContainer.Terminate(ctx); err != nil {
+			log.Fatalf("failed to terminate postgres container: %v", err)
+		}
+	}()
+
+	db, trManager := setupDbEntities(containerDBURL)
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Fatalf("failed to close db: %v", err)
+		}
+	}()
+
+	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, nil)
+	handler = NewGetCourierHandler(db, trmsqlx.DefaultCtxGetter)
+
+	dbURL = containerDBURL
+
+	os.Exit(m.Run())
+}
+
+func setupDbEntities(dbURL string) (*sqlx.DB, *manager.Manager) {
+	db, err := sqlx.Connect("postgres", dbURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return db, trManager
+}
+
+func cleanupDB(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		db, err := sqlx.Connect("postgres", dbURL)
+		if err != nil {
+			t.Fatalf("failed to connect to db for cleanup: %v", err)
+		}
+		defer db.Close()
+
+		_, err = db.Exec(`TRUNCATE TABLE storage_place, "order", courier, outbox RESTART IDENTITY CASCADE`)
+		if err != nil {
+			t.Fatalf("failed to cleanup database: %v", err)
+		}
+	})
+}
+
+func Test_GetCourierHandler_Handle_ReturnsStoragePlacesWithOccupancy(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	ctx := context.Background()
+	uow := uowFactory.NewUOW()
+
+	courierLocation, _ := shared_kernel.NewLocation(2, 3)
+	c, _ := courier.NewCourier("Test Courier", 2, courierLocation)
+	_ = c.AddStoragePlace("Багажник", 20)
+	assert.NoError(t, uow.CourierRepo().Add(ctx, c))
+
+	orderLocation, _ := shared_kernel.NewLocation(5, 5)
+	o, _ := order.NewOrder(uuid.New(), orderLocation, 5)
+	assert.NoError(t, uow.OrderRepo().Add(ctx, o))
+	assert.NoError(t, c.TakeOrder(shared_kernel.NewGridRoadNetwork(), o))
+	_ = o.Assign(c.ID())
+	assert.NoError(t, uow.OrderRepo().Update(ctx, o))
+	assert.NoError(t, uow.CourierRepo().Update(ctx, c))
+
+	query, err := NewGetCourierQuery(c.ID())
+	assert.NoError(t, err)
+
+	// Act
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, c.ID(), response.Courier.ID)
+	assert.Equal(t, "Test Courier", response.Courier.Name)
+	assert.Equal(t, LocationDTO{X: 2, Y: 3}, response.Courier.Location)
+	assert.Equal(t, int64(2), response.Courier.Speed)
+	assert.Len(t, response.Courier.StoragePlaces, len(c.StoragePlaces()))
+
+	var occupied []StoragePlaceDTO
+	for _, storagePlace := range response.Courier.StoragePlaces {
+		if storagePlace.OrderID != nil {
+			occupied = append(occupied, storagePlace)
+			continue
+		}
+		assert.Equal(t, int64(0), storagePlace.OccupiedVolume)
+	}
+	assert.Len(t, occupied, 1)
+	assert.Equal(t, o.ID(), *occupied[0].OrderID)
+	assert.Equal(t, int64(5), occupied[0].OccupiedVolume)
+}
+
+func Test_GetCourierHandler_Handle_CourierNotFound(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query, err := NewGetCourierQuery(uuid.New())
+	assert.NoError(t, err)
+
+	// Act
+	_, err = handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func Test_GetCourierHandler_Handle_InvalidQuery(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query := GetCourierQuery{isValid: false}
+
+	// Act
+	_, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package get_courier
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetCourierQuery struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewGetCourierQuery(courierID uuid.UUID) (GetCourierQuery, error) {
+	if courierID == uuid.Nil {
+		return GetCourierQuery{}, errs.NewValueIsInvalidErrorWithCause("courierID", errors.New("courierID is required"))
+	}
+
+	return GetCourierQuery{courierID: courierID, isValid: true}, nil
+}
+
+func (q GetCourierQuery) QueryName() string {
+	return "GetCourierQuery"
+}
+
+func (q GetCourierQuery) IsValid() bool {
+	return q.isValid
+}
+
+func (q GetCourierQuery) CourierID() uuid.UUID {
+	return q.courierID
+}
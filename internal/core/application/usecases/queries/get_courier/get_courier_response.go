@@ -0,0 +1,76 @@
+package get_courier
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+type GetCourierResponse struct {
+	Courier CourierDTO
+}
+
+type CourierDTO struct {
+	ID            uuid.UUID   `db:"id"`
+	Name          string      `db:"name"`
+	Location      LocationDTO `db:"location"`
+	Speed         int64       `db:"speed"`
+	TransportType string      `db:"transport_type"`
+	WorkStatus    string      `db:"work_status"`
+
+	StoragePlaces []StoragePlaceDTO `db:"-"`
+}
+
+// StoragePlaceDTO - OccupiedVolume равен объему заказа в месте хранения, у пустого места 0
+type StoragePlaceDTO struct {
+	ID             uuid.UUID  `db:"id"`
+	Name           string     `db:"name"`
+	TotalVolume    int64      `db:"total_volume"`
+	OrderID        *uuid.UUID `db:"order_id"`
+	OccupiedVolume int64      `db:"occupied_volume"`
+}
+
+type LocationDTO struct {
+	X int64
+	Y int64
+}
+
+func (l *LocationDTO) String() string {
+	return fmt.Sprintf("(%d,%d)", l.X, l.Y)
+}
+
+func (l *LocationDTO) Scan(src interface{}) error {
+	s, ok := src.(string)
+	if !ok {
+		b, ok := src.([]byte)
+		if !ok {
+			return errors.New("не удалось преобразовать POINT")
+		}
+		s = string(b)
+	}
+
+	re, err := regexp.Compile(`\((-?\d+\.?\d*),(-?\d+\.?\d*)\)`)
+	if err != nil {
+		return err
+	}
+
+	parts := re.FindStringSubmatch(s)
+	if len(parts) != 3 {
+		return fmt.Errorf("неожиданный формат POINT: %q", s)
+	}
+	x, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	y, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	l.X, l.Y = x, y
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package get_order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+)
+
+type GetOrderHandler interface {
+	Handle(ctx context.Context, query GetOrderQuery) (GetOrderResponse, error)
+}
+
+var _ GetOrderHandler = (*getOrderHandler)(nil)
+
+type txGetter interface {
+	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
+}
+
+type getOrderHandler struct {
+	db       *sqlx.DB
+	txGetter txGetter
+}
+
+func NewGetOrderHandler(db *sqlx.DB, txGetter txGetter) *getOrderHandler {
+	return &getOrderHandler{db: db, txGetter: txGetter}
+}
+
+// Handle - возвращает заказ вместе с текущим положением назначенного курьера и ETA
+func (h *getOrderHandler) Handle(ctx context.Context, query GetOrderQuery) (GetOrderResponse, error) {
+	if !query.IsValid() {
+		return GetOrderResponse{}, errs.NewQueryIsInvalidError(query.QueryName())
+	}
+
+	tx := h.txGetter.DefaultTrOrDB(ctx, h.db)
+
+	qry, args, err := squirrel.Select(
+		`o.id`,
+		`o.status`,
+		`o.location`,
+		`o.pickup_location`,
+		`o.courier_id`,
+		`c.name AS courier_name`,
+		`c.location AS courier_location`,
+		`e.moves AS eta_moves`,
+		`e.duration_seconds AS eta_seconds`,
+		`e.arrival_at_utc AS eta_arrival_at_utc`,
+	).
+		From(`"order" o`).
+		LeftJoin(`courier c ON c.id = o.courier_id`).
+		LeftJoin(`order_eta e ON e.order_id = o.id AND e.courier_id = o.courier_id`).
+		Where(squirrel.Eq{"o.id": query.OrderID()}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return GetOrderResponse{}, err
+	}
+
+	var order OrderDTO
+	err = tx.GetContext(ctx, &order, qry, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetOrderResponse{}, errs.NewObjectNotFoundError("order", query.OrderID())
+		}
+		return GetOrderResponse{}, err
+	}
+
+	return GetOrderResponse{
+		Order: order,
+	}, nil
+}
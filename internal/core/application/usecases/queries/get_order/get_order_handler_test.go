@@ -0,0 +1,176 @@
+package get_order
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgre"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/eta"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/testcnts"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var dbURL string
+var uowFactory ports.UnitOfWorkFactory
+var handler GetOrderHandler
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	testcnts.SetupTestEnvironment()
+
+	postgresContainer, containerDBURL, err := testcnts.StartPostgresContainer(ctx)
+	if err != nil {
+		log.Fatalf("failed to start postgres container: %v", err)
+	}
+	defer func() {
+		if err := postgresContainer.Terminate(ctx); err != nil {
+			log.Fatalf("failed to terminate postgres container: %v", err)
+		}
+	}()
+
+	db, trManager := setupDbEntities(containerDBURL)
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Fatalf("failed to close db: %v", err)
+		}
+	}()
+
+	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, nil)
+	handler = NewGetOrderHandler(db, trmsqlx.DefaultCtxGetter)
+
+	dbURL = containerDBURL
+
+	os.Exit(m.Run())
+}
+
+func setupDbEntities(dbURL string) (*sqlx.DB, *manager.Manager) {
+	db, err := sqlx.Connect("postgres", dbURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return db, trManager
+}
+
+func cleanupDB(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		db, err := sqlx.Connect("postgres", dbURL)
+		if err != nil {
+			t.Fatalf("failed to connect to db for cleanup: %v", err)
+		}
+		defer db.Close()
+
+		_, err = db.Exec(`TRUNCATE TABLE storage_place, "order", courier, order_eta, order_status_history, outbox RESTART IDENTITY CASCADE`)
+		if err != nil {
+			t.Fatalf("failed to cleanup database: %v", err)
+		}
+	})
+}
+
+func Test_GetOrderHandler_Handle_ReturnsAssignedOrderWithCourierAndEta(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	ctx := context.Background()
+	uow := uowFactory.NewUOW()
+	calculatedAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	courierLocation, _ := shared_kernel.NewLocation(2, 3)
+	c, _ := courier.NewCourier("Test Courier", 1, courierLocation)
+	assert.NoError(t, uow.CourierRepo().Add(ctx, c))
+
+	orderLocation, _ := shared_kernel.NewLocation(5, 5)
+	o, _ := order.NewOrder(uuid.New(), orderLocation, 5)
+	assert.NoError(t, uow.OrderRepo().Add(ctx, o))
+	_ = o.Assign(c.ID())
+	assert.NoError(t, uow.OrderRepo().Update(ctx, o))
+
+	estimate, _ := eta.NewEstimate(o.ID(), c.ID(), 5, calculatedAt)
+	assert.NoError(t, uow.OrderEtaRepo().Save(ctx, estimate))
+
+	query, err := NewGetOrderQuery(o.ID())
+	assert.NoError(t, err)
+
+	// Act
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, o.ID(), response.Order.ID)
+	assert.Equal(t, order.StatusAssigned.String(), response.Order.Status)
+	assert.Equal(t, LocationDTO{X: 5, Y: 5}, response.Order.Location)
+	assert.Nil(t, response.Order.PickupLocation)
+	assert.Equal(t, c.ID(), *response.Order.CourierID)
+	assert.Equal(t, "Test Courier", *response.Order.CourierName)
+	assert.Equal(t, LocationDTO{X: 2, Y: 3}, *response.Order.CourierLocation)
+	assert.Equal(t, int64(5), *response.Order.EtaMoves)
+	assert.True(t, calculatedAt.Add(5*courier.MoveInterval).Equal(*response.Order.EtaArrivalAt))
+}
+
+func Test_GetOrderHandler_Handle_ReturnsCreatedOrderWithoutCourier(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	ctx := context.Background()
+	orderLocation, _ := shared_kernel.NewLocation(5, 5)
+	o, _ := order.NewOrder(uuid.New(), orderLocation, 5)
+	assert.NoError(t, uowFactory.NewUOW().OrderRepo().Add(ctx, o))
+
+	query, err := NewGetOrderQuery(o.ID())
+	assert.NoError(t, err)
+
+	// Act
+	response, err := handler.Handle(ctx, query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, order.StatusCreated.String(), response.Order.Status)
+	assert.Nil(t, response.Order.CourierID)
+	assert.Nil(t, response.Order.CourierName)
+	assert.Nil(t, response.Order.CourierLocation)
+	assert.Nil(t, response.Order.EtaMoves)
+}
+
+func Test_GetOrderHandler_Handle_OrderNotFound(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query, err := NewGetOrderQuery(uuid.New())
+	assert.NoError(t, err)
+
+	// Act
+	_, err = handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func Test_GetOrderHandler_Handle_InvalidQuery(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query := GetOrderQuery{isValid: false}
+
+	// Act
+	_, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package get_order
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderQuery struct {
+	orderID uuid.UUID
+
+	isValid bool
+}
+
+func NewGetOrderQuery(orderID uuid.UUID) (GetOrderQuery, error) {
+	if orderID == uuid.Nil {
+		return GetOrderQuery{}, errs.NewValueIsInvalidErrorWithCause("orderID", errors.New("orderID is required"))
+	}
+
+	return GetOrderQuery{orderID: orderID, isValid: true}, nil
+}
+
+func (q GetOrderQuery) QueryName() string {
+	return "GetOrderQuery"
+}
+
+func (q GetOrderQuery) IsValid() bool {
+	return q.isValid
+}
+
+func (q GetOrderQuery) OrderID() uuid.UUID {
+	return q.orderID
+}
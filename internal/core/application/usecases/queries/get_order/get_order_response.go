@@ -0,0 +1,73 @@
+package get_order
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderResponse struct {
+	Order OrderDTO
+}
+
+type OrderDTO struct {
+	ID             uuid.UUID    `db:"id"`
+	Status         string       `db:"status"`
+	Location       LocationDTO  `db:"location"`
+	PickupLocation *LocationDTO `db:"pickup_location"`
+
+	// Курьер есть только у назначенного заказа
+	CourierID       *uuid.UUID   `db:"courier_id"`
+	CourierName     *string      `db:"courier_name"`
+	CourierLocation *LocationDTO `db:"courier_location"`
+
+	EtaMoves     *int64     `db:"eta_moves"`
+	EtaSeconds   *int64     `db:"eta_seconds"`
+	EtaArrivalAt *time.Time `db:"eta_arrival_at_utc"`
+}
+
+type LocationDTO struct {
+	X int64
+	Y int64
+}
+
+func (l *LocationDTO) String() string {
+	return fmt.Sprintf("(%d,%d)", l.X, l.Y)
+}
+
+func (l *LocationDTO) Scan(src interface{}) error {
+	s, ok := src.(string)
+	if !ok {
+		b, ok := src.([]byte)
+		if !ok {
+			return errors.New("не удалось преобразовать POINT")
+		}
+		s = string(b)
+	}
+
+	re, err := regexp.Compile(`\((-?\d+\.?\d*),(-?\d+\.?\d*)\)`)
+	if err != nil {
+		return err
+	}
+
+	parts := re.FindStringSubmatch(s)
+	if len(parts) != 3 {
+		return fmt.Errorf("неожиданный формат POINT: %q", s)
+	}
+	x, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	y, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	l.X, l.Y = x, y
+
+	return nil
+}
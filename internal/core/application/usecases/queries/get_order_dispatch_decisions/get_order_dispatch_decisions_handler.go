@@ -0,0 +1,95 @@
+package get_order_dispatch_decisions
+
+import (
+	"context"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// decisionsLimit - решение сохраняется при каждом изменении результата назначения, отдаем только последние
+const decisionsLimit = 100
+
+type GetOrderDispatchDecisionsHandler interface {
+	Handle(ctx context.Context, query GetOrderDispatchDecisionsQuery) (GetOrderDispatchDecisionsResponse, error)
+}
+
+var _ GetOrderDispatchDecisionsHandler = (*getOrderDispatchDecisionsHandler)(nil)
+
+type txGetter interface {
+	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
+}
+
+type getOrderDispatchDecisionsHandler struct {
+	db       *sqlx.DB
+	txGetter txGetter
+}
+
+func NewGetOrderDispatchDecisionsHandler(db *sqlx.DB, txGetter txGetter) *getOrderDispatchDecisionsHandler {
+	return &getOrderDispatchDecisionsHandler{db: db, txGetter: txGetter}
+}
+
+// Handle - возвращает решения о назначении заказа, последние - первыми
+func (h *getOrderDispatchDecisionsHandler) Handle(ctx context.Context, query GetOrderDispatchDecisionsQuery) (GetOrderDispatchDecisionsResponse, error) {
+	if !query.IsValid() {
+		return GetOrderDispatchDecisionsResponse{}, errs.NewQueryIsInvalidError(query.QueryName())
+	}
+
+	tx := h.txGetter.DefaultTrOrDB(ctx, h.db)
+
+	qry, args, err := squirrel.Select("id", "order_id", "strategy", "courier_id", "candidates", "decided_at_utc").
+		From("dispatch_decision").
+		Where(squirrel.Eq{"order_id": query.OrderID()}).
+		OrderBy("decided_at_utc DESC").
+		Limit(decisionsLimit).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return GetOrderDispatchDecisionsResponse{}, err
+	}
+
+	decisions := []DecisionDTO{}
+	err = tx.SelectContext(ctx, &decisions, qry, args...)
+	if err != nil {
+		return GetOrderDispatchDecisionsResponse{}, err
+	}
+
+	// Пустой список - это заказ, который еще не рассматривался, а не заказ, которого нет
+	if len(decisions) == 0 {
+		if err := h.ensureOrderExists(ctx, tx, query.OrderID()); err != nil {
+			return GetOrderDispatchDecisionsResponse{}, err
+		}
+	}
+
+	return GetOrderDispatchDecisionsResponse{
+		Decisions: decisions,
+	}, nil
+}
+
+func (h *getOrderDispatchDecisionsHandler) ensureOrderExists(ctx context.Context, tx trmsqlx.Tr, orderID uuid.UUID) error {
+	qry, args, err := squirrel.Select("1").
+		Prefix("SELECT EXISTS (").
+		From(`"order"`).
+		Where(squirrel.Eq{"id": orderID}).
+		Suffix(")").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, qry, args...); err != nil {
+		return err
+	}
+
+	if !exists {
+		return errs.NewObjectNotFoundError("order", orderID)
+	}
+
+	return nil
+}
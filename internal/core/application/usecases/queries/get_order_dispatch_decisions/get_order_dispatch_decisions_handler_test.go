@@ -0,0 +1,191 @@
+package get_order_dispatch_decisions
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgre"
+	"delivery/internal/core/domain/model/dispatch"
+	modelOrder "delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/testcnts"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var dbURL string
+var uowFactory ports.UnitOfWorkFactory
+var handler GetOrderDispatchDecisionsHandler
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	testcnts.SetupTestEnvironment()
+
+	postgresContainer, containerDBURL, err := testcnts.StartPostgresContainer(ctx)
+	if err != nil {
+		log.Fatalf("failed to start postgres container: %v", err)
+	}
+	defer func() {
+		if err := postgresContainer.Terminate(ctx); err != nil {
+			log.Fatalf("failed to terminate postgres container: %v", err)
+		}
+	}()
+
+	db, trManager := setupDbEntities(containerDBURL)
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Fatalf("failed to close db: %v", err)
+		}
+	}()
+
+	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, nil)
+	handler = NewGetOrderDispatchDecisionsHandler(db, trmsqlx.DefaultCtxGetter)
+
+	dbURL = containerDBURL
+
+	os.Exit(m.Run())
+}
+
+func setupDbEntities(dbURL string) (*sqlx.DB, *manager.Manager) {
+	db, err := sqlx.Connect("postgres", dbURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return db, trManager
+}
+
+func cleanupDB(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		db, err := sqlx.Connect("postgres", dbURL)
+		if err != nil {
+			t.Fatalf("failed to connect to db for cleanup: %v", err)
+		}
+		defer db.Close()
+
+		_, err = db.Exec(`TRUNCATE TABLE dispatch_decision, "order" RESTART IDENTITY CASCADE`)
+		if err != nil {
+			t.Fatalf("failed to cleanup database: %v", err)
+		}
+	})
+}
+
+func addDecision(t *testing.T, decision *dispatch.Decision) {
+	t.Helper()
+
+	err := uowFactory.NewUOW().DispatchDecisionRepo().Add(context.Background(), decision)
+	assert.NoError(t, err)
+}
+
+func addOrder(t *testing.T) uuid.UUID {
+	t.Helper()
+
+	location, err := shared_kernel.NewRandomLocation()
+	assert.NoError(t, err)
+
+	order, err := modelOrder.NewOrder(uuid.New(), location, 5)
+	assert.NoError(t, err)
+
+	err = uowFactory.NewUOW().OrderRepo().Add(context.Background(), order)
+	assert.NoError(t, err)
+
+	return order.ID()
+}
+
+func Test_GetOrderDispatchDecisionsHandler_Handle_ReturnsDecisionsWithCandidates(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	orderID := addOrder(t)
+	winnerID, busyID := uuid.New(), uuid.New()
+	decidedAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	first, _ := dispatch.NewDecision(orderID, "nearest", decidedAt)
+	first.AddCandidate(dispatch.NewCandidate(busyID, 1.5, dispatch.RejectionReasonNoStoragePlace))
+	addDecision(t, first)
+
+	second, _ := dispatch.NewDecision(orderID, "nearest", decidedAt.Add(time.Second))
+	second.AddCandidate(dispatch.NewCandidate(busyID, 1.5, dispatch.RejectionReasonNoStoragePlace))
+	second.AddCandidate(dispatch.NewCandidate(winnerID, 2, dispatch.RejectionReasonNone))
+	_ = second.Select(winnerID)
+	addDecision(t, second)
+
+	otherOrderDecision, _ := dispatch.NewDecision(uuid.New(), "nearest", decidedAt)
+	addDecision(t, otherOrderDecision)
+
+	query, err := NewGetOrderDispatchDecisionsQuery(orderID)
+	assert.NoError(t, err)
+
+	// Act
+	response, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, response.Decisions, 2)
+
+	latest := response.Decisions[0]
+	assert.Equal(t, second.ID(), latest.ID)
+	assert.Equal(t, winnerID, *latest.CourierID)
+	assert.Equal(t, CandidatesDTO{
+		{CourierID: busyID, TimeToLocation: 1.5, RejectionReason: string(dispatch.RejectionReasonNoStoragePlace)},
+		{CourierID: winnerID, TimeToLocation: 2},
+	}, latest.Candidates)
+
+	assert.Equal(t, first.ID(), response.Decisions[1].ID)
+	assert.Nil(t, response.Decisions[1].CourierID)
+}
+
+func Test_GetOrderDispatchDecisionsHandler_Handle_NoDecisions(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query, err := NewGetOrderDispatchDecisionsQuery(addOrder(t))
+	assert.NoError(t, err)
+
+	// Act
+	response, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, response.Decisions)
+}
+
+func Test_GetOrderDispatchDecisionsHandler_Handle_UnknownOrder(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query, err := NewGetOrderDispatchDecisionsQuery(uuid.New())
+	assert.NoError(t, err)
+
+	// Act
+	_, err = handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func Test_GetOrderDispatchDecisionsHandler_Handle_InvalidQuery(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query := GetOrderDispatchDecisionsQuery{isValid: false}
+
+	// Act
+	_, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.Error(t, err)
+}
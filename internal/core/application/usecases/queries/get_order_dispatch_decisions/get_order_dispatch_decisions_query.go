@@ -0,0 +1,35 @@
+package get_order_dispatch_decisions
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderDispatchDecisionsQuery struct {
+	orderID uuid.UUID
+
+	isValid bool
+}
+
+func NewGetOrderDispatchDecisionsQuery(orderID uuid.UUID) (GetOrderDispatchDecisionsQuery, error) {
+	if orderID == uuid.Nil {
+		return GetOrderDispatchDecisionsQuery{}, errs.NewValueIsInvalidErrorWithCause("orderID", errors.New("orderID is required"))
+	}
+
+	return GetOrderDispatchDecisionsQuery{orderID: orderID, isValid: true}, nil
+}
+
+func (q GetOrderDispatchDecisionsQuery) QueryName() string {
+	return "GetOrderDispatchDecisionsQuery"
+}
+
+func (q GetOrderDispatchDecisionsQuery) IsValid() bool {
+	return q.isValid
+}
+
+func (q GetOrderDispatchDecisionsQuery) OrderID() uuid.UUID {
+	return q.orderID
+}
@@ -0,0 +1,44 @@
+package get_order_dispatch_decisions
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderDispatchDecisionsResponse struct {
+	Decisions []DecisionDTO
+}
+
+type DecisionDTO struct {
+	ID         uuid.UUID     `db:"id"`
+	OrderID    uuid.UUID     `db:"order_id"`
+	Strategy   string        `db:"strategy"`
+	CourierID  *uuid.UUID    `db:"courier_id"`
+	Candidates CandidatesDTO `db:"candidates"`
+	DecidedAt  time.Time     `db:"decided_at_utc"`
+}
+
+type CandidateDTO struct {
+	CourierID       uuid.UUID `json:"courier_id"`
+	TimeToLocation  float64   `json:"time_to_location"`
+	RejectionReason string    `json:"rejection_reason,omitempty"`
+}
+
+type CandidatesDTO []CandidateDTO
+
+func (c *CandidatesDTO) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("не удалось преобразовать jsonb кандидатов")
+	}
+
+	return json.Unmarshal(data, c)
+}
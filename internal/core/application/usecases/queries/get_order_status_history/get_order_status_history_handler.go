@@ -0,0 +1,92 @@
+package get_order_status_history
+
+import (
+	"context"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type GetOrderStatusHistoryHandler interface {
+	Handle(ctx context.Context, query GetOrderStatusHistoryQuery) (GetOrderStatusHistoryResponse, error)
+}
+
+var _ GetOrderStatusHistoryHandler = (*getOrderStatusHistoryHandler)(nil)
+
+type txGetter interface {
+	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
+}
+
+type getOrderStatusHistoryHandler struct {
+	db       *sqlx.DB
+	txGetter txGetter
+}
+
+func NewGetOrderStatusHistoryHandler(db *sqlx.DB, txGetter txGetter) *getOrderStatusHistoryHandler {
+	return &getOrderStatusHistoryHandler{db: db, txGetter: txGetter}
+}
+
+// Handle - возвращает переходы статуса заказа в хронологическом порядке
+func (h *getOrderStatusHistoryHandler) Handle(ctx context.Context, query GetOrderStatusHistoryQuery) (GetOrderStatusHistoryResponse, error) {
+	if !query.IsValid() {
+		return GetOrderStatusHistoryResponse{}, errs.NewQueryIsInvalidError(query.QueryName())
+	}
+
+	tx := h.txGetter.DefaultTrOrDB(ctx, h.db)
+
+	// id - порядок записи, если несколько переходов случились в одно и то же время
+	qry, args, err := squirrel.Select("from_status", "to_status", "courier_id", "command", "changed_at_utc").
+		From("order_status_history").
+		Where(squirrel.Eq{"order_id": query.OrderID()}).
+		OrderBy("changed_at_utc", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return GetOrderStatusHistoryResponse{}, err
+	}
+
+	changes := []StatusChangeDTO{}
+	err = tx.SelectContext(ctx, &changes, qry, args...)
+	if err != nil {
+		return GetOrderStatusHistoryResponse{}, err
+	}
+
+	// У заказов, созданных до появления истории, записей может не быть
+	if len(changes) == 0 {
+		if err := h.ensureOrderExists(ctx, tx, query.OrderID()); err != nil {
+			return GetOrderStatusHistoryResponse{}, err
+		}
+	}
+
+	return GetOrderStatusHistoryResponse{
+		Changes: changes,
+	}, nil
+}
+
+func (h *getOrderStatusHistoryHandler) ensureOrderExists(ctx context.Context, tx trmsqlx.Tr, orderID uuid.UUID) error {
+	qry, args, err := squirrel.Select("1").
+		Prefix("SELECT EXISTS (").
+		From(`"order"`).
+		Where(squirrel.Eq{"id": orderID}).
+		Suffix(")").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, qry, args...); err != nil {
+		return err
+	}
+
+	if !exists {
+		return errs.NewObjectNotFoundError("order", orderID)
+	}
+
+	return nil
+}
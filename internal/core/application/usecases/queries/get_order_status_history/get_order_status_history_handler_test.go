@@ -0,0 +1,174 @@
+package get_order_status_history
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"delivery/internal/adapters/out/postgre"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/audit"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/testcnts"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var dbURL string
+var uowFactory ports.UnitOfWorkFactory
+var handler GetOrderStatusHistoryHandler
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	testcnts.SetupTestEnvironment()
+
+	postgresContainer, containerDBURL, err := testcnts.StartPostgresContainer(ctx)
+	if err != nil {
+		log.Fatalf("failed to start postgres container: %v", err)
+	}
+	defer func() {
+		if err := postgresContainer.Terminate(ctx); err != nil {
+			log.Fatalf("failed to terminate postgres container: %v", err)
+		}
+	}()
+
+	db, trManager := setupDbEntities(containerDBURL)
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Fatalf("failed to close db: %v", err)
+		}
+	}()
+
+	uowFactory = postgre.NewUnitOfWorkFactory(db, trManager, trmsqlx.DefaultCtxGetter, nil)
+	handler = NewGetOrderStatusHistoryHandler(db, trmsqlx.DefaultCtxGetter)
+
+	dbURL = containerDBURL
+
+	os.Exit(m.Run())
+}
+
+func setupDbEntities(dbURL string) (*sqlx.DB, *manager.Manager) {
+	db, err := sqlx.Connect("postgres", dbURL)
+	if err != nil {
+		log.Fatalf("failed to connect to db: %v", err)
+	}
+
+	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
+	return db, trManager
+}
+
+func cleanupDB(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		db, err := sqlx.Connect("postgres", dbURL)
+		if err != nil {
+			t.Fatalf("failed to connect to db for cleanup: %v", err)
+		}
+		defer db.Close()
+
+		_, err = db.Exec(`TRUNCATE TABLE order_status_history, "order", outbox RESTART IDENTITY CASCADE`)
+		if err != nil {
+			t.Fatalf("failed to cleanup database: %v", err)
+		}
+	})
+}
+
+func Test_GetOrderStatusHistoryHandler_Handle_ReturnsTransitionsInOrder(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	location, _ := shared_kernel.NewLocation(5, 5)
+	o, _ := order.NewOrder(uuid.New(), location, 5)
+	courierID := uuid.New()
+
+	uow := uowFactory.NewUOW()
+	assert.NoError(t, uow.OrderRepo().Add(audit.WithCommand(context.Background(), "CreateOrderCommand"), o))
+
+	_ = o.Assign(courierID)
+	assert.NoError(t, uow.OrderRepo().Update(audit.WithCommand(context.Background(), "AssignedOrderCommand"), o))
+
+	loaded, _ := uow.OrderRepo().Get(context.Background(), o.ID())
+	_ = loaded.Complete()
+	assert.NoError(t, uow.OrderRepo().Update(audit.WithCommand(context.Background(), "MoveCouriersAndFinishOrderCommand"), loaded))
+
+	query, err := NewGetOrderStatusHistoryQuery(o.ID())
+	assert.NoError(t, err)
+
+	// Act
+	response, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, response.Changes, 3)
+
+	assert.Empty(t, response.Changes[0].FromStatus)
+	assert.Equal(t, order.StatusCreated.String(), response.Changes[0].ToStatus)
+	assert.Nil(t, response.Changes[0].CourierID)
+	assert.Equal(t, "CreateOrderCommand", response.Changes[0].Command)
+
+	assert.Equal(t, order.StatusCreated.String(), response.Changes[1].FromStatus)
+	assert.Equal(t, order.StatusAssigned.String(), response.Changes[1].ToStatus)
+	assert.Equal(t, courierID, *response.Changes[1].CourierID)
+	assert.Equal(t, "AssignedOrderCommand", response.Changes[1].Command)
+
+	assert.Equal(t, order.StatusAssigned.String(), response.Changes[2].FromStatus)
+	assert.Equal(t, order.StatusCompleted.String(), response.Changes[2].ToStatus)
+	assert.Equal(t, courierID, *response.Changes[2].CourierID)
+	assert.Equal(t, "MoveCouriersAndFinishOrderCommand", response.Changes[2].Command)
+}
+
+func Test_GetOrderStatusHistoryHandler_Handle_OrderWithoutHistory(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	location, _ := shared_kernel.NewLocation(5, 5)
+	o, _ := order.NewOrder(uuid.New(), location, 5)
+	o.ClearStatusChanges()
+	assert.NoError(t, uowFactory.NewUOW().OrderRepo().Add(context.Background(), o))
+
+	query, err := NewGetOrderStatusHistoryQuery(o.ID())
+	assert.NoError(t, err)
+
+	// Act
+	response, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, response.Changes)
+}
+
+func Test_GetOrderStatusHistoryHandler_Handle_UnknownOrder(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query, err := NewGetOrderStatusHistoryQuery(uuid.New())
+	assert.NoError(t, err)
+
+	// Act
+	_, err = handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func Test_GetOrderStatusHistoryHandler_Handle_InvalidQuery(t *testing.T) {
+	cleanupDB(t)
+
+	// Arrange
+	query := GetOrderStatusHistoryQuery{isValid: false}
+
+	// Act
+	_, err := handler.Handle(context.Background(), query)
+
+	// Assert
+	assert.Error(t, err)
+}
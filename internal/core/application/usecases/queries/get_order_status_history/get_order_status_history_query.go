@@ -0,0 +1,35 @@
+package get_order_status_history
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderStatusHistoryQuery struct {
+	orderID uuid.UUID
+
+	isValid bool
+}
+
+func NewGetOrderStatusHistoryQuery(orderID uuid.UUID) (GetOrderStatusHistoryQuery, error) {
+	if orderID == uuid.Nil {
+		return GetOrderStatusHistoryQuery{}, errs.NewValueIsInvalidErrorWithCause("orderID", errors.New("orderID is required"))
+	}
+
+	return GetOrderStatusHistoryQuery{orderID: orderID, isValid: true}, nil
+}
+
+func (q GetOrderStatusHistoryQuery) QueryName() string {
+	return "GetOrderStatusHistoryQuery"
+}
+
+func (q GetOrderStatusHistoryQuery) IsValid() bool {
+	return q.isValid
+}
+
+func (q GetOrderStatusHistoryQuery) OrderID() uuid.UUID {
+	return q.orderID
+}
@@ -0,0 +1,19 @@
+package get_order_status_history
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderStatusHistoryResponse struct {
+	Changes []StatusChangeDTO
+}
+
+type StatusChangeDTO struct {
+	FromStatus string     `db:"from_status"`
+	ToStatus   string     `db:"to_status"`
+	CourierID  *uuid.UUID `db:"courier_id"`
+	Command    string     `db:"command"`
+	ChangedAt  time.Time  `db:"changed_at_utc"`
+}
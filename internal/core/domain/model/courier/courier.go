@@ -3,9 +3,13 @@ package courier
 import (
 	"errors"
 	"math"
+	"slices"
+	"time"
 
+	"delivery/internal/core/domain/model/event"
 	"delivery/internal/core/domain/model/order"
 	kernel "delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -14,43 +18,86 @@ import (
 const (
 	defaultStoragePlaceName         = "Сумка"
 	defaultStoragePlaceVolume int64 = 10
+
+	// MoveInterval - за это время курьер проходит расстояние, равное своей скорости
+	MoveInterval = time.Second
 )
 
 type Courier struct {
-	id            uuid.UUID
-	name          string
-	speed         int64
-	location      kernel.Location
+	*ddd.BaseAggregate[uuid.UUID]
+
+	name     string
+	speed    int64
+	location kernel.Location
+	// transit - дорога, которую курьер не успел проехать за прошлое перемещение
+	transit       Transit
+	transportType TransportType
 	storagePlaces []*StoragePlace
-	version       int64
+	// routePlan - порядок, в котором курьер развозит взятые заказы
+	routePlan  []RouteStop
+	workStatus WorkStatus
+	version    int64
 }
 
 func NewCourier(name string, speed int64, location kernel.Location) (*Courier, error) {
-	storagePlace, err := NewStoragePlace(defaultStoragePlaceName, defaultStoragePlaceVolume)
+	if speed <= 0 {
+		return nil, errs.NewValueIsInvalidErrorWithCause("speed", errors.New("speed must be greater than 0"))
+	}
+
+	return NewCourierWithTransport(name, TransportTypeFoot, speed, location)
+}
+
+// NewCourierWithTransport - создает курьера с местами хранения по шаблону транспорта.
+// Если speed равна 0, используется скорость транспорта по умолчанию.
+func NewCourierWithTransport(name string, transportType TransportType, speed int64, location kernel.Location) (*Courier, error) {
+	if transportType.IsEmpty() {
+		transportType = TransportTypeFoot
+	}
+
+	transportType, err := NewTransportType(transportType.String())
 	if err != nil {
 		return nil, err
 	}
 
-	if speed <= 0 {
+	if speed == 0 {
+		speed = transportType.DefaultSpeed()
+	}
+
+	if speed < 0 {
 		return nil, errs.NewValueIsInvalidErrorWithCause("speed", errors.New("speed must be greater than 0"))
 	}
 
-	return &Courier{
-		id:            uuid.New(),
+	storagePlaces, err := transportType.newStoragePlaces()
+	if err != nil {
+		return nil, err
+	}
+
+	courier := &Courier{
+		BaseAggregate: ddd.NewBaseAggregate(uuid.New()),
 		name:          name,
 		speed:         speed,
 		location:      location,
-		storagePlaces: []*StoragePlace{storagePlace},
-	}, nil
+		transportType: transportType,
+		storagePlaces: storagePlaces,
+		workStatus:    WorkStatusOnline,
+	}
+
+	courier.RaiseDomainEvent(event.NewCourierCreated(courier.ID(), name, transportType.String()))
+
+	return courier, nil
 }
 
-func LoadCourierFromRepo(id uuid.UUID, name string, speed int64, location kernel.Location, storagePlaces []*StoragePlace, version int64) *Courier {
+func LoadCourierFromRepo(id uuid.UUID, name string, speed int64, location kernel.Location, transit Transit, transportType TransportType, storagePlaces []*StoragePlace, routePlan []RouteStop, workStatus WorkStatus, version int64) *Courier {
 	return &Courier{
-		id:            id,
+		BaseAggregate: ddd.NewBaseAggregate(id),
 		name:          name,
 		speed:         speed,
 		location:      location,
+		transit:       transit,
+		transportType: transportType,
 		storagePlaces: storagePlaces,
+		routePlan:     routePlan,
+		workStatus:    workStatus,
 		version:       version,
 	}
 }
@@ -60,11 +107,7 @@ func (c *Courier) Equals(other *Courier) bool {
 		return false
 	}
 
-	return c.id == other.id
-}
-
-func (c *Courier) ID() uuid.UUID {
-	return c.id
+	return c.ID() == other.ID()
 }
 
 func (c *Courier) Name() string {
@@ -75,14 +118,45 @@ func (c *Courier) Speed() int64 {
 	return c.speed
 }
 
+// Location - последний узел дорожной сети, через который проехал курьер
 func (c *Courier) Location() kernel.Location {
 	return c.location
 }
 
+func (c *Courier) Transit() Transit {
+	return c.transit
+}
+
+// IsAt - курьер стоит в точке location, а не едет по дороге
+func (c *Courier) IsAt(location kernel.Location) bool {
+	return !c.transit.IsSet() && c.location.Equals(location)
+}
+
+func (c *Courier) TransportType() TransportType {
+	return c.transportType
+}
+
 func (c *Courier) StoragePlaces() []*StoragePlace {
 	return c.storagePlaces
 }
 
+func (c *Courier) RoutePlan() []RouteStop {
+	return c.routePlan
+}
+
+// NextStop - ближайшая по плану остановка
+func (c *Courier) NextStop() (RouteStop, bool) {
+	if len(c.routePlan) == 0 {
+		return RouteStop{}, false
+	}
+
+	return c.routePlan[0], true
+}
+
+func (c *Courier) WorkStatus() WorkStatus {
+	return c.workStatus
+}
+
 func (c *Courier) Version() int64 {
 	return c.version
 }
@@ -94,6 +168,8 @@ func (c *Courier) AddStoragePlace(name string, volume int64) error {
 	}
 
 	c.storagePlaces = append(c.storagePlaces, storagePlace)
+	c.RaiseDomainEvent(event.NewStoragePlaceAdded(c.ID(), storagePlace.ID(), storagePlace.Name(), storagePlace.TotalVolume()))
+
 	return nil
 }
 
@@ -102,36 +178,158 @@ func (c *Courier) CanTakeOrder(order *order.Order) bool {
 		return false
 	}
 
-	for _, storagePlace := range c.storagePlaces {
-		if storagePlace.CanStore(order.Volume()) {
-			return true
+	_, ok := c.findStoragePlaceFor(order)
+	return ok
+}
+
+func (c *Courier) TakeOrder(network kernel.RoadNetwork, order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsInvalidErrorWithCause("order", errors.New("order is nil"))
+	}
+
+	storagePlace, ok := c.findStoragePlaceFor(order)
+	if !ok {
+		return errs.NewValueIsInvalidErrorWithCause("order", errors.New("courier has no storage place with enough volume"))
+	}
+
+	if err := storagePlace.Store(order.ID(), order.Volume()); err != nil {
+		return err
+	}
+
+	c.routePlan = planRoute(network, c.routeStart(), append(c.routePlan, routeStopsFor(order)...))
+	c.RaiseDomainEvent(event.NewOrderTaken(c.ID(), order.ID(), storagePlace.ID()))
+
+	return nil
+}
+
+// PlanRoute - заново строит маршрут по заказам, которые лежат у курьера
+func (c *Courier) PlanRoute(network kernel.RoadNetwork, orders []*order.Order) error {
+	stops := make([]RouteStop, 0, len(orders))
+	for _, order := range orders {
+		if order == nil {
+			return errs.NewValueIsInvalidErrorWithCause("order", errors.New("order is nil"))
+		}
+
+		if _, err := c.findStoragePlaceByOrderID(order.ID()); err != nil {
+			return err
+		}
+
+		stops = append(stops, routeStopsFor(order)...)
+	}
+
+	c.routePlan = planRoute(network, c.routeStart(), stops)
+
+	return nil
+}
+
+// RoutePlanMatches - в маршруте ровно те остановки, которые нужны переданным заказам
+func (c *Courier) RoutePlanMatches(orders []*order.Order) bool {
+	expectedStops := make([]RouteStop, 0, len(orders))
+	for _, order := range orders {
+		expectedStops = append(expectedStops, routeStopsFor(order)...)
+	}
+
+	if len(c.routePlan) != len(expectedStops) {
+		return false
+	}
+
+	for _, stop := range expectedStops {
+		if !slices.Contains(c.routePlan, stop) {
+			return false
 		}
 	}
-	return false
+
+	return true
 }
 
-func (c *Courier) TakeOrder(order *order.Order) error {
+// PickUpOrder - курьер забрал заказ в точке забора, дальше его нужно доставить клиенту
+func (c *Courier) PickUpOrder(order *order.Order) error {
 	if order == nil {
 		return errs.NewValueIsInvalidErrorWithCause("order", errors.New("order is nil"))
 	}
 
-	if !c.CanTakeOrder(order) {
-		return errs.NewValueIsInvalidErrorWithCause("order", errors.New("courier has no storage place with enough volume"))
+	if _, err := c.findStoragePlaceByOrderID(order.ID()); err != nil {
+		return err
 	}
 
+	c.routePlan = slices.DeleteFunc(c.routePlan, func(stop RouteStop) bool {
+		return stop.orderID == order.ID() && stop.pickup
+	})
+
+	return nil
+}
+
+func (c *Courier) CompleteOrder(order *order.Order) error {
+	if err := c.releaseStoragePlace(order); err != nil {
+		return err
+	}
+
+	c.RaiseDomainEvent(event.NewOrderDelivered(c.ID(), order.ID()))
+
+	return nil
+}
+
+// UnassignOrder - освобождает место хранения заказа, который курьер больше не везет:
+// заказ отменен или возвращается в распределение
+func (c *Courier) UnassignOrder(order *order.Order) error {
+	return c.releaseStoragePlace(order)
+}
+
+// AssignedOrderIDs - заказы, которые сейчас лежат в местах хранения курьера
+func (c *Courier) AssignedOrderIDs() []uuid.UUID {
+	orderIDs := make([]uuid.UUID, 0, len(c.storagePlaces))
 	for _, storagePlace := range c.storagePlaces {
-		if storagePlace.CanStore(int64(order.Volume())) {
-			if err := storagePlace.Store(order.ID(), order.Volume()); err != nil {
-				return err
-			}
-			return nil
+		if storagePlace.OrderID() != nil {
+			orderIDs = append(orderIDs, *storagePlace.OrderID())
 		}
 	}
 
+	return orderIDs
+}
+
+// StartShift - курьер выходит на смену или возвращается с перерыва
+func (c *Courier) StartShift() error {
+	return c.switchToWorkStatus(WorkStatusOnline)
+}
+
+// TakeBreak - курьер на перерыве не получает новые заказы и не двигается, но назначенные заказы остаются за ним
+func (c *Courier) TakeBreak() error {
+	return c.switchToWorkStatus(WorkStatusOnBreak)
+}
+
+// EndShift - курьер уходит со смены. Назначенные ему заказы нужно вернуть в распределение через UnassignOrder.
+// Забранный заказ другому курьеру не передать, поэтому с ним уйти со смены нельзя.
+func (c *Courier) EndShift(assignedOrders []*order.Order) error {
+	for _, assignedOrder := range assignedOrders {
+		if assignedOrder != nil && assignedOrder.Status().Equals(order.StatusPickedUp) {
+			return errs.NewValueIsInvalidErrorWithCause("workStatus", errors.New("курьер не может уйти со смены, пока у него забранный заказ "+assignedOrder.ID().String()))
+		}
+	}
+
+	return c.switchToWorkStatus(WorkStatusOffline)
+}
+
+func (c *Courier) switchToWorkStatus(workStatus WorkStatus) error {
+	workStatusTransition := map[WorkStatus][]WorkStatus{
+		WorkStatusOnline:  {WorkStatusOnBreak, WorkStatusOffline},
+		WorkStatusOnBreak: {WorkStatusOnline, WorkStatusOffline},
+		WorkStatusOffline: {WorkStatusOnline},
+	}
+
+	if !slices.Contains(workStatusTransition[c.workStatus], workStatus) {
+		return errs.NewValueIsInvalidErrorWithCause("workStatus", errors.New("из текущего статуса курьера нельзя перейти в статус "+workStatus.String()))
+	}
+
+	c.workStatus = workStatus
+
 	return nil
 }
 
-func (c *Courier) CompleteOrder(order *order.Order) error {
+func (c *Courier) releaseStoragePlace(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsInvalidErrorWithCause("order", errors.New("order is nil"))
+	}
+
 	storagePlace, err := c.findStoragePlaceByOrderID(order.ID())
 	if err != nil {
 		return err
@@ -141,43 +339,173 @@ func (c *Courier) CompleteOrder(order *order.Order) error {
 		return err
 	}
 
+	c.routePlan = slices.DeleteFunc(c.routePlan, func(stop RouteStop) bool {
+		return stop.orderID == order.ID()
+	})
+
 	return nil
 }
 
-func (c *Courier) CalculateTimeToLocation(target kernel.Location) float64 {
-	distance := c.location.DistanceTo(target)
+// CanReach - есть ли дорога от текущего местоположения курьера до target
+func (c *Courier) CanReach(network kernel.RoadNetwork, target kernel.Location) bool {
+	_, ok := c.distanceTo(network, target)
+	return ok
+}
+
+// CalculateTimeToLocation - время в пути по дорожной сети. Для недостижимой точки - +Inf.
+func (c *Courier) CalculateTimeToLocation(network kernel.RoadNetwork, target kernel.Location) float64 {
+	distance, ok := c.distanceTo(network, target)
+	if !ok {
+		return math.Inf(1)
+	}
+
 	return float64(distance) / float64(c.speed)
 }
 
-func (c *Courier) Move(target kernel.Location) error {
+// CanDeliver - курьер может доехать до точки забора заказа, а оттуда - до клиента
+func (c *Courier) CanDeliver(network kernel.RoadNetwork, order *order.Order) bool {
+	_, ok := c.deliveryDistance(network, order)
+	return ok
+}
+
+// CalculateTimeToDeliver - время на доставку заказа с учетом заезда в точку забора. Для недостижимого заказа - +Inf.
+func (c *Courier) CalculateTimeToDeliver(network kernel.RoadNetwork, order *order.Order) float64 {
+	distance, ok := c.deliveryDistance(network, order)
+	if !ok {
+		return math.Inf(1)
+	}
+
+	return float64(distance) / float64(c.speed)
+}
+
+// EstimateDeliveryTime - время, когда курьер доставит заказ, если начнет движение в now
+func (c *Courier) EstimateDeliveryTime(network kernel.RoadNetwork, order *order.Order, now time.Time) time.Time {
+	return estimateTime(c.CalculateTimeToDeliver(network, order), now)
+}
+
+func (c *Courier) deliveryDistance(network kernel.RoadNetwork, order *order.Order) (int64, bool) {
+	if !order.AwaitingPickup() {
+		return c.distanceTo(network, order.Location())
+	}
+
+	toPickup, ok := c.distanceTo(network, order.PickupLocation())
+	if !ok {
+		return 0, false
+	}
+
+	toDropoff, ok := network.Distance(order.PickupLocation(), order.Location())
+	if !ok {
+		return 0, false
+	}
+
+	return toPickup + toDropoff, true
+}
+
+// EstimateArrivalTime - время, когда курьер доберется до target, если начнет движение в now
+func (c *Courier) EstimateArrivalTime(network kernel.RoadNetwork, target kernel.Location, now time.Time) time.Time {
+	return estimateTime(c.CalculateTimeToLocation(network, target), now)
+}
+
+// CalculateMovesToDeliver - сколько перемещений нужно курьеру, чтобы доставить заказ, двигаясь по своему маршруту.
+// За одно перемещение курьер не проезжает дальше очередной остановки. false - заказа нет в маршруте или он недостижим.
+func (c *Courier) CalculateMovesToDeliver(network kernel.RoadNetwork, orderID uuid.UUID) (int64, bool) {
+	var moves int64
+	current := c.routeStart()
+	for i, stop := range c.routePlan {
+		distance, ok := network.Distance(current, stop.location)
+		if !ok {
+			return 0, false
+		}
+		if i == 0 {
+			distance += c.transit.left
+		}
+
+		moves += (distance + c.speed - 1) / c.speed
+		current = stop.location
+
+		if stop.orderID == orderID && !stop.pickup {
+			return moves, true
+		}
+	}
+
+	return 0, false
+}
+
+func estimateTime(timeToLocation float64, now time.Time) time.Time {
+	if math.IsInf(timeToLocation, 1) {
+		return now.Add(time.Duration(math.MaxInt64))
+	}
+
+	moves := math.Ceil(timeToLocation)
+	return now.Add(time.Duration(moves) * MoveInterval)
+}
+
+// Move - курьер проходит по маршруту столько, на сколько хватает скорости.
+// Дорогу, на которую не хватило скорости, он проезжает частично и продолжает при следующем перемещении.
+func (c *Courier) Move(network kernel.RoadNetwork, target kernel.Location) error {
 	if !target.IsSet() {
 		return errs.NewValueIsRequiredError("target")
 	}
 
-	dx := float64(target.X() - c.location.X())
-	dy := float64(target.Y() - c.location.Y())
-	remainingRange := float64(c.speed)
-
-	if math.Abs(dx) > remainingRange {
-		dx = math.Copysign(remainingRange, dx)
+	route, ok := network.Route(c.routeStart(), target)
+	if !ok {
+		return errs.NewValueIsInvalidErrorWithCause("target", errors.New("target is unreachable by road"))
 	}
-	remainingRange -= math.Abs(dx)
 
-	if math.Abs(dy) > remainingRange {
-		dy = math.Copysign(remainingRange, dy)
+	if c.transit.IsSet() {
+		route = append([]kernel.RouteStep{kernel.NewRouteStep(c.transit.to, c.transit.left)}, route...)
+		c.transit = Transit{}
 	}
 
-	newX := c.location.X() + int64(dx)
-	newY := c.location.Y() + int64(dy)
+	start := c.location
+	remainingRange := c.speed
+	for _, step := range route {
+		if step.Cost() > remainingRange {
+			if remainingRange > 0 {
+				c.transit = Transit{to: step.Location(), left: step.Cost() - remainingRange}
+			}
+			break
+		}
 
-	newLocation, err := kernel.NewLocation(newX, newY)
-	if err != nil {
-		return err
+		remainingRange -= step.Cost()
+		c.location = step.Location()
+	}
+
+	if !c.location.Equals(start) {
+		c.RaiseDomainEvent(event.NewCourierMoved(c.ID(), c.location.X(), c.location.Y(), time.Now().UTC()))
 	}
-	c.location = newLocation
 
 	return nil
+}
+
+// routeStart - узел, от которого курьер продолжит маршрут: следующий узел, если он в пути
+func (c *Courier) routeStart() kernel.Location {
+	if c.transit.IsSet() {
+		return c.transit.to
+	}
+
+	return c.location
+}
+
+// distanceTo - расстояние по дорожной сети с учетом недоезженной дороги
+func (c *Courier) distanceTo(network kernel.RoadNetwork, target kernel.Location) (int64, bool) {
+	distance, ok := network.Distance(c.routeStart(), target)
+	if !ok {
+		return 0, false
+	}
+
+	return c.transit.left + distance, true
+}
+
+// findStoragePlaceFor - первое место хранения, в которое помещается заказ
+func (c *Courier) findStoragePlaceFor(order *order.Order) (*StoragePlace, bool) {
+	for _, storagePlace := range c.storagePlaces {
+		if storagePlace.CanStore(order.Volume()) {
+			return storagePlace, true
+		}
+	}
 
+	return nil, false
 }
 
 func (c *Courier) findStoragePlaceByOrderID(orderID uuid.UUID) (*StoragePlace, error) {
@@ -1,10 +1,13 @@
 package courier
 
 import (
+	"math"
 	"testing"
 
+	"delivery/internal/core/domain/model/event"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +27,48 @@ func Test_Create_Courier_With_Default_StoragePlace(t *testing.T) {
 	assert.Equal(t, 1, len(courier.StoragePlaces()))
 	assert.Equal(t, defaultStoragePlaceName, courier.StoragePlaces()[0].Name())
 	assert.Equal(t, defaultStoragePlaceVolume, courier.StoragePlaces()[0].TotalVolume())
+	assert.Equal(t, TransportTypeFoot, courier.TransportType())
+}
+
+func Test_Create_Courier_With_Transport_Template(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewRandomLocation()
+
+	// Act
+	courier, err := NewCourierWithTransport("John Doe", TransportTypeCar, 0, location)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, TransportTypeCar, courier.TransportType())
+	assert.Equal(t, TransportTypeCar.DefaultSpeed(), courier.Speed())
+	assert.Equal(t, 2, len(courier.StoragePlaces()))
+	assert.Equal(t, "Багажник", courier.StoragePlaces()[0].Name())
+	assert.Equal(t, int64(40), courier.StoragePlaces()[0].TotalVolume())
+}
+
+func Test_Create_Courier_With_Transport_And_Explicit_Speed(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewRandomLocation()
+
+	// Act
+	courier, err := NewCourierWithTransport("John Doe", TransportTypeBicycle, 5, location)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), courier.Speed())
+	assert.Equal(t, 2, len(courier.StoragePlaces()))
+}
+
+func Test_Create_Courier_With_Unknown_Transport_Fails(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewRandomLocation()
+
+	// Act
+	courier, err := NewCourierWithTransport("John Doe", TransportType("Rocket"), 0, location)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, courier)
 }
 
 func Test_Courier_Can_Add_New_Storage_Place(t *testing.T) {
@@ -61,7 +106,7 @@ func Test_Courier_Can_Take_Order_If_At_Least_One_Storage_Place_Has_Enough_Volume
 			courier: func() *Courier {
 				courier := newCourier(t)
 				order := newOrderWithRandomLocationAndSettedVolume(t, 5)
-				_ = courier.TakeOrder(order)
+				_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
 
 				_ = courier.AddStoragePlace("Ящик", 20)
 
@@ -92,7 +137,7 @@ func Test_Courier_Can_Not_Take_Order_If_Storage_Place_Is_Occupied(t *testing.T)
 	newOrder := newOrderWithRandomLocationAndSettedVolume(t, 5)
 
 	// Act
-	_ = courier.TakeOrder(placedOrder)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), placedOrder)
 	canTakeOrder := courier.CanTakeOrder(newOrder)
 
 	// Assert
@@ -106,8 +151,8 @@ func Test_Courier_Impossible_To_Take_Order_If_Storage_Place_Is_Occupied_By_Anoth
 	newOrder := newOrderWithRandomLocationAndSettedVolume(t, 5)
 
 	// Act
-	_ = courier.TakeOrder(placedOrder)
-	err := courier.TakeOrder(newOrder)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), placedOrder)
+	err := courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), newOrder)
 
 	// Assert
 	assert.Error(t, err)
@@ -119,7 +164,7 @@ func Test_Courier_Take_New_Order(t *testing.T) {
 	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
 
 	// Act
-	err := courier.TakeOrder(order)
+	err := courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
 
 	// Assert
 	assert.NoError(t, err)
@@ -132,7 +177,7 @@ func Test_Courier_Can_Complete_Order(t *testing.T) {
 	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
 
 	// Act
-	_ = courier.TakeOrder(order)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
 	err := courier.CompleteOrder(order)
 
 	// Assert
@@ -140,6 +185,128 @@ func Test_Courier_Can_Complete_Order(t *testing.T) {
 	assert.Nil(t, courier.StoragePlaces()[0].OrderID())
 }
 
+func Test_Courier_Can_Unassign_Cancelled_Order(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
+
+	// Act
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
+	err := courier.UnassignOrder(order)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, courier.StoragePlaces()[0].OrderID())
+	assert.True(t, courier.CanTakeOrder(order))
+}
+
+func Test_Courier_Cannot_Unassign_Order_It_Does_Not_Store(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
+
+	// Act
+	err := courier.UnassignOrder(order)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_New_Courier_Is_Online(t *testing.T) {
+	// Act
+	courier := newCourier(t)
+
+	// Assert
+	assert.Equal(t, WorkStatusOnline, courier.WorkStatus())
+}
+
+func Test_Courier_Can_Take_Break_And_Return(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+
+	// Act
+	breakErr := courier.TakeBreak()
+	returnErr := courier.StartShift()
+
+	// Assert
+	assert.NoError(t, breakErr)
+	assert.NoError(t, returnErr)
+	assert.Equal(t, WorkStatusOnline, courier.WorkStatus())
+}
+
+func Test_Courier_Can_End_Shift_From_Break(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	_ = courier.TakeBreak()
+
+	// Act
+	err := courier.EndShift(nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, WorkStatusOffline, courier.WorkStatus())
+}
+
+func Test_Courier_Cannot_End_Shift_With_Picked_Up_Order(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	pickedUpOrder := newOrderWithRandomLocationAndSettedVolume(t, 5)
+	pickupLocation, _ := shared_kernel.NewRandomLocation()
+	_ = pickedUpOrder.SetPickupLocation(pickupLocation)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), pickedUpOrder)
+	_ = pickedUpOrder.Assign(courier.ID())
+	_ = pickedUpOrder.PickUp()
+	_ = courier.PickUpOrder(pickedUpOrder)
+
+	// Act
+	err := courier.EndShift([]*order.Order{pickedUpOrder})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Contains(t, err.Error(), pickedUpOrder.ID().String())
+	assert.Equal(t, WorkStatusOnline, courier.WorkStatus())
+}
+
+func Test_Courier_Cannot_Take_Break_When_Offline(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	_ = courier.EndShift(nil)
+
+	// Act
+	err := courier.TakeBreak()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, WorkStatusOffline, courier.WorkStatus())
+}
+
+func Test_Courier_Cannot_Start_Shift_Twice(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+
+	// Act
+	err := courier.StartShift()
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_Courier_Unassign_Order_Releases_Storage_Place(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
+
+	// Act
+	orderIDs := courier.AssignedOrderIDs()
+	err := courier.UnassignOrder(order)
+
+	// Assert
+	assert.Equal(t, []uuid.UUID{order.ID()}, orderIDs)
+	assert.NoError(t, err)
+	assert.Empty(t, courier.AssignedOrderIDs())
+}
+
 func Test_Calculate_Time_To_Location(t *testing.T) {
 	// Arrange
 	startLocation, _ := shared_kernel.NewLocation(1, 1)
@@ -147,12 +314,84 @@ func Test_Calculate_Time_To_Location(t *testing.T) {
 	courier, _ := NewCourier("John Doe", 2, startLocation)
 
 	// Act
-	time := courier.CalculateTimeToLocation(targetLocation)
+	time := courier.CalculateTimeToLocation(shared_kernel.NewGridRoadNetwork(), targetLocation)
 
 	// Assert
 	assert.GreaterOrEqual(t, time, 4.0)
 }
 
+func Test_Courier_Moves_Around_Blocked_Cells(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 2)
+	target, _ := shared_kernel.NewLocation(3, 2)
+	blocked, _ := shared_kernel.NewLocation(2, 2)
+	graph, _ := shared_kernel.NewRoadGraph(shared_kernel.DefaultMapBounds(), true, nil, []shared_kernel.Location{blocked})
+
+	courier, _ := NewCourier("John Doe", 1, start)
+
+	// Act
+	moves := 0
+	for !courier.Location().Equals(target) && moves < 10 {
+		assert.NoError(t, courier.Move(graph, target))
+		assert.False(t, courier.Location().Equals(blocked))
+		moves++
+	}
+
+	// Assert
+	assert.Equal(t, 4, moves)
+	assert.Equal(t, target, courier.Location())
+}
+
+func Test_Courier_Moves_Along_Road_Longer_Than_Speed_In_Several_Moves(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	target, _ := shared_kernel.NewLocation(1, 6)
+	edge, _ := shared_kernel.NewRoadEdge(start, target, 5, false)
+	graph, _ := shared_kernel.NewRoadGraph(shared_kernel.DefaultMapBounds(), false, []shared_kernel.RoadEdge{edge}, nil)
+
+	courier, _ := NewCourier("John Doe", 2, start)
+	order, _ := order.NewOrder(uuid.New(), target, 1)
+	_ = courier.TakeOrder(graph, order)
+	expectedMoves, _ := courier.CalculateMovesToDeliver(graph, order.ID())
+
+	// Act
+	err := courier.Move(graph, target)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, start, courier.Location())
+	assert.False(t, courier.IsAt(target))
+	assert.Equal(t, int64(3), courier.Transit().Left())
+	movesLeft, _ := courier.CalculateMovesToDeliver(graph, order.ID())
+	assert.Equal(t, int64(2), movesLeft)
+
+	moves := int64(1)
+	for !courier.IsAt(target) && moves < 10 {
+		assert.NoError(t, courier.Move(graph, target))
+		moves++
+	}
+	assert.Equal(t, int64(3), expectedMoves)
+	assert.Equal(t, expectedMoves, moves)
+	assert.False(t, courier.Transit().IsSet())
+}
+
+func Test_Courier_Cannot_Reach_Location_Without_Road(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	target, _ := shared_kernel.NewLocation(10, 10)
+	graph, _ := shared_kernel.NewRoadGraph(shared_kernel.DefaultMapBounds(), false, nil, nil)
+
+	courier, _ := NewCourier("John Doe", 1, start)
+
+	// Act
+	err := courier.Move(graph, target)
+
+	// Assert
+	assert.Error(t, err)
+	assert.False(t, courier.CanReach(graph, target))
+	assert.True(t, math.IsInf(courier.CalculateTimeToLocation(graph, target), 1))
+}
+
 func newCourier(t *testing.T) *Courier {
 	t.Helper()
 
@@ -176,3 +415,130 @@ func newOrderWithRandomLocationAndSettedVolume(t *testing.T, volume int64) *orde
 
 	return order
 }
+
+func Test_Courier_Move_Raises_CourierMoved_Event(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	target, _ := shared_kernel.NewLocation(5, 1)
+	courier, _ := NewCourier("John Doe", 2, start)
+	courier.ClearDomainEvents()
+
+	// Act
+	err := courier.Move(shared_kernel.NewGridRoadNetwork(), target)
+
+	// Assert
+	assert.NoError(t, err)
+	events := courier.GetDomainEvents()
+	assert.Len(t, events, 1)
+
+	courierMovedEvent, ok := events[0].(*event.CourierMoved)
+	assert.True(t, ok, "Expected event to be *event.CourierMoved")
+	assert.Equal(t, courier.ID(), courierMovedEvent.GetCourierID())
+	assert.Equal(t, int64(3), courierMovedEvent.X)
+	assert.Equal(t, int64(1), courierMovedEvent.Y)
+}
+
+func Test_Courier_Move_To_Current_Location_Does_Not_Raise_Event(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := NewCourier("John Doe", 2, start)
+	courier.ClearDomainEvents()
+
+	// Act
+	err := courier.Move(shared_kernel.NewGridRoadNetwork(), start)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, courier.GetDomainEvents())
+}
+
+func Test_New_Courier_Raises_CourierCreated_Event(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewRandomLocation()
+
+	// Act
+	courier, err := NewCourierWithTransport("John Doe", TransportTypeFoot, 0, location)
+
+	// Assert
+	assert.NoError(t, err)
+	events := courier.GetDomainEvents()
+	assert.Len(t, events, 1)
+
+	courierCreatedEvent, ok := events[0].(*event.CourierCreated)
+	assert.True(t, ok, "Expected event to be *event.CourierCreated")
+	assert.Equal(t, courier.ID(), courierCreatedEvent.GetCourierID())
+	assert.Equal(t, "John Doe", courierCreatedEvent.CourierName)
+	assert.Equal(t, TransportTypeFoot.String(), courierCreatedEvent.TransportType)
+}
+
+func Test_Loaded_Courier_Has_No_Domain_Events(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewRandomLocation()
+
+	// Act
+	courier := LoadCourierFromRepo(uuid.New(), "John Doe", 1, location, Transit{}, TransportTypeFoot, nil, nil, WorkStatusOnline, 1)
+
+	// Assert
+	assert.Empty(t, courier.GetDomainEvents())
+}
+
+func Test_Courier_AddStoragePlace_Raises_StoragePlaceAdded_Event(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	courier.ClearDomainEvents()
+
+	// Act
+	err := courier.AddStoragePlace("Багажник", 50)
+
+	// Assert
+	assert.NoError(t, err)
+	events := courier.GetDomainEvents()
+	assert.Len(t, events, 1)
+
+	storagePlaceAddedEvent, ok := events[0].(*event.StoragePlaceAdded)
+	assert.True(t, ok, "Expected event to be *event.StoragePlaceAdded")
+	assert.Equal(t, courier.ID(), storagePlaceAddedEvent.GetCourierID())
+	assert.Equal(t, courier.StoragePlaces()[1].ID(), storagePlaceAddedEvent.StoragePlaceID)
+	assert.Equal(t, int64(50), storagePlaceAddedEvent.TotalVolume)
+}
+
+func Test_Courier_TakeOrder_And_CompleteOrder_Raise_Events(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	courier.ClearDomainEvents()
+	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
+
+	// Act
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
+	err := courier.CompleteOrder(order)
+
+	// Assert
+	assert.NoError(t, err)
+	events := courier.GetDomainEvents()
+	assert.Len(t, events, 2)
+
+	orderTakenEvent, ok := events[0].(*event.OrderTaken)
+	assert.True(t, ok, "Expected first event to be *event.OrderTaken")
+	assert.Equal(t, courier.ID(), orderTakenEvent.GetCourierID())
+	assert.Equal(t, order.ID(), orderTakenEvent.GetOrderID())
+	assert.Equal(t, courier.StoragePlaces()[0].ID(), orderTakenEvent.StoragePlaceID)
+
+	orderDeliveredEvent, ok := events[1].(*event.OrderDelivered)
+	assert.True(t, ok, "Expected last event to be *event.OrderDelivered")
+	assert.Equal(t, courier.ID(), orderDeliveredEvent.GetCourierID())
+	assert.Equal(t, order.ID(), orderDeliveredEvent.GetOrderID())
+}
+
+func Test_Courier_Failed_CompleteOrder_Does_Not_Raise_Event(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	courier.ClearDomainEvents()
+	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
+
+	// Act
+	err := courier.CompleteOrder(order)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, courier.GetDomainEvents())
+}
@@ -0,0 +1,197 @@
+package courier
+
+import (
+	"math"
+
+	"delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+
+	"github.com/google/uuid"
+)
+
+// RouteStop - остановка в маршруте курьера: забрать заказ из точки забора или доставить клиенту
+type RouteStop struct {
+	orderID  uuid.UUID
+	location kernel.Location
+	pickup   bool
+}
+
+func NewRouteStop(orderID uuid.UUID, location kernel.Location) RouteStop {
+	return RouteStop{orderID: orderID, location: location}
+}
+
+func NewPickupRouteStop(orderID uuid.UUID, location kernel.Location) RouteStop {
+	return RouteStop{orderID: orderID, location: location, pickup: true}
+}
+
+func (s RouteStop) OrderID() uuid.UUID {
+	return s.orderID
+}
+
+func (s RouteStop) Location() kernel.Location {
+	return s.location
+}
+
+func (s RouteStop) IsPickup() bool {
+	return s.pickup
+}
+
+// routeStopsFor - остановки, которые нужны заказу: точка забора, если заказ еще не забран, и адрес доставки
+func routeStopsFor(order *order.Order) []RouteStop {
+	if order.AwaitingPickup() {
+		return []RouteStop{
+			NewPickupRouteStop(order.ID(), order.PickupLocation()),
+			NewRouteStop(order.ID(), order.Location()),
+		}
+	}
+
+	return []RouteStop{NewRouteStop(order.ID(), order.Location())}
+}
+
+// planRoute - порядок объезда остановок от start: жадный ближайший сосед, затем улучшение 2-opt.
+// Маршрут открытый - курьер не возвращается в начальную точку. Заказ доставляется только после того, как забран.
+// Недостижимые остановки в планировании не участвуют и идут в конце маршрута в прежнем порядке.
+func planRoute(network kernel.RoadNetwork, start kernel.Location, stops []RouteStop) []RouteStop {
+	if len(stops) < 2 {
+		return stops
+	}
+
+	reachable, unreachable := splitUnreachableStops(network, start, stops)
+
+	route := nearestNeighbourRoute(network, start, reachable)
+	route = improveRouteWith2Opt(network, start, route)
+
+	return append(route, unreachable...)
+}
+
+// splitUnreachableStops - отделяет остановки, до которых нет дороги от start. Одна недостижимая остановка
+// делает длину всего маршрута бесконечной, и 2-opt не смог бы улучшить остальные.
+// Доставка заказа с недостижимой точкой забора тоже считается недостижимой.
+func splitUnreachableStops(network kernel.RoadNetwork, start kernel.Location, stops []RouteStop) ([]RouteStop, []RouteStop) {
+	unreachableOrders := make(map[uuid.UUID]struct{})
+	for _, stop := range stops {
+		if _, ok := network.Distance(start, stop.location); !ok && stop.pickup {
+			unreachableOrders[stop.orderID] = struct{}{}
+		}
+	}
+
+	reachable := make([]RouteStop, 0, len(stops))
+	var unreachable []RouteStop
+	for _, stop := range stops {
+		_, pickupUnreachable := unreachableOrders[stop.orderID]
+		if _, ok := network.Distance(start, stop.location); !ok || pickupUnreachable {
+			unreachable = append(unreachable, stop)
+			continue
+		}
+
+		reachable = append(reachable, stop)
+	}
+
+	return reachable, unreachable
+}
+
+func nearestNeighbourRoute(network kernel.RoadNetwork, start kernel.Location, stops []RouteStop) []RouteStop {
+	remaining := make([]RouteStop, len(stops))
+	copy(remaining, stops)
+
+	route := make([]RouteStop, 0, len(stops))
+	current := start
+
+	for len(remaining) > 0 {
+		nearest := -1
+		for i := range remaining {
+			if awaitsPickup(remaining, remaining[i]) {
+				continue
+			}
+
+			if nearest < 0 || roadDistance(network, current, remaining[i].location) < roadDistance(network, current, remaining[nearest].location) {
+				nearest = i
+			}
+		}
+
+		route = append(route, remaining[nearest])
+		current = remaining[nearest].location
+		remaining = append(remaining[:nearest], remaining[nearest+1:]...)
+	}
+
+	return route
+}
+
+// improveRouteWith2Opt - разворачивает участки маршрута, пока это сокращает его длину
+func improveRouteWith2Opt(network kernel.RoadNetwork, start kernel.Location, route []RouteStop) []RouteStop {
+	best := routeLength(network, start, route)
+
+	for improved := true; improved; {
+		improved = false
+
+		for i := 0; i < len(route)-1; i++ {
+			for j := i + 1; j < len(route); j++ {
+				candidate := make([]RouteStop, len(route))
+				copy(candidate, route)
+				reverseStops(candidate[i : j+1])
+
+				if !respectsPickupOrder(candidate) {
+					continue
+				}
+
+				if length := routeLength(network, start, candidate); length < best {
+					route, best, improved = candidate, length, true
+				}
+			}
+		}
+	}
+
+	return route
+}
+
+func routeLength(network kernel.RoadNetwork, start kernel.Location, route []RouteStop) float64 {
+	length := 0.0
+	current := start
+	for _, stop := range route {
+		length += roadDistance(network, current, stop.location)
+		current = stop.location
+	}
+
+	return length
+}
+
+// roadDistance - расстояние по дорожной сети, для недостижимой точки - +Inf
+func roadDistance(network kernel.RoadNetwork, from, to kernel.Location) float64 {
+	distance, ok := network.Distance(from, to)
+	if !ok {
+		return math.Inf(1)
+	}
+
+	return float64(distance)
+}
+
+// awaitsPickup - доставку нельзя планировать, пока в оставшихся остановках есть забор этого заказа
+func awaitsPickup(remaining []RouteStop, stop RouteStop) bool {
+	if stop.pickup {
+		return false
+	}
+
+	for _, other := range remaining {
+		if other.pickup && other.orderID == stop.orderID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func respectsPickupOrder(route []RouteStop) bool {
+	for i, stop := range route {
+		if awaitsPickup(route[i+1:], stop) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func reverseStops(stops []RouteStop) {
+	for i, j := 0, len(stops)-1; i < j; i, j = i+1, j-1 {
+		stops[i], stops[j] = stops[j], stops[i]
+	}
+}
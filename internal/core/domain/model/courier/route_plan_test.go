@@ -0,0 +1,168 @@
+package courier
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Plan_Route_Visits_Nearest_Stop_First(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	far := NewRouteStop(uuid.New(), mustLocation(t, 10, 10))
+	near := NewRouteStop(uuid.New(), mustLocation(t, 2, 2))
+	middle := NewRouteStop(uuid.New(), mustLocation(t, 5, 5))
+
+	// Act
+	route := planRoute(shared_kernel.NewGridRoadNetwork(), start, []RouteStop{far, near, middle})
+
+	// Assert
+	assert.Equal(t, []RouteStop{near, middle, far}, route)
+}
+
+func Test_Plan_Route_2Opt_Removes_Crossing(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(5, 1)
+	route := []RouteStop{
+		NewRouteStop(uuid.New(), mustLocation(t, 1, 1)),
+		NewRouteStop(uuid.New(), mustLocation(t, 10, 2)),
+		NewRouteStop(uuid.New(), mustLocation(t, 1, 3)),
+		NewRouteStop(uuid.New(), mustLocation(t, 10, 4)),
+	}
+
+	// Act
+	improved := improveRouteWith2Opt(shared_kernel.NewGridRoadNetwork(), start, route)
+
+	// Assert
+	assert.Less(t, routeLength(shared_kernel.NewGridRoadNetwork(), start, improved), routeLength(shared_kernel.NewGridRoadNetwork(), start, route))
+	assert.ElementsMatch(t, route, improved)
+}
+
+func Test_Plan_Route_Optimizes_Reachable_Stops_And_Puts_Unreachable_Last(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(3, 6)
+	wall := []shared_kernel.Location{mustLocation(t, 9, 10), mustLocation(t, 10, 9)}
+	graph, _ := shared_kernel.NewRoadGraph(shared_kernel.DefaultMapBounds(), true, nil, wall)
+	unreachable := NewRouteStop(uuid.New(), mustLocation(t, 10, 10))
+	reachable := []RouteStop{
+		NewRouteStop(uuid.New(), mustLocation(t, 6, 7)),
+		NewRouteStop(uuid.New(), mustLocation(t, 8, 6)),
+		NewRouteStop(uuid.New(), mustLocation(t, 9, 3)),
+		NewRouteStop(uuid.New(), mustLocation(t, 5, 8)),
+	}
+	greedyRoute := nearestNeighbourRoute(graph, start, reachable)
+
+	// Act
+	route := planRoute(graph, start, append([]RouteStop{unreachable}, reachable...))
+
+	// Assert
+	assert.Len(t, route, 5)
+	assert.Equal(t, unreachable, route[4])
+	assert.ElementsMatch(t, reachable, route[:4])
+	assert.Less(t, routeLength(graph, start, route[:4]), routeLength(graph, start, greedyRoute))
+}
+
+func Test_Courier_Replans_Route_When_Taking_Order(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := NewCourier("John Doe", 1, start)
+	_ = courier.AddStoragePlace("Ящик", 10)
+	farOrder, _ := order.NewOrder(uuid.New(), mustLocation(t, 10, 10), 5)
+	nearOrder, _ := order.NewOrder(uuid.New(), mustLocation(t, 2, 1), 5)
+
+	// Act
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), farOrder)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), nearOrder)
+
+	// Assert
+	stop, ok := courier.NextStop()
+	assert.True(t, ok)
+	assert.Equal(t, nearOrder.ID(), stop.OrderID())
+	assert.Len(t, courier.RoutePlan(), 2)
+	assert.True(t, courier.RoutePlanMatches([]*order.Order{farOrder, nearOrder}))
+}
+
+func Test_Courier_Removes_Stop_When_Order_Completed(t *testing.T) {
+	// Arrange
+	courier := newCourier(t)
+	order := newOrderWithRandomLocationAndSettedVolume(t, 5)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), order)
+
+	// Act
+	err := courier.CompleteOrder(order)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, courier.RoutePlan())
+}
+
+func mustLocation(t *testing.T, x, y int64) shared_kernel.Location {
+	t.Helper()
+
+	location, err := shared_kernel.NewLocation(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location
+}
+
+func Test_Plan_Route_Picks_Up_Before_Delivery(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := NewCourier("John Doe", 1, start)
+	orderWithPickup, _ := order.NewOrder(uuid.New(), mustLocation(t, 2, 1), 5)
+	_ = orderWithPickup.SetPickupLocation(mustLocation(t, 10, 10))
+
+	// Act
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), orderWithPickup)
+
+	// Assert
+	route := courier.RoutePlan()
+	assert.Len(t, route, 2)
+	assert.True(t, route[0].IsPickup())
+	assert.Equal(t, orderWithPickup.PickupLocation(), route[0].Location())
+	assert.Equal(t, orderWithPickup.Location(), route[1].Location())
+}
+
+func Test_Courier_Delivery_Time_Includes_Pickup(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := NewCourier("John Doe", 2, start)
+	orderWithPickup, _ := order.NewOrder(uuid.New(), mustLocation(t, 1, 1), 5)
+	_ = orderWithPickup.SetPickupLocation(mustLocation(t, 5, 1))
+
+	// Act
+	timeToDeliver := courier.CalculateTimeToDeliver(shared_kernel.NewGridRoadNetwork(), orderWithPickup)
+
+	// Assert
+	assert.Equal(t, 4.0, timeToDeliver)
+	assert.Equal(t, 0.0, courier.CalculateTimeToLocation(shared_kernel.NewGridRoadNetwork(), orderWithPickup.Location()))
+}
+
+func Test_Courier_Moves_To_Deliver_Follow_Route_Plan(t *testing.T) {
+	// Arrange
+	start, _ := shared_kernel.NewLocation(1, 1)
+	courier, _ := NewCourier("John Doe", 2, start)
+	_ = courier.AddStoragePlace("Ящик", 10)
+	nearOrder, _ := order.NewOrder(uuid.New(), mustLocation(t, 4, 1), 5)
+	farOrder, _ := order.NewOrder(uuid.New(), mustLocation(t, 4, 6), 5)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), nearOrder)
+	_ = courier.TakeOrder(shared_kernel.NewGridRoadNetwork(), farOrder)
+
+	// Act
+	nearMoves, nearOk := courier.CalculateMovesToDeliver(shared_kernel.NewGridRoadNetwork(), nearOrder.ID())
+	farMoves, farOk := courier.CalculateMovesToDeliver(shared_kernel.NewGridRoadNetwork(), farOrder.ID())
+	_, unknownOk := courier.CalculateMovesToDeliver(shared_kernel.NewGridRoadNetwork(), uuid.New())
+
+	// Assert
+	assert.True(t, nearOk)
+	assert.Equal(t, int64(2), nearMoves)
+	assert.True(t, farOk)
+	assert.Equal(t, int64(5), farMoves)
+	assert.False(t, unknownOk)
+}
@@ -0,0 +1,31 @@
+package courier
+
+import (
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+)
+
+// Transit - дорога, по которой курьер едет к следующему узлу дорожной сети.
+// Дорогу длиннее своей скорости курьер проходит за несколько перемещений.
+type Transit struct {
+	to   kernel.Location
+	left int64
+}
+
+func LoadTransitFromRepo(to kernel.Location, left int64) Transit {
+	return Transit{to: to, left: left}
+}
+
+// To - узел, к которому едет курьер
+func (t Transit) To() kernel.Location {
+	return t.to
+}
+
+// Left - сколько курьеру осталось ехать до узла To
+func (t Transit) Left() int64 {
+	return t.left
+}
+
+// IsSet - курьер сейчас в пути между узлами
+func (t Transit) IsSet() bool {
+	return t.left > 0
+}
@@ -0,0 +1,94 @@
+package courier
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+)
+
+const (
+	TransportTypeEmpty   TransportType = ""
+	TransportTypeFoot    TransportType = "Foot"
+	TransportTypeBicycle TransportType = "Bicycle"
+	TransportTypeScooter TransportType = "Scooter"
+	TransportTypeCar     TransportType = "Car"
+)
+
+// TransportType - на чем передвигается курьер. Определяет скорость по умолчанию и набор мест хранения.
+type TransportType string
+
+type storagePlaceTemplate struct {
+	name   string
+	volume int64
+}
+
+type transportTemplate struct {
+	speed         int64
+	storagePlaces []storagePlaceTemplate
+}
+
+var transportTemplates = map[TransportType]transportTemplate{
+	TransportTypeFoot: {
+		speed:         1,
+		storagePlaces: []storagePlaceTemplate{{name: defaultStoragePlaceName, volume: defaultStoragePlaceVolume}},
+	},
+	TransportTypeBicycle: {
+		speed: 2,
+		storagePlaces: []storagePlaceTemplate{
+			{name: defaultStoragePlaceName, volume: defaultStoragePlaceVolume},
+			{name: "Корзина", volume: 10},
+		},
+	},
+	TransportTypeScooter: {
+		speed:         3,
+		storagePlaces: []storagePlaceTemplate{{name: "Кофр", volume: 20}},
+	},
+	TransportTypeCar: {
+		speed: 4,
+		storagePlaces: []storagePlaceTemplate{
+			{name: "Багажник", volume: 40},
+			{name: "Салон", volume: 20},
+		},
+	},
+}
+
+func NewTransportType(value string) (TransportType, error) {
+	transportType := TransportType(value)
+	if _, ok := transportTemplates[transportType]; !ok {
+		return TransportTypeEmpty, errs.NewValueIsInvalidErrorWithCause("transportType", errors.New("unknown transport type "+value))
+	}
+
+	return transportType, nil
+}
+
+func (t TransportType) Equals(other TransportType) bool {
+	return t == other
+}
+
+func (t TransportType) IsEmpty() bool {
+	return t == TransportTypeEmpty
+}
+
+func (t TransportType) String() string {
+	return string(t)
+}
+
+// DefaultSpeed - скорость курьера, если при создании она не указана явно
+func (t TransportType) DefaultSpeed() int64 {
+	return transportTemplates[t].speed
+}
+
+func (t TransportType) newStoragePlaces() ([]*StoragePlace, error) {
+	templates := transportTemplates[t].storagePlaces
+
+	storagePlaces := make([]*StoragePlace, 0, len(templates))
+	for _, template := range templates {
+		storagePlace, err := NewStoragePlace(template.name, template.volume)
+		if err != nil {
+			return nil, err
+		}
+		storagePlaces = append(storagePlaces, storagePlace)
+	}
+
+	return storagePlaces, nil
+}
@@ -0,0 +1,23 @@
+package courier
+
+const (
+	WorkStatusEmpty   WorkStatus = ""
+	WorkStatusOnline  WorkStatus = "Online"
+	WorkStatusOnBreak WorkStatus = "OnBreak"
+	WorkStatusOffline WorkStatus = "Offline"
+)
+
+// WorkStatus - находится ли курьер на смене. Заказы получают только курьеры в статусе Online.
+type WorkStatus string
+
+func (s WorkStatus) Equals(other WorkStatus) bool {
+	return s == other
+}
+
+func (s WorkStatus) IsEmpty() bool {
+	return s == WorkStatusEmpty
+}
+
+func (s WorkStatus) String() string {
+	return string(s)
+}
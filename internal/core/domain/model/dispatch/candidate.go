@@ -0,0 +1,49 @@
+package dispatch
+
+import (
+	"github.com/google/uuid"
+)
+
+// RejectionReason - почему курьер не получил заказ. Пустая причина - курьер выбран.
+type RejectionReason string
+
+const (
+	RejectionReasonNone                   RejectionReason = ""
+	RejectionReasonNoStoragePlace         RejectionReason = "no storage place >= volume"
+	RejectionReasonDeliveryPeriod         RejectionReason = "cannot meet delivery period"
+	RejectionReasonNotSelected            RejectionReason = "not selected by strategy"
+	RejectionReasonAssignedToAnotherOrder RejectionReason = "assigned to another order"
+	RejectionReasonUnreachable            RejectionReason = "no road to order location"
+)
+
+// Candidate - курьер, рассмотренный при назначении заказа
+type Candidate struct {
+	courierID       uuid.UUID
+	timeToLocation  float64
+	rejectionReason RejectionReason
+}
+
+func NewCandidate(courierID uuid.UUID, timeToLocation float64, rejectionReason RejectionReason) Candidate {
+	return Candidate{
+		courierID:       courierID,
+		timeToLocation:  timeToLocation,
+		rejectionReason: rejectionReason,
+	}
+}
+
+func (c Candidate) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c Candidate) TimeToLocation() float64 {
+	return c.timeToLocation
+}
+
+func (c Candidate) RejectionReason() RejectionReason {
+	return c.rejectionReason
+}
+
+// IsEligible - курьер может взять заказ и успевает в окно доставки
+func (c Candidate) IsEligible() bool {
+	return c.rejectionReason == RejectionReasonNone
+}
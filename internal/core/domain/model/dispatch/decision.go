@@ -0,0 +1,152 @@
+package dispatch
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// Decision - запись о назначении заказа: какие курьеры рассматривались,
+// почему каждый из них отклонен и кто в итоге получил заказ
+type Decision struct {
+	id         uuid.UUID
+	orderID    uuid.UUID
+	strategy   string
+	courierID  *uuid.UUID
+	candidates []Candidate
+	decidedAt  time.Time
+}
+
+func NewDecision(orderID uuid.UUID, strategy string, decidedAt time.Time) (*Decision, error) {
+	if orderID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if strategy == "" {
+		return nil, errs.NewValueIsRequiredError("strategy")
+	}
+
+	return &Decision{
+		id:         uuid.New(),
+		orderID:    orderID,
+		strategy:   strategy,
+		candidates: []Candidate{},
+		decidedAt:  decidedAt.UTC(),
+	}, nil
+}
+
+func LoadDecisionFromRepo(id uuid.UUID, orderID uuid.UUID, strategy string, courierID *uuid.UUID, candidates []Candidate, decidedAt time.Time) *Decision {
+	return &Decision{
+		id:         id,
+		orderID:    orderID,
+		strategy:   strategy,
+		courierID:  courierID,
+		candidates: candidates,
+		decidedAt:  decidedAt,
+	}
+}
+
+func (d *Decision) ID() uuid.UUID {
+	return d.id
+}
+
+func (d *Decision) OrderID() uuid.UUID {
+	return d.orderID
+}
+
+func (d *Decision) Strategy() string {
+	return d.strategy
+}
+
+// CourierID - выбранный курьер, nil если заказ никому не назначен
+func (d *Decision) CourierID() *uuid.UUID {
+	return d.courierID
+}
+
+func (d *Decision) Candidates() []Candidate {
+	candidates := make([]Candidate, len(d.candidates))
+	copy(candidates, d.candidates)
+
+	return candidates
+}
+
+func (d *Decision) DecidedAt() time.Time {
+	return d.decidedAt
+}
+
+func (d *Decision) AddCandidate(candidate Candidate) {
+	d.candidates = append(d.candidates, candidate)
+}
+
+// Select - фиксирует победителя, остальные подходящие курьеры помечаются как не выбранные стратегией
+func (d *Decision) Select(courierID uuid.UUID) error {
+	index := d.findEligibleCandidate(courierID)
+	if index < 0 {
+		return errs.NewValueIsInvalidErrorWithCause("courierID", errors.New("courier is not an eligible candidate"))
+	}
+
+	for i := range d.candidates {
+		if i != index && d.candidates[i].IsEligible() {
+			d.candidates[i].rejectionReason = RejectionReasonNotSelected
+		}
+	}
+
+	d.courierID = &courierID
+	return nil
+}
+
+// RejectEligible - отклоняет всех подходящих курьеров, если заказ так никому и не достался
+func (d *Decision) RejectEligible(reason RejectionReason) {
+	if d.courierID != nil {
+		return
+	}
+
+	for i := range d.candidates {
+		if d.candidates[i].IsEligible() {
+			d.candidates[i].rejectionReason = reason
+		}
+	}
+}
+
+// HasSameOutcomeAs - привел ли назначение к тому же результату, что и other: тот же курьер
+// и те же причины отказа у тех же кандидатов. Время до заказа не сравнивается - оно меняется с каждым шагом курьера.
+func (d *Decision) HasSameOutcomeAs(other *Decision) bool {
+	if other == nil || d.orderID != other.orderID || d.strategy != other.strategy {
+		return false
+	}
+
+	if (d.courierID == nil) != (other.courierID == nil) || (d.courierID != nil && *d.courierID != *other.courierID) {
+		return false
+	}
+
+	if len(d.candidates) != len(other.candidates) {
+		return false
+	}
+
+	reasons := make(map[uuid.UUID]RejectionReason, len(other.candidates))
+	for _, candidate := range other.candidates {
+		reasons[candidate.courierID] = candidate.rejectionReason
+	}
+
+	for _, candidate := range d.candidates {
+		reason, ok := reasons[candidate.courierID]
+		if !ok || reason != candidate.rejectionReason {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *Decision) findEligibleCandidate(courierID uuid.UUID) int {
+	for i, candidate := range d.candidates {
+		if candidate.courierID == courierID && candidate.IsEligible() {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,137 @@
+package dispatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Create_Decision_With_Valid_Parameters(t *testing.T) {
+	// Arrange
+	orderID := uuid.New()
+	decidedAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	// Act
+	decision, err := NewDecision(orderID, "nearest", decidedAt)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, decision.ID())
+	assert.Equal(t, orderID, decision.OrderID())
+	assert.Equal(t, "nearest", decision.Strategy())
+	assert.Equal(t, decidedAt, decision.DecidedAt())
+	assert.Nil(t, decision.CourierID())
+	assert.Empty(t, decision.Candidates())
+}
+
+func Test_Cannot_Create_Decision_Without_Order(t *testing.T) {
+	// Act
+	_, err := NewDecision(uuid.Nil, "nearest", time.Now())
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_Cannot_Create_Decision_Without_Strategy(t *testing.T) {
+	// Act
+	_, err := NewDecision(uuid.New(), "", time.Now())
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_Select_Marks_Other_Eligible_Candidates_As_Not_Selected(t *testing.T) {
+	// Arrange
+	decision, _ := NewDecision(uuid.New(), "nearest", time.Now())
+	winnerID, loserID, busyID := uuid.New(), uuid.New(), uuid.New()
+	decision.AddCandidate(NewCandidate(busyID, 1, RejectionReasonNoStoragePlace))
+	decision.AddCandidate(NewCandidate(loserID, 3, RejectionReasonNone))
+	decision.AddCandidate(NewCandidate(winnerID, 2, RejectionReasonNone))
+
+	// Act
+	err := decision.Select(winnerID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, winnerID, *decision.CourierID())
+
+	candidates := decision.Candidates()
+	assert.Equal(t, RejectionReasonNoStoragePlace, candidates[0].RejectionReason())
+	assert.Equal(t, RejectionReasonNotSelected, candidates[1].RejectionReason())
+	assert.Equal(t, RejectionReasonNone, candidates[2].RejectionReason())
+}
+
+func Test_Cannot_Select_Rejected_Candidate(t *testing.T) {
+	// Arrange
+	decision, _ := NewDecision(uuid.New(), "nearest", time.Now())
+	busyID := uuid.New()
+	decision.AddCandidate(NewCandidate(busyID, 1, RejectionReasonNoStoragePlace))
+
+	// Act
+	err := decision.Select(busyID)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, decision.CourierID())
+}
+
+func Test_RejectEligible_Rejects_Only_Eligible_Candidates(t *testing.T) {
+	// Arrange
+	decision, _ := NewDecision(uuid.New(), "optimal", time.Now())
+	decision.AddCandidate(NewCandidate(uuid.New(), 1, RejectionReasonDeliveryPeriod))
+	decision.AddCandidate(NewCandidate(uuid.New(), 2, RejectionReasonNone))
+
+	// Act
+	decision.RejectEligible(RejectionReasonAssignedToAnotherOrder)
+
+	// Assert
+	candidates := decision.Candidates()
+	assert.Equal(t, RejectionReasonDeliveryPeriod, candidates[0].RejectionReason())
+	assert.Equal(t, RejectionReasonAssignedToAnotherOrder, candidates[1].RejectionReason())
+}
+
+func Test_Decision_Has_Same_Outcome_When_Only_Times_Differ(t *testing.T) {
+	// Arrange
+	orderID, firstCourierID, secondCourierID := uuid.New(), uuid.New(), uuid.New()
+
+	previous, _ := NewDecision(orderID, "nearest", time.Now())
+	previous.AddCandidate(NewCandidate(firstCourierID, 3, RejectionReasonNoStoragePlace))
+	previous.AddCandidate(NewCandidate(secondCourierID, 5, RejectionReasonDeliveryPeriod))
+
+	current, _ := NewDecision(orderID, "nearest", time.Now().Add(time.Second))
+	current.AddCandidate(NewCandidate(secondCourierID, 4, RejectionReasonDeliveryPeriod))
+	current.AddCandidate(NewCandidate(firstCourierID, 2, RejectionReasonNoStoragePlace))
+
+	// Act
+	sameOutcome := current.HasSameOutcomeAs(previous)
+
+	// Assert
+	assert.True(t, sameOutcome)
+}
+
+func Test_Decision_Has_Different_Outcome(t *testing.T) {
+	// Arrange
+	orderID, courierID, newCourierID := uuid.New(), uuid.New(), uuid.New()
+
+	previous, _ := NewDecision(orderID, "nearest", time.Now())
+	previous.AddCandidate(NewCandidate(courierID, 3, RejectionReasonNoStoragePlace))
+
+	reasonChanged, _ := NewDecision(orderID, "nearest", time.Now())
+	reasonChanged.AddCandidate(NewCandidate(courierID, 3, RejectionReasonDeliveryPeriod))
+
+	candidateAdded, _ := NewDecision(orderID, "nearest", time.Now())
+	candidateAdded.AddCandidate(NewCandidate(courierID, 3, RejectionReasonNoStoragePlace))
+	candidateAdded.AddCandidate(NewCandidate(newCourierID, 1, RejectionReasonNone))
+
+	assigned, _ := NewDecision(orderID, "nearest", time.Now())
+	assigned.AddCandidate(NewCandidate(newCourierID, 1, RejectionReasonNone))
+	_ = assigned.Select(newCourierID)
+
+	// Act & Assert
+	assert.False(t, reasonChanged.HasSameOutcomeAs(previous))
+	assert.False(t, candidateAdded.HasSameOutcomeAs(previous))
+	assert.False(t, assigned.HasSameOutcomeAs(previous))
+	assert.False(t, previous.HasSameOutcomeAs(nil))
+}
@@ -0,0 +1,66 @@
+package eta
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// Estimate - ожидаемое время доставки назначенного заказа, рассчитанное по текущему положению
+// и маршруту курьера. Пересчитывается после каждого перемещения курьеров.
+type Estimate struct {
+	orderID      uuid.UUID
+	courierID    uuid.UUID
+	moves        int64
+	calculatedAt time.Time
+}
+
+func NewEstimate(orderID uuid.UUID, courierID uuid.UUID, moves int64, calculatedAt time.Time) (*Estimate, error) {
+	if orderID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("orderID")
+	}
+
+	if courierID == uuid.Nil {
+		return nil, errs.NewValueIsRequiredError("courierID")
+	}
+
+	if moves < 0 {
+		return nil, errs.NewValueIsInvalidErrorWithCause("moves", errors.New("moves must not be negative"))
+	}
+
+	return &Estimate{
+		orderID:      orderID,
+		courierID:    courierID,
+		moves:        moves,
+		calculatedAt: calculatedAt.UTC(),
+	}, nil
+}
+
+func (e *Estimate) OrderID() uuid.UUID {
+	return e.orderID
+}
+
+func (e *Estimate) CourierID() uuid.UUID {
+	return e.courierID
+}
+
+// Moves - сколько тиков перемещения курьеров осталось до доставки
+func (e *Estimate) Moves() int64 {
+	return e.moves
+}
+
+func (e *Estimate) Duration() time.Duration {
+	return time.Duration(e.moves) * courier.MoveInterval
+}
+
+func (e *Estimate) CalculatedAt() time.Time {
+	return e.calculatedAt
+}
+
+func (e *Estimate) ArrivalAt() time.Time {
+	return e.calculatedAt.Add(e.Duration())
+}
@@ -0,0 +1,52 @@
+package eta
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Create_Estimate_With_Valid_Parameters(t *testing.T) {
+	// Arrange
+	orderID, courierID := uuid.New(), uuid.New()
+	calculatedAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	// Act
+	estimate, err := NewEstimate(orderID, courierID, 3, calculatedAt)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, orderID, estimate.OrderID())
+	assert.Equal(t, courierID, estimate.CourierID())
+	assert.Equal(t, int64(3), estimate.Moves())
+	assert.Equal(t, 3*courier.MoveInterval, estimate.Duration())
+	assert.Equal(t, calculatedAt.Add(3*courier.MoveInterval), estimate.ArrivalAt())
+}
+
+func Test_Cannot_Create_Estimate_Without_Order(t *testing.T) {
+	// Act
+	_, err := NewEstimate(uuid.Nil, uuid.New(), 3, time.Now())
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_Cannot_Create_Estimate_Without_Courier(t *testing.T) {
+	// Act
+	_, err := NewEstimate(uuid.New(), uuid.Nil, 3, time.Now())
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_Cannot_Create_Estimate_With_Negative_Moves(t *testing.T) {
+	// Act
+	_, err := NewEstimate(uuid.New(), uuid.New(), -1, time.Now())
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,204 @@
+package event
+
+import (
+	"time"
+
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventNameCourierCreated    EventName = "courier_created"
+	EventNameStoragePlaceAdded EventName = "storage_place_added"
+	EventNameOrderTaken        EventName = "order_taken"
+	EventNameOrderDelivered    EventName = "order_delivered"
+	EventNameCourierMoved      EventName = "courier_moved"
+)
+
+var _ ddd.DomainEvent = (*CourierCreated)(nil)
+var _ ddd.DomainEvent = (*StoragePlaceAdded)(nil)
+var _ ddd.DomainEvent = (*OrderTaken)(nil)
+var _ ddd.DomainEvent = (*OrderDelivered)(nil)
+var _ ddd.TransientDomainEvent = (*CourierMoved)(nil)
+
+// CourierCreated - в системе появился новый курьер
+type CourierCreated struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	CourierID     uuid.UUID `json:"courier_id"`
+	CourierName   string    `json:"courier_name"`
+	TransportType string    `json:"transport_type"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+func NewCourierCreated(courierID uuid.UUID, courierName string, transportType string) *CourierCreated {
+	return &CourierCreated{
+		ID:            uuid.New(),
+		Name:          EventNameCourierCreated,
+		CourierID:     courierID,
+		CourierName:   courierName,
+		TransportType: transportType,
+		OccurredAt:    time.Now().UTC(),
+	}
+}
+
+func (e *CourierCreated) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *CourierCreated) GetName() string {
+	return string(e.Name)
+}
+
+func (e *CourierCreated) GetCourierID() uuid.UUID {
+	return e.CourierID
+}
+
+// StoragePlaceAdded - у курьера появилось новое место хранения
+type StoragePlaceAdded struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	CourierID        uuid.UUID `json:"courier_id"`
+	StoragePlaceID   uuid.UUID `json:"storage_place_id"`
+	StoragePlaceName string    `json:"storage_place_name"`
+	TotalVolume      int64     `json:"total_volume"`
+	OccurredAt       time.Time `json:"occurred_at"`
+}
+
+func NewStoragePlaceAdded(courierID uuid.UUID, storagePlaceID uuid.UUID, storagePlaceName string, totalVolume int64) *StoragePlaceAdded {
+	return &StoragePlaceAdded{
+		ID:               uuid.New(),
+		Name:             EventNameStoragePlaceAdded,
+		CourierID:        courierID,
+		StoragePlaceID:   storagePlaceID,
+		StoragePlaceName: storagePlaceName,
+		TotalVolume:      totalVolume,
+		OccurredAt:       time.Now().UTC(),
+	}
+}
+
+func (e *StoragePlaceAdded) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *StoragePlaceAdded) GetName() string {
+	return string(e.Name)
+}
+
+func (e *StoragePlaceAdded) GetCourierID() uuid.UUID {
+	return e.CourierID
+}
+
+// OrderTaken - курьер положил заказ в место хранения
+type OrderTaken struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	CourierID      uuid.UUID `json:"courier_id"`
+	OrderID        uuid.UUID `json:"order_id"`
+	StoragePlaceID uuid.UUID `json:"storage_place_id"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+func NewOrderTaken(courierID uuid.UUID, orderID uuid.UUID, storagePlaceID uuid.UUID) *OrderTaken {
+	return &OrderTaken{
+		ID:             uuid.New(),
+		Name:           EventNameOrderTaken,
+		CourierID:      courierID,
+		OrderID:        orderID,
+		StoragePlaceID: storagePlaceID,
+		OccurredAt:     time.Now().UTC(),
+	}
+}
+
+func (e *OrderTaken) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderTaken) GetName() string {
+	return string(e.Name)
+}
+
+func (e *OrderTaken) GetCourierID() uuid.UUID {
+	return e.CourierID
+}
+
+func (e *OrderTaken) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+// OrderDelivered - курьер доставил заказ и освободил место хранения
+type OrderDelivered struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	CourierID  uuid.UUID `json:"courier_id"`
+	OrderID    uuid.UUID `json:"order_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewOrderDelivered(courierID uuid.UUID, orderID uuid.UUID) *OrderDelivered {
+	return &OrderDelivered{
+		ID:         uuid.New(),
+		Name:       EventNameOrderDelivered,
+		CourierID:  courierID,
+		OrderID:    orderID,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func (e *OrderDelivered) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderDelivered) GetName() string {
+	return string(e.Name)
+}
+
+func (e *OrderDelivered) GetCourierID() uuid.UUID {
+	return e.CourierID
+}
+
+func (e *OrderDelivered) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+// CourierMoved - курьер переместился в новую точку карты.
+// Курьер двигается каждую секунду, поэтому событие не пишется в outbox, а отправляется после фиксации транзакции.
+type CourierMoved struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	CourierID  uuid.UUID `json:"courier_id"`
+	X          int64     `json:"x"`
+	Y          int64     `json:"y"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewCourierMoved(courierID uuid.UUID, x, y int64, occurredAt time.Time) *CourierMoved {
+	return &CourierMoved{
+		ID:         uuid.New(),
+		Name:       EventNameCourierMoved,
+		CourierID:  courierID,
+		X:          x,
+		Y:          y,
+		OccurredAt: occurredAt,
+	}
+}
+
+func (e *CourierMoved) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *CourierMoved) GetName() string {
+	return string(e.Name)
+}
+
+func (e *CourierMoved) Transient() {}
+
+func (e *CourierMoved) GetCourierID() uuid.UUID {
+	return e.CourierID
+}
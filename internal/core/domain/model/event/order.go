@@ -1,6 +1,8 @@
 package event
 
 import (
+	"time"
+
 	"delivery/internal/pkg/ddd"
 
 	"github.com/google/uuid"
@@ -9,63 +11,208 @@ import (
 type EventName string
 
 const (
-	EventNameOrderCreated   EventName = "order_created"
-	EventNameOrderCompleted EventName = "order_completed"
+	EventNameOrderCreated        EventName = "order_created"
+	EventNameOrderAssigned       EventName = "order_assigned"
+	EventNameOrderCompleted      EventName = "order_completed"
+	EventNameOrderCancelled      EventName = "order_cancelled"
+	EventNameOrderDeliveryAtRisk EventName = "order_delivery_at_risk"
+	EventNameOrderStatusChanged  EventName = "order_status_changed"
 )
 
 var _ ddd.DomainEvent = (*OrderCreated)(nil)
+var _ ddd.DomainEvent = (*OrderAssigned)(nil)
 var _ ddd.DomainEvent = (*OrderCompleted)(nil)
+var _ ddd.DomainEvent = (*OrderCancelled)(nil)
+var _ ddd.DomainEvent = (*OrderDeliveryAtRisk)(nil)
+var _ ddd.DomainEvent = (*OrderStatusChanged)(nil)
 
+// Поля событий экспортируются, чтобы событие можно было сериализовать в outbox
 type OrderCreated struct {
-	id   uuid.UUID
-	name EventName
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
 
-	orderID uuid.UUID
+	OrderID    uuid.UUID `json:"order_id"`
+	OccurredAt time.Time `json:"occurred_at"`
 }
 
 func NewOrderCreated(orderID uuid.UUID) *OrderCreated {
 	return &OrderCreated{
-		id:      uuid.New(),
-		name:    EventNameOrderCreated,
-		orderID: orderID,
+		ID:         uuid.New(),
+		Name:       EventNameOrderCreated,
+		OrderID:    orderID,
+		OccurredAt: time.Now().UTC(),
 	}
 }
 
 func (e *OrderCreated) GetID() uuid.UUID {
-	return e.id
+	return e.ID
 }
 
 func (e *OrderCreated) GetName() string {
-	return string(e.name)
+	return string(e.Name)
 }
 
 func (e *OrderCreated) GetOrderID() uuid.UUID {
-	return e.orderID
+	return e.OrderID
+}
+
+type OrderAssigned struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	OrderID    uuid.UUID `json:"order_id"`
+	CourierID  uuid.UUID `json:"courier_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewOrderAssigned(orderID uuid.UUID, courierID uuid.UUID) *OrderAssigned {
+	return &OrderAssigned{
+		ID:         uuid.New(),
+		Name:       EventNameOrderAssigned,
+		OrderID:    orderID,
+		CourierID:  courierID,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func (e *OrderAssigned) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderAssigned) GetName() string {
+	return string(e.Name)
+}
+
+func (e *OrderAssigned) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e *OrderAssigned) GetCourierID() uuid.UUID {
+	return e.CourierID
 }
 
 type OrderCompleted struct {
-	id   uuid.UUID
-	name EventName
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
 
-	orderID uuid.UUID
+	OrderID    uuid.UUID `json:"order_id"`
+	CourierID  uuid.UUID `json:"courier_id"`
+	OccurredAt time.Time `json:"occurred_at"`
 }
 
-func NewOrderCompleted(orderID uuid.UUID) *OrderCompleted {
+func NewOrderCompleted(orderID uuid.UUID, courierID uuid.UUID) *OrderCompleted {
 	return &OrderCompleted{
-		id:      uuid.New(),
-		name:    EventNameOrderCompleted,
-		orderID: orderID,
+		ID:         uuid.New(),
+		Name:       EventNameOrderCompleted,
+		OrderID:    orderID,
+		CourierID:  courierID,
+		OccurredAt: time.Now().UTC(),
 	}
 }
 
 func (e *OrderCompleted) GetID() uuid.UUID {
-	return e.id
+	return e.ID
 }
 
 func (e *OrderCompleted) GetName() string {
-	return string(e.name)
+	return string(e.Name)
 }
 
 func (e *OrderCompleted) GetOrderID() uuid.UUID {
-	return e.orderID
+	return e.OrderID
+}
+
+func (e *OrderCompleted) GetCourierID() uuid.UUID {
+	return e.CourierID
+}
+
+type OrderCancelled struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	OrderID uuid.UUID `json:"order_id"`
+}
+
+func NewOrderCancelled(orderID uuid.UUID) *OrderCancelled {
+	return &OrderCancelled{
+		ID:      uuid.New(),
+		Name:    EventNameOrderCancelled,
+		OrderID: orderID,
+	}
+}
+
+func (e *OrderCancelled) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderCancelled) GetName() string {
+	return string(e.Name)
+}
+
+func (e *OrderCancelled) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+type OrderDeliveryAtRisk struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	OrderID uuid.UUID `json:"order_id"`
+}
+
+func NewOrderDeliveryAtRisk(orderID uuid.UUID) *OrderDeliveryAtRisk {
+	return &OrderDeliveryAtRisk{
+		ID:      uuid.New(),
+		Name:    EventNameOrderDeliveryAtRisk,
+		OrderID: orderID,
+	}
+}
+
+func (e *OrderDeliveryAtRisk) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderDeliveryAtRisk) GetName() string {
+	return string(e.Name)
+}
+
+func (e *OrderDeliveryAtRisk) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+// OrderStatusChanged - заказ перешел в другой статус. Статусы передаются строками,
+// потому что пакет событий не зависит от модели заказа.
+type OrderStatusChanged struct {
+	ID   uuid.UUID `json:"id"`
+	Name EventName `json:"name"`
+
+	OrderID    uuid.UUID  `json:"order_id"`
+	CourierID  *uuid.UUID `json:"courier_id,omitempty"`
+	FromStatus string     `json:"from_status"`
+	ToStatus   string     `json:"to_status"`
+	OccurredAt time.Time  `json:"occurred_at"`
+}
+
+func NewOrderStatusChanged(orderID uuid.UUID, courierID *uuid.UUID, fromStatus, toStatus string, occurredAt time.Time) *OrderStatusChanged {
+	return &OrderStatusChanged{
+		ID:         uuid.New(),
+		Name:       EventNameOrderStatusChanged,
+		OrderID:    orderID,
+		CourierID:  courierID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		OccurredAt: occurredAt,
+	}
+}
+
+func (e *OrderStatusChanged) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderStatusChanged) GetName() string {
+	return string(e.Name)
+}
+
+func (e *OrderStatusChanged) GetOrderID() uuid.UUID {
+	return e.OrderID
 }
@@ -0,0 +1,68 @@
+package order
+
+import (
+	"strings"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Address - адрес доставки, как в Address корзины. Квартира необязательна.
+type Address struct {
+	country   string
+	city      string
+	street    string
+	house     string
+	apartment string
+	isSet     bool
+}
+
+func NewAddress(country string, city string, street string, house string, apartment string) (Address, error) {
+	requiredFields := []struct {
+		name  string
+		value string
+	}{
+		{name: "country", value: country},
+		{name: "city", value: city},
+		{name: "street", value: street},
+		{name: "house", value: house},
+	}
+
+	for _, field := range requiredFields {
+		if strings.TrimSpace(field.value) == "" {
+			return Address{}, errs.NewValueIsRequiredError(field.name)
+		}
+	}
+
+	return Address{
+		country:   strings.TrimSpace(country),
+		city:      strings.TrimSpace(city),
+		street:    strings.TrimSpace(street),
+		house:     strings.TrimSpace(house),
+		apartment: strings.TrimSpace(apartment),
+		isSet:     true,
+	}, nil
+}
+
+func (a Address) Country() string {
+	return a.country
+}
+
+func (a Address) City() string {
+	return a.city
+}
+
+func (a Address) Street() string {
+	return a.street
+}
+
+func (a Address) House() string {
+	return a.house
+}
+
+func (a Address) Apartment() string {
+	return a.apartment
+}
+
+func (a Address) IsSet() bool {
+	return a.isSet
+}
@@ -0,0 +1,48 @@
+package order
+
+import (
+	"testing"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Address_Can_Be_Created_Without_Apartment(t *testing.T) {
+	// Act
+	address, err := NewAddress("Россия", "Москва", " Тверская ", "1", "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, address.IsSet())
+	assert.Equal(t, "Тверская", address.Street())
+	assert.Empty(t, address.Apartment())
+}
+
+func Test_Address_Requires_Every_Part_Except_Apartment(t *testing.T) {
+	// Arrange
+	tests := []struct {
+		name    string
+		country string
+		city    string
+		street  string
+		house   string
+	}{
+		{name: "country", country: "", city: "Москва", street: "Тверская", house: "1"},
+		{name: "city", country: "Россия", city: " ", street: "Тверская", house: "1"},
+		{name: "street", country: "Россия", city: "Москва", street: "", house: "1"},
+		{name: "house", country: "Россия", city: "Москва", street: "Тверская", house: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			address, err := NewAddress(tt.country, tt.city, tt.street, tt.house, "12")
+
+			// Assert
+			assert.ErrorIs(t, err, errs.ErrValueIsRequired)
+			assert.Contains(t, err.Error(), tt.name)
+			assert.False(t, address.IsSet())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/errs"
+)
+
+const hoursInDay = 24
+
+// DeliveryPeriod - окно, в которое заказ должен быть доставлен
+type DeliveryPeriod struct {
+	from  time.Time
+	to    time.Time
+	isSet bool
+}
+
+func NewDeliveryPeriod(from time.Time, to time.Time) (DeliveryPeriod, error) {
+	if from.IsZero() {
+		return DeliveryPeriod{}, errs.NewValueIsRequiredError("from")
+	}
+
+	if to.IsZero() {
+		return DeliveryPeriod{}, errs.NewValueIsRequiredError("to")
+	}
+
+	if !from.Before(to) {
+		return DeliveryPeriod{}, errs.NewValueIsInvalidErrorWithCause("to", errors.New("to must be after from"))
+	}
+
+	return DeliveryPeriod{from: from.UTC(), to: to.UTC(), isSet: true}, nil
+}
+
+// NewDeliveryPeriodForHours - создает окно доставки по часам суток (например, с 9 до 12).
+// Окно строится на сутки момента now, а если оно к этому моменту уже закончилось - на следующие.
+// Окно, у которого toHour меньше fromHour, переходит через полночь (например, с 22 до 2).
+func NewDeliveryPeriodForHours(now time.Time, fromHour int64, toHour int64) (DeliveryPeriod, error) {
+	if fromHour < 0 || fromHour >= hoursInDay {
+		return DeliveryPeriod{}, errs.NewValueIsOutOfRangeError("fromHour", fromHour, 0, hoursInDay-1)
+	}
+
+	if toHour <= 0 || toHour > hoursInDay {
+		return DeliveryPeriod{}, errs.NewValueIsOutOfRangeError("toHour", toHour, 1, hoursInDay)
+	}
+
+	if fromHour == toHour {
+		return DeliveryPeriod{}, errs.NewValueIsInvalidErrorWithCause("toHour", errors.New("toHour must differ from fromHour"))
+	}
+
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	from := day.Add(time.Duration(fromHour) * time.Hour)
+	to := day.Add(time.Duration(toHour) * time.Hour)
+	if toHour < fromHour {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	switch {
+	case to.AddDate(0, 0, -1).After(now):
+		// Ночное окно, начавшееся накануне, еще не закончилось
+		from = from.AddDate(0, 0, -1)
+		to = to.AddDate(0, 0, -1)
+	case !to.After(now):
+		from = from.AddDate(0, 0, 1)
+		to = to.AddDate(0, 0, 1)
+	}
+
+	return NewDeliveryPeriod(from, to)
+}
+
+func (p DeliveryPeriod) From() time.Time {
+	return p.from
+}
+
+func (p DeliveryPeriod) To() time.Time {
+	return p.to
+}
+
+func (p DeliveryPeriod) IsSet() bool {
+	return p.isSet
+}
+
+func (p DeliveryPeriod) Equals(other DeliveryPeriod) bool {
+	return p.from.Equal(other.from) && p.to.Equal(other.to) && p.isSet == other.isSet
+}
+
+// CanBeMetAt - можно ли уложиться в окно, если доставка произойдет в deliveryTime.
+// Приехать раньше начала окна можно, опоздать - нельзя.
+func (p DeliveryPeriod) CanBeMetAt(deliveryTime time.Time) bool {
+	if !p.isSet {
+		return true
+	}
+
+	return !deliveryTime.After(p.to)
+}
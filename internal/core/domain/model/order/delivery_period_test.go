@@ -0,0 +1,122 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Create_DeliveryPeriod_With_Valid_Parameters(t *testing.T) {
+	// Arrange
+	from := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	deliveryPeriod, err := NewDeliveryPeriod(from, to)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, deliveryPeriod.IsSet())
+	assert.Equal(t, from, deliveryPeriod.From())
+	assert.Equal(t, to, deliveryPeriod.To())
+}
+
+func Test_Cannot_Create_DeliveryPeriod_When_To_Is_Not_After_From(t *testing.T) {
+	// Arrange
+	from := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	_, err := NewDeliveryPeriod(from, from)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_Create_DeliveryPeriod_For_Hours_Of_Current_Day(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 8, 30, 0, 0, time.UTC)
+
+	// Act
+	deliveryPeriod, err := NewDeliveryPeriodForHours(now, 9, 12)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC), deliveryPeriod.From())
+	assert.Equal(t, time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC), deliveryPeriod.To())
+}
+
+func Test_Create_DeliveryPeriod_For_Hours_Moves_To_Next_Day_When_Window_Is_Over(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 13, 0, 0, 0, time.UTC)
+
+	// Act
+	deliveryPeriod, err := NewDeliveryPeriodForHours(now, 9, 12)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), deliveryPeriod.From())
+	assert.Equal(t, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), deliveryPeriod.To())
+}
+
+func Test_Cannot_Create_DeliveryPeriod_For_Hours_Out_Of_Day(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+
+	// Act
+	_, errFrom := NewDeliveryPeriodForHours(now, -1, 12)
+	_, errTo := NewDeliveryPeriodForHours(now, 9, 25)
+	_, errEmpty := NewDeliveryPeriodForHours(now, 9, 9)
+
+	// Assert
+	assert.Error(t, errFrom)
+	assert.Error(t, errTo)
+	assert.Error(t, errEmpty)
+}
+
+func Test_Create_DeliveryPeriod_For_Hours_Crossing_Midnight(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC)
+
+	// Act
+	deliveryPeriod, err := NewDeliveryPeriodForHours(now, 22, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC), deliveryPeriod.From())
+	assert.Equal(t, time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC), deliveryPeriod.To())
+}
+
+func Test_Create_DeliveryPeriod_For_Hours_Keeps_Night_Window_Started_Yesterday(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 18, 1, 0, 0, 0, time.UTC)
+
+	// Act
+	deliveryPeriod, err := NewDeliveryPeriodForHours(now, 22, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC), deliveryPeriod.From())
+	assert.Equal(t, time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC), deliveryPeriod.To())
+}
+
+func Test_DeliveryPeriod_Can_Be_Met_Before_Its_End(t *testing.T) {
+	// Arrange
+	from := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	deliveryPeriod, _ := NewDeliveryPeriod(from, to)
+
+	// Act & Assert
+	assert.True(t, deliveryPeriod.CanBeMetAt(from.Add(-time.Hour)))
+	assert.True(t, deliveryPeriod.CanBeMetAt(to))
+	assert.False(t, deliveryPeriod.CanBeMetAt(to.Add(time.Second)))
+}
+
+func Test_Empty_DeliveryPeriod_Can_Always_Be_Met(t *testing.T) {
+	// Arrange
+	deliveryPeriod := DeliveryPeriod{}
+
+	// Act & Assert
+	assert.False(t, deliveryPeriod.IsSet())
+	assert.True(t, deliveryPeriod.CanBeMetAt(time.Now().Add(24*time.Hour)))
+}
@@ -2,6 +2,8 @@ package order
 
 import (
 	"errors"
+	"slices"
+	"time"
 
 	"delivery/internal/core/domain/model/event"
 	"delivery/internal/core/domain/model/shared_kernel"
@@ -12,14 +14,20 @@ import (
 )
 
 type Order struct {
-	id        uuid.UUID
+	*ddd.BaseAggregate[uuid.UUID]
+
 	courierID *uuid.UUID
 	location  shared_kernel.Location
-	volume    int64
-	status    Status
-	version   int64
+	// pickupLocation - откуда курьер забирает заказ. У заказов без точки забора не задан.
+	pickupLocation shared_kernel.Location
+	volume         int64
+	status         Status
+	version        int64
+
+	deliveryPeriod DeliveryPeriod
+	deliveryAtRisk bool
 
-	domainEvents []ddd.DomainEvent
+	statusChanges []StatusChange
 }
 
 func NewOrder(orderID uuid.UUID, location shared_kernel.Location, volume int64) (*Order, error) {
@@ -34,34 +42,68 @@ func NewOrder(orderID uuid.UUID, location shared_kernel.Location, volume int64)
 	}
 
 	order := &Order{
-		id:       orderID,
-		location: location,
-		volume:   volume,
-		status:   StatusCreated,
+		BaseAggregate: ddd.NewBaseAggregate(orderID),
+		location:      location,
+		volume:        volume,
+		status:        StatusCreated,
 	}
 
-	order.raiseDomainEvent(event.NewOrderCreated(orderID))
+	// История заказа начинается с его создания
+	order.statusChanges = append(order.statusChanges, StatusChange{
+		from:      StatusEmpty,
+		to:        StatusCreated,
+		changedAt: time.Now().UTC(),
+	})
+
+	order.RaiseDomainEvent(event.NewOrderCreated(orderID))
 
 	return order, nil
 }
 
 // LoadOrderFromRepo - загружает заказ из репозитория. Можно использовать ТОЛЬКО для загрузки из репозитория.
-func LoadOrderFromRepo(orderID uuid.UUID, courierID *uuid.UUID, location shared_kernel.Location, volume int64, status Status, version int64) (*Order, error) {
+func LoadOrderFromRepo(
+	orderID uuid.UUID,
+	courierID *uuid.UUID,
+	location shared_kernel.Location,
+	pickupLocation shared_kernel.Location,
+	volume int64,
+	status Status,
+	version int64,
+	deliveryPeriod DeliveryPeriod,
+	deliveryAtRisk bool,
+) (*Order, error) {
 	return &Order{
-		id:        orderID,
-		courierID: courierID,
-		location:  location,
-		volume:    volume,
-		status:    status,
-		version:   version,
+		BaseAggregate:  ddd.NewBaseAggregate(orderID),
+		courierID:      courierID,
+		location:       location,
+		pickupLocation: pickupLocation,
+		volume:         volume,
+		status:         status,
+		version:        version,
+		deliveryPeriod: deliveryPeriod,
+		deliveryAtRisk: deliveryAtRisk,
 	}, nil
 }
 
-func (o *Order) ID() uuid.UUID {
-	return o.id
+func (o *Order) Location() shared_kernel.Location {
+	return o.location
 }
 
-func (o *Order) Location() shared_kernel.Location {
+func (o *Order) PickupLocation() shared_kernel.Location {
+	return o.pickupLocation
+}
+
+// AwaitingPickup - курьер еще должен забрать заказ из точки забора
+func (o *Order) AwaitingPickup() bool {
+	return o.pickupLocation.IsSet() && (o.status.Equals(StatusCreated) || o.status.Equals(StatusAssigned))
+}
+
+// CourierTarget - куда курьеру нужно двигаться сейчас: сначала в точку забора, потом к клиенту
+func (o *Order) CourierTarget() shared_kernel.Location {
+	if o.AwaitingPickup() {
+		return o.pickupLocation
+	}
+
 	return o.location
 }
 
@@ -81,52 +123,159 @@ func (o *Order) Version() int64 {
 	return o.version
 }
 
-func (o *Order) DomainEvents() []ddd.DomainEvent {
-	events := make([]ddd.DomainEvent, len(o.domainEvents))
-	copy(events, o.domainEvents)
-	return events
+func (o *Order) DeliveryPeriod() DeliveryPeriod {
+	return o.deliveryPeriod
+}
+
+func (o *Order) DeliveryAtRisk() bool {
+	return o.deliveryAtRisk
+}
+
+// StatusChanges - переходы статуса, еще не сохраненные в историю
+func (o *Order) StatusChanges() []StatusChange {
+	changes := make([]StatusChange, len(o.statusChanges))
+	copy(changes, o.statusChanges)
+	return changes
+}
+
+func (o *Order) ClearStatusChanges() {
+	o.statusChanges = nil
+}
+
+// SetDeliveryPeriod - задает окно доставки. Менять окно можно только пока заказ не назначен курьеру.
+func (o *Order) SetDeliveryPeriod(deliveryPeriod DeliveryPeriod) error {
+	if !deliveryPeriod.IsSet() {
+		return errs.NewValueIsRequiredError("deliveryPeriod")
+	}
+
+	if !o.status.Equals(StatusCreated) {
+		return errs.NewValueIsInvalidErrorWithCause("status", errors.New("окно доставки можно задать только для заказа в статусе "+StatusCreated.String()))
+	}
+
+	o.deliveryPeriod = deliveryPeriod
+
+	return nil
+}
+
+// SetPickupLocation - задает точку забора. Менять ее можно только пока заказ не назначен курьеру.
+func (o *Order) SetPickupLocation(pickupLocation shared_kernel.Location) error {
+	if !pickupLocation.IsSet() {
+		return errs.NewValueIsRequiredError("pickupLocation")
+	}
+
+	if !o.status.Equals(StatusCreated) {
+		return errs.NewValueIsInvalidErrorWithCause("status", errors.New("точку забора можно задать только для заказа в статусе "+StatusCreated.String()))
+	}
+
+	o.pickupLocation = pickupLocation
+
+	return nil
+}
+
+// FlagDeliveryAtRiskIfLate - помечает заказ, если при ожидаемом времени доставки он не успеет в окно доставки.
+// Возвращает true, если заказ был помечен этим вызовом.
+func (o *Order) FlagDeliveryAtRiskIfLate(expectedDeliveryTime time.Time) bool {
+	if o.deliveryAtRisk || !o.deliveryPeriod.IsSet() {
+		return false
+	}
+
+	if !o.status.Equals(StatusCreated) && !o.status.Equals(StatusAssigned) && !o.status.Equals(StatusPickedUp) {
+		return false
+	}
+
+	if o.deliveryPeriod.CanBeMetAt(expectedDeliveryTime) {
+		return false
+	}
+
+	o.deliveryAtRisk = true
+	o.RaiseDomainEvent(event.NewOrderDeliveryAtRisk(o.ID()))
+
+	return true
 }
 
 func (o *Order) Assign(courierID uuid.UUID) error {
-	if err := o.switchToStatus(StatusAssigned); err != nil {
+	if err := o.switchToStatus(StatusAssigned, &courierID); err != nil {
 		return err
 	}
 
 	o.courierID = &courierID
+	o.RaiseDomainEvent(event.NewOrderAssigned(o.ID(), courierID))
 
 	return nil
 }
 
+// Unassign - возвращает заказ в распределение, например когда курьер ушел со смены
+func (o *Order) Unassign() error {
+	if err := o.switchToStatus(StatusCreated, o.courierID); err != nil {
+		return err
+	}
+
+	o.courierID = nil
+
+	return nil
+}
+
+// PickUp - курьер забрал заказ из точки забора
+func (o *Order) PickUp() error {
+	if !o.pickupLocation.IsSet() {
+		return errs.NewValueIsInvalidErrorWithCause("pickupLocation", errors.New("у заказа нет точки забора"))
+	}
+
+	return o.switchToStatus(StatusPickedUp, o.courierID)
+}
+
 func (o *Order) Complete() error {
-	if err := o.switchToStatus(StatusCompleted); err != nil {
+	if o.AwaitingPickup() {
+		return errs.NewValueIsInvalidErrorWithCause("status", errors.New("заказ нужно сначала забрать из точки забора"))
+	}
+
+	if err := o.switchToStatus(StatusCompleted, o.courierID); err != nil {
 		return err
 	}
 
-	o.raiseDomainEvent(event.NewOrderCompleted(o.id))
+	// Завершить можно только назначенный заказ, поэтому курьер всегда есть
+	o.RaiseDomainEvent(event.NewOrderCompleted(o.ID(), *o.courierID))
 
 	return nil
 }
 
-func (o *Order) switchToStatus(status Status) error {
-	statusTransition := map[Status]Status{
-		StatusCreated:  StatusAssigned,
-		StatusAssigned: StatusCompleted,
+func (o *Order) Cancel() error {
+	if err := o.switchToStatus(StatusCancelled, o.courierID); err != nil {
+		return err
 	}
 
-	allowedNextStatus, ok := statusTransition[o.status]
+	o.RaiseDomainEvent(event.NewOrderCancelled(o.ID()))
+
+	return nil
+}
+
+func (o *Order) switchToStatus(status Status, courierID *uuid.UUID) error {
+	statusTransition := map[Status][]Status{
+		StatusCreated:  {StatusAssigned, StatusCancelled},
+		StatusAssigned: {StatusPickedUp, StatusCompleted, StatusCancelled, StatusCreated},
+		// Забранный заказ уже у курьера, отменить его можно только до забора
+		StatusPickedUp: {StatusCompleted},
+	}
+
+	allowedNextStatuses, ok := statusTransition[o.status]
 	if !ok {
 		return errs.NewValueIsInvalidErrorWithCause("status", errors.New("из текущего статуса заказа нельзя перейти в статус "+status.String()))
 	}
 
-	if allowedNextStatus != status {
+	if !slices.Contains(allowedNextStatuses, status) {
 		return errs.NewValueIsInvalidErrorWithCause("status", errors.New("из текущего статуса заказа нельзя перейти в статус "+status.String()))
 	}
 
+	change := StatusChange{
+		from:      o.status,
+		to:        status,
+		courierID: courierID,
+		changedAt: time.Now().UTC(),
+	}
+	o.statusChanges = append(o.statusChanges, change)
 	o.status = status
 
-	return nil
-}
+	o.RaiseDomainEvent(event.NewOrderStatusChanged(o.ID(), courierID, change.from.String(), change.to.String(), change.changedAt))
 
-func (o *Order) raiseDomainEvent(event ddd.DomainEvent) {
-	o.domainEvents = append(o.domainEvents, event)
+	return nil
 }
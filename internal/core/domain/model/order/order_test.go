@@ -2,9 +2,11 @@ package order
 
 import (
 	"testing"
+	"time"
 
 	"delivery/internal/core/domain/model/event"
 	"delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -39,7 +41,7 @@ func Test_NewOrder_Raises_OrderCreated_Event(t *testing.T) {
 
 	// Assert
 	assert.NoError(t, err)
-	events := order.DomainEvents()
+	events := order.GetDomainEvents()
 	assert.Len(t, events, 1)
 
 	orderCreatedEvent, ok := events[0].(*event.OrderCreated)
@@ -116,6 +118,27 @@ func Test_Assign_Courier_To_Created_Order(t *testing.T) {
 	assert.Equal(t, courierID, *order.CourierID())
 }
 
+func Test_Assign_Raises_OrderAssigned_Event(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	courierID := uuid.New()
+	orderID := order.ID()
+
+	// Act
+	err := order.Assign(courierID)
+
+	// Assert
+	assert.NoError(t, err)
+	events := order.GetDomainEvents()
+	assert.Len(t, events, 3)
+
+	orderAssignedEvent, ok := events[2].(*event.OrderAssigned)
+	assert.True(t, ok, "Expected last event to be *event.OrderAssigned")
+	assert.Equal(t, orderID, orderAssignedEvent.GetOrderID())
+	assert.Equal(t, courierID, orderAssignedEvent.GetCourierID())
+	assert.False(t, orderAssignedEvent.OccurredAt.IsZero())
+}
+
 func Test_Cannot_Assign_Courier_To_Already_Assigned_Order(t *testing.T) {
 	// Arrange
 	order := newValidOrder(t)
@@ -176,15 +199,15 @@ func Test_Complete_Raises_OrderCompleted_Event(t *testing.T) {
 
 	// Assert
 	assert.NoError(t, err)
-	events := order.DomainEvents()
-	assert.Len(t, events, 2)
+	events := order.GetDomainEvents()
+	assert.Len(t, events, 5)
 
 	orderCreatedEvent, ok := events[0].(*event.OrderCreated)
 	assert.True(t, ok, "Expected first event to be *event.OrderCreated")
 	assert.Equal(t, orderID, orderCreatedEvent.GetOrderID())
 
-	orderCompletedEvent, ok := events[1].(*event.OrderCompleted)
-	assert.True(t, ok, "Expected second event to be *event.OrderCompleted")
+	orderCompletedEvent, ok := events[4].(*event.OrderCompleted)
+	assert.True(t, ok, "Expected last event to be *event.OrderCompleted")
 	assert.Equal(t, orderID, orderCompletedEvent.GetOrderID())
 }
 
@@ -210,7 +233,7 @@ func Test_Failed_Complete_Does_Not_Raise_OrderCompleted_Event(t *testing.T) {
 
 	// Assert
 	assert.Error(t, err)
-	events := order.DomainEvents()
+	events := order.GetDomainEvents()
 	assert.Len(t, events, 1)
 
 	_, ok := events[0].(*event.OrderCreated)
@@ -232,6 +255,226 @@ func Test_Cannot_Complete_Already_Completed_Order(t *testing.T) {
 	assert.Equal(t, StatusCompleted, order.Status())
 }
 
+func Test_Cancel_Created_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+
+	// Act
+	err := order.Cancel()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, StatusCancelled, order.Status())
+	assert.Nil(t, order.CourierID())
+}
+
+func Test_Cancel_Assigned_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	courierID := uuid.New()
+
+	// Act
+	_ = order.Assign(courierID)
+	err := order.Cancel()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, StatusCancelled, order.Status())
+	assert.Equal(t, courierID, *order.CourierID())
+}
+
+func Test_Unassign_Assigned_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	_ = order.Assign(uuid.New())
+
+	// Act
+	err := order.Unassign()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, StatusCreated, order.Status())
+	assert.Nil(t, order.CourierID())
+}
+
+func Test_Cannot_Unassign_Created_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+
+	// Act
+	err := order.Unassign()
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func Test_Cancel_Raises_OrderCancelled_Event(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	orderID := order.ID()
+
+	// Act
+	err := order.Cancel()
+
+	// Assert
+	assert.NoError(t, err)
+	events := order.GetDomainEvents()
+	assert.Len(t, events, 3)
+
+	orderCancelledEvent, ok := events[2].(*event.OrderCancelled)
+	assert.True(t, ok, "Expected last event to be *event.OrderCancelled")
+	assert.Equal(t, orderID, orderCancelledEvent.GetOrderID())
+}
+
+func Test_Cannot_Cancel_Completed_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	courierID := uuid.New()
+
+	// Act
+	_ = order.Assign(courierID)
+	_ = order.Complete()
+	err := order.Cancel()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, StatusCompleted, order.Status())
+}
+
+func Test_Cannot_Cancel_Picked_Up_Order(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	pickupLocation, _ := shared_kernel.NewLocation(1, 1)
+	order, _ := NewOrder(uuid.New(), location, 5)
+	_ = order.SetPickupLocation(pickupLocation)
+	_ = order.Assign(uuid.New())
+	_ = order.PickUp()
+
+	// Act
+	err := order.Cancel()
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Equal(t, StatusPickedUp, order.Status())
+}
+
+func Test_Cannot_Cancel_Already_Cancelled_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+
+	// Act
+	_ = order.Cancel()
+	err := order.Cancel()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, StatusCancelled, order.Status())
+	assert.Len(t, order.GetDomainEvents(), 3)
+}
+
+func Test_Cannot_Assign_Courier_To_Cancelled_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+
+	// Act
+	_ = order.Cancel()
+	err := order.Assign(uuid.New())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, StatusCancelled, order.Status())
+	assert.Nil(t, order.CourierID())
+}
+
+func Test_Set_DeliveryPeriod_For_Created_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	deliveryPeriod := newDeliveryPeriod(t, time.Now(), time.Now().Add(time.Hour))
+
+	// Act
+	err := order.SetDeliveryPeriod(deliveryPeriod)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, deliveryPeriod.Equals(order.DeliveryPeriod()))
+}
+
+func Test_Cannot_Set_DeliveryPeriod_For_Assigned_Order(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	_ = order.Assign(uuid.New())
+	deliveryPeriod := newDeliveryPeriod(t, time.Now(), time.Now().Add(time.Hour))
+
+	// Act
+	err := order.SetDeliveryPeriod(deliveryPeriod)
+
+	// Assert
+	assert.Error(t, err)
+	assert.False(t, order.DeliveryPeriod().IsSet())
+}
+
+func Test_Flag_Order_When_Delivery_Is_Late(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	order := newValidOrder(t)
+	_ = order.SetDeliveryPeriod(newDeliveryPeriod(t, now, now.Add(time.Minute)))
+
+	// Act
+	flagged := order.FlagDeliveryAtRiskIfLate(now.Add(time.Hour))
+
+	// Assert
+	assert.True(t, flagged)
+	assert.True(t, order.DeliveryAtRisk())
+
+	events := order.GetDomainEvents()
+	assert.Len(t, events, 2)
+	orderDeliveryAtRiskEvent, ok := events[1].(*event.OrderDeliveryAtRisk)
+	assert.True(t, ok, "Expected second event to be *event.OrderDeliveryAtRisk")
+	assert.Equal(t, order.ID(), orderDeliveryAtRiskEvent.GetOrderID())
+}
+
+func Test_Do_Not_Flag_Order_When_Delivery_Is_In_Time(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	order := newValidOrder(t)
+	_ = order.SetDeliveryPeriod(newDeliveryPeriod(t, now, now.Add(time.Hour)))
+
+	// Act
+	flagged := order.FlagDeliveryAtRiskIfLate(now.Add(time.Minute))
+
+	// Assert
+	assert.False(t, flagged)
+	assert.False(t, order.DeliveryAtRisk())
+	assert.Len(t, order.GetDomainEvents(), 1)
+}
+
+func Test_Do_Not_Flag_Order_Twice(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	order := newValidOrder(t)
+	_ = order.SetDeliveryPeriod(newDeliveryPeriod(t, now, now.Add(time.Minute)))
+
+	// Act
+	_ = order.FlagDeliveryAtRiskIfLate(now.Add(time.Hour))
+	flagged := order.FlagDeliveryAtRiskIfLate(now.Add(time.Hour))
+
+	// Assert
+	assert.False(t, flagged)
+	assert.Len(t, order.GetDomainEvents(), 2)
+}
+
+func Test_Do_Not_Flag_Order_Without_DeliveryPeriod(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+
+	// Act
+	flagged := order.FlagDeliveryAtRiskIfLate(time.Now().Add(24 * time.Hour))
+
+	// Assert
+	assert.False(t, flagged)
+	assert.False(t, order.DeliveryAtRisk())
+}
+
 func newValidOrder(t *testing.T) *Order {
 	t.Helper()
 
@@ -246,3 +489,160 @@ func newValidOrder(t *testing.T) *Order {
 
 	return order
 }
+
+func newDeliveryPeriod(t *testing.T, from time.Time, to time.Time) DeliveryPeriod {
+	t.Helper()
+
+	deliveryPeriod, err := NewDeliveryPeriod(from, to)
+	if err != nil {
+		t.Fatalf("failed to create delivery period: %v", err)
+	}
+
+	return deliveryPeriod
+}
+
+func Test_Order_With_Pickup_Targets_Pickup_Until_Picked_Up(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	pickupLocation, _ := shared_kernel.NewLocation(1, 1)
+	order, _ := NewOrder(uuid.New(), location, 5)
+	_ = order.SetPickupLocation(pickupLocation)
+	_ = order.Assign(uuid.New())
+
+	// Act
+	targetBeforePickup := order.CourierTarget()
+	err := order.PickUp()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, pickupLocation, targetBeforePickup)
+	assert.Equal(t, location, order.CourierTarget())
+	assert.Equal(t, StatusPickedUp, order.Status())
+}
+
+func Test_Cannot_Complete_Order_Before_Pickup(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	pickupLocation, _ := shared_kernel.NewLocation(1, 1)
+	order, _ := NewOrder(uuid.New(), location, 5)
+	_ = order.SetPickupLocation(pickupLocation)
+	_ = order.Assign(uuid.New())
+
+	// Act
+	err := order.Complete()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, StatusAssigned, order.Status())
+}
+
+func Test_Complete_Picked_Up_Order(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	pickupLocation, _ := shared_kernel.NewLocation(1, 1)
+	order, _ := NewOrder(uuid.New(), location, 5)
+	_ = order.SetPickupLocation(pickupLocation)
+	_ = order.Assign(uuid.New())
+	_ = order.PickUp()
+
+	// Act
+	err := order.Complete()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, StatusCompleted, order.Status())
+}
+
+func Test_Cannot_Pick_Up_Order_Without_Pickup_Location(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	order, _ := NewOrder(uuid.New(), location, 5)
+	_ = order.Assign(uuid.New())
+
+	// Act
+	err := order.PickUp()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, StatusAssigned, order.Status())
+}
+
+func Test_Order_Records_Status_Changes(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	order, _ := NewOrder(uuid.New(), location, 5)
+	order.ClearStatusChanges()
+	courierID := uuid.New()
+
+	// Act
+	_ = order.Assign(courierID)
+	_ = order.Unassign()
+
+	// Assert
+	changes := order.StatusChanges()
+	assert.Len(t, changes, 2)
+
+	assert.Equal(t, StatusCreated, changes[0].From())
+	assert.Equal(t, StatusAssigned, changes[0].To())
+	assert.Equal(t, courierID, *changes[0].CourierID())
+	assert.False(t, changes[0].ChangedAt().IsZero())
+
+	assert.Equal(t, StatusAssigned, changes[1].From())
+	assert.Equal(t, StatusCreated, changes[1].To())
+	assert.Equal(t, courierID, *changes[1].CourierID())
+
+	order.ClearStatusChanges()
+	assert.Empty(t, order.StatusChanges())
+}
+
+func Test_Order_Does_Not_Record_Rejected_Status_Change(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+	order, _ := NewOrder(uuid.New(), location, 5)
+	order.ClearStatusChanges()
+
+	// Act
+	err := order.Complete()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, order.StatusChanges())
+}
+
+func Test_New_Order_Records_Creation(t *testing.T) {
+	// Arrange
+	location, _ := shared_kernel.NewLocation(10, 10)
+
+	// Act
+	order, err := NewOrder(uuid.New(), location, 5)
+
+	// Assert
+	assert.NoError(t, err)
+	changes := order.StatusChanges()
+	assert.Len(t, changes, 1)
+	assert.Equal(t, StatusEmpty, changes[0].From())
+	assert.Equal(t, StatusCreated, changes[0].To())
+	assert.Nil(t, changes[0].CourierID())
+	assert.False(t, changes[0].ChangedAt().IsZero())
+}
+
+func Test_Status_Change_Raises_OrderStatusChanged_Event(t *testing.T) {
+	// Arrange
+	order := newValidOrder(t)
+	courierID := uuid.New()
+
+	// Act
+	err := order.Assign(courierID)
+
+	// Assert
+	assert.NoError(t, err)
+	events := order.GetDomainEvents()
+	assert.Len(t, events, 3)
+
+	statusChangedEvent, ok := events[1].(*event.OrderStatusChanged)
+	assert.True(t, ok, "Expected second event to be *event.OrderStatusChanged")
+	assert.Equal(t, order.ID(), statusChangedEvent.GetOrderID())
+	assert.Equal(t, courierID, *statusChangedEvent.CourierID)
+	assert.Equal(t, StatusCreated.String(), statusChangedEvent.FromStatus)
+	assert.Equal(t, StatusAssigned.String(), statusChangedEvent.ToStatus)
+}
@@ -4,7 +4,9 @@ const (
 	StatusEmpty     Status = ""
 	StatusCreated   Status = "Created"
 	StatusAssigned  Status = "Assigned"
+	StatusPickedUp  Status = "PickedUp"
 	StatusCompleted Status = "Completed"
+	StatusCancelled Status = "Cancelled"
 )
 
 type Status string
@@ -0,0 +1,33 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusChange - переход заказа из одного статуса в другой. Репозиторий сохраняет переходы в историю статусов.
+// У создания заказа статуса до перехода нет.
+type StatusChange struct {
+	from      Status
+	to        Status
+	courierID *uuid.UUID
+	changedAt time.Time
+}
+
+func (c StatusChange) From() Status {
+	return c.from
+}
+
+func (c StatusChange) To() Status {
+	return c.to
+}
+
+// CourierID - курьер, к которому относится переход: получивший заказ при назначении, потерявший при снятии
+func (c StatusChange) CourierID() *uuid.UUID {
+	return c.courierID
+}
+
+func (c StatusChange) ChangedAt() time.Time {
+	return c.changedAt
+}
@@ -0,0 +1,79 @@
+package shared_kernel
+
+var _ RoadNetwork = (*CachedRoadNetwork)(nil)
+
+// CachedRoadNetwork - запоминает найденные маршруты и расстояния, чтобы за один проход
+// диспетчеризации или движения курьеров не искать один и тот же маршрут повторно.
+// Живет в пределах одного прохода и не потокобезопасна.
+type CachedRoadNetwork struct {
+	network   RoadNetwork
+	routes    map[routeKey][]RouteStep
+	distances map[routeKey]cachedDistance
+}
+
+type routeKey struct {
+	from Location
+	to   Location
+}
+
+type cachedDistance struct {
+	distance int64
+	ok       bool
+}
+
+func NewCachedRoadNetwork(network RoadNetwork) *CachedRoadNetwork {
+	return &CachedRoadNetwork{
+		network:   network,
+		routes:    make(map[routeKey][]RouteStep),
+		distances: make(map[routeKey]cachedDistance),
+	}
+}
+
+func (n *CachedRoadNetwork) Route(from, to Location) ([]RouteStep, bool) {
+	key := routeKey{from: from, to: to}
+
+	steps, ok := n.routes[key]
+	if !ok {
+		if cached, found := n.distances[key]; found && !cached.ok {
+			return nil, false
+		}
+
+		steps, ok = n.network.Route(from, to)
+		if !ok {
+			n.distances[key] = cachedDistance{}
+			return nil, false
+		}
+
+		n.routes[key] = steps
+		n.distances[key] = cachedDistance{distance: routeDistance(steps), ok: true}
+	}
+
+	// Вызывающий может менять маршрут, кеш от этого страдать не должен
+	result := make([]RouteStep, len(steps))
+	copy(result, steps)
+	return result, true
+}
+
+func (n *CachedRoadNetwork) Distance(from, to Location) (int64, bool) {
+	key := routeKey{from: from, to: to}
+
+	if cached, ok := n.distances[key]; ok {
+		return cached.distance, cached.ok
+	}
+
+	distance, ok := n.network.Distance(from, to)
+	n.distances[key] = cachedDistance{distance: distance, ok: ok}
+	return distance, ok
+}
+
+func (n *CachedRoadNetwork) NearestLocation(location Location) Location {
+	return n.network.NearestLocation(location)
+}
+
+func routeDistance(steps []RouteStep) int64 {
+	var distance int64
+	for _, step := range steps {
+		distance += step.cost
+	}
+	return distance
+}
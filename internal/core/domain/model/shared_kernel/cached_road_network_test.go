@@ -0,0 +1,98 @@
+package shared_kernel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingRoadNetwork struct {
+	RoadNetwork
+	routeCalls    int
+	distanceCalls int
+}
+
+func (n *countingRoadNetwork) Route(from, to Location) ([]RouteStep, bool) {
+	n.routeCalls++
+	return n.RoadNetwork.Route(from, to)
+}
+
+func (n *countingRoadNetwork) Distance(from, to Location) (int64, bool) {
+	n.distanceCalls++
+	return n.RoadNetwork.Distance(from, to)
+}
+
+func Test_Cached_Road_Network_Searches_Route_Once(t *testing.T) {
+	// Arrange
+	from := mustLocation(t, 1, 1)
+	to := mustLocation(t, 5, 5)
+	network := &countingRoadNetwork{RoadNetwork: NewGridRoadNetwork()}
+	cached := NewCachedRoadNetwork(network)
+
+	// Act
+	firstRoute, _ := cached.Route(from, to)
+	secondRoute, _ := cached.Route(from, to)
+	distance, ok := cached.Distance(from, to)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, int64(8), distance)
+	assert.Equal(t, firstRoute, secondRoute)
+	assert.Equal(t, 1, network.routeCalls)
+	assert.Equal(t, 0, network.distanceCalls)
+}
+
+func Test_Cached_Road_Network_Remembers_Distance(t *testing.T) {
+	// Arrange
+	from := mustLocation(t, 1, 1)
+	to := mustLocation(t, 5, 5)
+	network := &countingRoadNetwork{RoadNetwork: NewGridRoadNetwork()}
+	cached := NewCachedRoadNetwork(network)
+
+	// Act
+	cached.Distance(from, to)
+	distance, ok := cached.Distance(from, to)
+	reverse, _ := cached.Distance(to, from)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, int64(8), distance)
+	assert.Equal(t, int64(8), reverse)
+	assert.Equal(t, 2, network.distanceCalls)
+}
+
+func Test_Cached_Road_Network_Remembers_Unreachable_Location(t *testing.T) {
+	// Arrange
+	from := mustLocation(t, 1, 1)
+	to := mustLocation(t, 10, 10)
+	graph, err := NewRoadGraph(DefaultMapBounds(), false, nil, nil)
+	assert.NoError(t, err)
+	network := &countingRoadNetwork{RoadNetwork: graph}
+	cached := NewCachedRoadNetwork(network)
+
+	// Act
+	_, reachable := cached.Distance(from, to)
+	_, routeFound := cached.Route(from, to)
+
+	// Assert
+	assert.False(t, reachable)
+	assert.False(t, routeFound)
+	assert.Equal(t, 1, network.distanceCalls)
+	assert.Equal(t, 0, network.routeCalls)
+}
+
+func Test_Cached_Road_Network_Returns_Copy_Of_Route(t *testing.T) {
+	// Arrange
+	from := mustLocation(t, 1, 1)
+	to := mustLocation(t, 3, 1)
+	cached := NewCachedRoadNetwork(NewGridRoadNetwork())
+	route, _ := cached.Route(from, to)
+
+	// Act
+	route[0] = NewRouteStep(to, 100)
+	again, _ := cached.Route(from, to)
+
+	// Assert
+	assert.Equal(t, mustLocation(t, 2, 1), again[0].Location())
+	assert.Equal(t, int64(1), again[0].Cost())
+}
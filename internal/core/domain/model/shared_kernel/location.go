@@ -7,41 +7,26 @@ import (
 	"delivery/internal/pkg/errs"
 )
 
-const (
-	minX, maxX = 1, 10
-	minY, maxY = 1, 10
-)
-
 type Location struct {
 	x     int64
 	y     int64
 	isSet bool
 }
 
+// NewLocation - координата в границах карты по умолчанию.
+// Границы из конфигурации сервиса проверяет MapBounds.NewLocation.
 func NewLocation(x int64, y int64) (Location, error) {
-	if x < minX || x > maxX {
-		return Location{}, errs.NewValueIsOutOfRangeError("x", x, minX, maxX)
-	}
-
-	if y < minY || y > maxY {
-		return Location{}, errs.NewValueIsOutOfRangeError("y", y, minY, maxY)
-	}
-
-	return Location{x: x, y: y, isSet: true}, nil
+	return DefaultMapBounds().NewLocation(x, y)
 }
 
 func NewRandomLocation() (Location, error) {
-	x, err := randomInt64InRange(minX, maxX)
-	if err != nil {
-		return Location{}, err
-	}
-
-	y, err := randomInt64InRange(minY, maxY)
-	if err != nil {
-		return Location{}, err
-	}
+	return DefaultMapBounds().NewRandomLocation()
+}
 
-	return NewLocation(x, y)
+// LoadLocationFromRepo - координата из хранилища. Границы не проверяются:
+// сохраненные координаты приводятся к границам карты при старте сервиса.
+func LoadLocationFromRepo(x int64, y int64) Location {
+	return Location{x: x, y: y, isSet: true}
 }
 
 func (l Location) X() int64 {
@@ -164,8 +164,8 @@ func Test_Random_Location_Should_Be_In_Range(t *testing.T) {
 
 	// Assert
 	assert.NoError(t, err)
-	assert.True(t, location.X() >= minX && location.X() <= maxX)
-	assert.True(t, location.Y() >= minY && location.Y() <= maxY)
+	assert.True(t, location.X() >= defaultMinX && location.X() <= defaultMaxX)
+	assert.True(t, location.Y() >= defaultMinY && location.Y() <= defaultMaxY)
 }
 
 func courierAndFinalDestinationIsSameLocation() (Location, Location) {
@@ -0,0 +1,83 @@
+package shared_kernel
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+)
+
+const (
+	defaultMinX, defaultMaxX = 1, 10
+	defaultMinY, defaultMaxY = 1, 10
+)
+
+// MapBounds - границы карты, в пределах которых допустимы координаты Location
+type MapBounds struct {
+	minX int64
+	maxX int64
+	minY int64
+	maxY int64
+}
+
+func NewMapBounds(minX, maxX, minY, maxY int64) (MapBounds, error) {
+	if minX > maxX {
+		return MapBounds{}, errs.NewValueIsInvalidErrorWithCause("minX", errors.New("minX is greater than maxX"))
+	}
+
+	if minY > maxY {
+		return MapBounds{}, errs.NewValueIsInvalidErrorWithCause("minY", errors.New("minY is greater than maxY"))
+	}
+
+	return MapBounds{minX: minX, maxX: maxX, minY: minY, maxY: maxY}, nil
+}
+
+func DefaultMapBounds() MapBounds {
+	return MapBounds{minX: defaultMinX, maxX: defaultMaxX, minY: defaultMinY, maxY: defaultMaxY}
+}
+
+func (b MapBounds) MinX() int64 {
+	return b.minX
+}
+
+func (b MapBounds) MaxX() int64 {
+	return b.maxX
+}
+
+func (b MapBounds) MinY() int64 {
+	return b.minY
+}
+
+func (b MapBounds) MaxY() int64 {
+	return b.maxY
+}
+
+func (b MapBounds) Contains(x, y int64) bool {
+	return x >= b.minX && x <= b.maxX && y >= b.minY && y <= b.maxY
+}
+
+// NewLocation - координата, проверенная по этим границам карты
+func (b MapBounds) NewLocation(x int64, y int64) (Location, error) {
+	if x < b.minX || x > b.maxX {
+		return Location{}, errs.NewValueIsOutOfRangeError("x", x, b.minX, b.maxX)
+	}
+
+	if y < b.minY || y > b.maxY {
+		return Location{}, errs.NewValueIsOutOfRangeError("y", y, b.minY, b.maxY)
+	}
+
+	return Location{x: x, y: y, isSet: true}, nil
+}
+
+func (b MapBounds) NewRandomLocation() (Location, error) {
+	x, err := randomInt64InRange(b.minX, b.maxX)
+	if err != nil {
+		return Location{}, err
+	}
+
+	y, err := randomInt64InRange(b.minY, b.maxY)
+	if err != nil {
+		return Location{}, err
+	}
+
+	return b.NewLocation(x, y)
+}
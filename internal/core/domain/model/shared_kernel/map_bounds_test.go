@@ -0,0 +1,49 @@
+package shared_kernel
+
+import (
+	"testing"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Impossible_To_Create_Map_Bounds_With_Min_Greater_Than_Max(t *testing.T) {
+	// Act
+	_, err := NewMapBounds(10, 1, 1, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func Test_Location_Honors_Configured_Map_Bounds(t *testing.T) {
+	// Arrange
+	bounds, err := NewMapBounds(0, 100, 0, 50)
+	assert.NoError(t, err)
+
+	// Act
+	inside, insideErr := bounds.NewLocation(100, 0)
+	_, outsideErr := bounds.NewLocation(10, 51)
+	random, randomErr := bounds.NewRandomLocation()
+
+	// Assert
+	assert.NoError(t, insideErr)
+	assert.Equal(t, int64(100), inside.X())
+	assert.ErrorIs(t, outsideErr, errs.ErrValueIsOutOfRange)
+	assert.NoError(t, randomErr)
+	assert.True(t, bounds.Contains(random.X(), random.Y()))
+}
+
+func Test_Default_Location_Does_Not_Depend_On_Configured_Map_Bounds(t *testing.T) {
+	// Arrange
+	bounds, err := NewMapBounds(0, 100, 0, 50)
+	assert.NoError(t, err)
+
+	// Act
+	_, configuredErr := bounds.NewLocation(100, 50)
+	_, defaultErr := NewLocation(100, 50)
+
+	// Assert
+	assert.NoError(t, configuredErr)
+	assert.ErrorIs(t, defaultErr, errs.ErrValueIsOutOfRange)
+}
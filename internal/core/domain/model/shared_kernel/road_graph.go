@@ -0,0 +1,228 @@
+package shared_kernel
+
+import (
+	"container/heap"
+	"errors"
+
+	"delivery/internal/pkg/errs"
+)
+
+var _ RoadNetwork = (*RoadGraph)(nil)
+
+// RoadEdge - дорога между двумя точками карты
+type RoadEdge struct {
+	from   Location
+	to     Location
+	cost   int64
+	oneWay bool
+}
+
+func NewRoadEdge(from, to Location, cost int64, oneWay bool) (RoadEdge, error) {
+	if !from.IsSet() {
+		return RoadEdge{}, errs.NewValueIsRequiredError("from")
+	}
+
+	if !to.IsSet() {
+		return RoadEdge{}, errs.NewValueIsRequiredError("to")
+	}
+
+	if from.Equals(to) {
+		return RoadEdge{}, errs.NewValueIsInvalidErrorWithCause("to", errors.New("edge must connect different locations"))
+	}
+
+	if cost <= 0 {
+		return RoadEdge{}, errs.NewValueIsInvalidErrorWithCause("cost", errors.New("cost must be greater than 0"))
+	}
+
+	return RoadEdge{from: from, to: to, cost: cost, oneWay: oneWay}, nil
+}
+
+// RoadGraph - дорожная сеть, заданная графом. Кратчайшие маршруты ищутся алгоритмом A*.
+type RoadGraph struct {
+	adjacency map[Location][]RouteStep
+	// heuristicScale - минимальная стоимость единицы манхэттенского расстояния среди всех дорог,
+	// с ней эвристика A* не переоценивает оставшийся путь
+	heuristicScale float64
+}
+
+// NewRoadGraph - строит граф из ребер. Если grid = true, все клетки карты, кроме blocked,
+// дополнительно соединяются с соседними дорогами стоимостью 1.
+func NewRoadGraph(bounds MapBounds, grid bool, edges []RoadEdge, blocked []Location) (*RoadGraph, error) {
+	blockedSet := make(map[Location]struct{}, len(blocked))
+	for _, location := range blocked {
+		blockedSet[location] = struct{}{}
+	}
+
+	g := &RoadGraph{
+		adjacency:      make(map[Location][]RouteStep),
+		heuristicScale: 1,
+	}
+
+	if grid {
+		for x := bounds.minX; x <= bounds.maxX; x++ {
+			for y := bounds.minY; y <= bounds.maxY; y++ {
+				from := Location{x: x, y: y, isSet: true}
+				if _, ok := blockedSet[from]; ok {
+					continue
+				}
+				if _, ok := g.adjacency[from]; !ok {
+					g.adjacency[from] = nil
+				}
+
+				for _, to := range []Location{{x: x + 1, y: y, isSet: true}, {x: x, y: y + 1, isSet: true}} {
+					if !bounds.Contains(to.x, to.y) {
+						continue
+					}
+					if _, ok := blockedSet[to]; ok {
+						continue
+					}
+					g.addEdge(from, to, 1, false)
+				}
+			}
+		}
+	}
+
+	for _, edge := range edges {
+		for _, location := range []Location{edge.from, edge.to} {
+			if !bounds.Contains(location.x, location.y) {
+				return nil, errs.NewValueIsInvalidErrorWithCause("edge", errors.New("edge location is outside map bounds"))
+			}
+			if _, ok := blockedSet[location]; ok {
+				return nil, errs.NewValueIsInvalidErrorWithCause("edge", errors.New("edge leads to a blocked location"))
+			}
+		}
+
+		g.addEdge(edge.from, edge.to, edge.cost, edge.oneWay)
+		g.heuristicScale = min(g.heuristicScale, float64(edge.cost)/float64(edge.from.DistanceTo(edge.to)))
+	}
+
+	return g, nil
+}
+
+func (g *RoadGraph) addEdge(from, to Location, cost int64, oneWay bool) {
+	g.adjacency[from] = append(g.adjacency[from], NewRouteStep(to, cost))
+	if _, ok := g.adjacency[to]; !ok {
+		g.adjacency[to] = nil
+	}
+
+	if !oneWay {
+		g.adjacency[to] = append(g.adjacency[to], NewRouteStep(from, cost))
+	}
+}
+
+func (g *RoadGraph) Route(from, to Location) ([]RouteStep, bool) {
+	if from.Equals(to) {
+		return []RouteStep{}, true
+	}
+
+	if _, ok := g.adjacency[from]; !ok {
+		return nil, false
+	}
+	if _, ok := g.adjacency[to]; !ok {
+		return nil, false
+	}
+
+	cost := map[Location]int64{from: 0}
+	previous := make(map[Location]RouteStep)
+	previousFrom := make(map[Location]Location)
+
+	queue := &routeQueue{}
+	heap.Push(queue, routeQueueItem{location: from, priority: g.heuristic(from, to)})
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(routeQueueItem).location
+		if current.Equals(to) {
+			break
+		}
+
+		for _, next := range g.adjacency[current] {
+			nextCost := cost[current] + next.cost
+			if knownCost, ok := cost[next.location]; ok && knownCost <= nextCost {
+				continue
+			}
+
+			cost[next.location] = nextCost
+			previous[next.location] = next
+			previousFrom[next.location] = current
+			heap.Push(queue, routeQueueItem{
+				location: next.location,
+				priority: float64(nextCost) + g.heuristic(next.location, to),
+			})
+		}
+	}
+
+	if _, ok := cost[to]; !ok {
+		return nil, false
+	}
+
+	var steps []RouteStep
+	for current := to; !current.Equals(from); current = previousFrom[current] {
+		steps = append(steps, previous[current])
+	}
+
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+
+	return steps, true
+}
+
+func (g *RoadGraph) Distance(from, to Location) (int64, bool) {
+	steps, ok := g.Route(from, to)
+	if !ok {
+		return 0, false
+	}
+
+	var distance int64
+	for _, step := range steps {
+		distance += step.cost
+	}
+
+	return distance, true
+}
+
+// NearestLocation - ближайший к location узел графа по манхэттенскому расстоянию.
+// При равенстве выбирается узел с меньшими координатами, чтобы результат не зависел от обхода map.
+func (g *RoadGraph) NearestLocation(location Location) Location {
+	if _, ok := g.adjacency[location]; ok {
+		return location
+	}
+
+	nearest := location
+	var nearestDistance int64 = -1
+	for node := range g.adjacency {
+		distance := node.DistanceTo(location)
+		if nearestDistance < 0 || distance < nearestDistance ||
+			(distance == nearestDistance && (node.x < nearest.x || node.x == nearest.x && node.y < nearest.y)) {
+			nearest, nearestDistance = node, distance
+		}
+	}
+
+	return nearest
+}
+
+func (g *RoadGraph) heuristic(from, to Location) float64 {
+	return g.heuristicScale * float64(from.DistanceTo(to))
+}
+
+type routeQueueItem struct {
+	location Location
+	priority float64
+}
+
+type routeQueue []routeQueueItem
+
+func (q routeQueue) Len() int           { return len(q) }
+func (q routeQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q routeQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *routeQueue) Push(x any) {
+	*q = append(*q, x.(routeQueueItem))
+}
+
+func (q *routeQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,122 @@
+package shared_kernel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Road_Graph_Route_Goes_Around_Blocked_Cells(t *testing.T) {
+	// Arrange
+	from, _ := NewLocation(1, 2)
+	to, _ := NewLocation(3, 2)
+	wall := []Location{mustLocation(t, 2, 1), mustLocation(t, 2, 2)}
+	graph, err := NewRoadGraph(DefaultMapBounds(), true, nil, wall)
+	assert.NoError(t, err)
+
+	// Act
+	route, ok := graph.Route(from, to)
+	distance, _ := graph.Distance(from, to)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), distance)
+	assert.Equal(t, to, route[len(route)-1].Location())
+	for _, step := range route {
+		assert.NotContains(t, wall, step.Location())
+	}
+}
+
+func Test_Road_Graph_Prefers_Cheaper_Road(t *testing.T) {
+	// Arrange
+	from := mustLocation(t, 1, 1)
+	to := mustLocation(t, 10, 1)
+	highway, _ := NewRoadEdge(from, to, 3, false)
+	graph, err := NewRoadGraph(DefaultMapBounds(), true, []RoadEdge{highway}, nil)
+	assert.NoError(t, err)
+
+	// Act
+	distance, ok := graph.Distance(from, to)
+	route, _ := graph.Route(from, to)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), distance)
+	assert.Len(t, route, 1)
+}
+
+func Test_Road_Graph_Respects_One_Way_Roads(t *testing.T) {
+	// Arrange
+	a := mustLocation(t, 1, 1)
+	b := mustLocation(t, 5, 5)
+	road, _ := NewRoadEdge(a, b, 2, true)
+	graph, err := NewRoadGraph(DefaultMapBounds(), false, []RoadEdge{road}, nil)
+	assert.NoError(t, err)
+
+	// Act
+	_, forward := graph.Distance(a, b)
+	_, backward := graph.Distance(b, a)
+
+	// Assert
+	assert.True(t, forward)
+	assert.False(t, backward)
+}
+
+func Test_Road_Graph_Target_Behind_Wall_Is_Unreachable(t *testing.T) {
+	// Arrange
+	wall := make([]Location, 0, 10)
+	for y := int64(1); y <= 10; y++ {
+		wall = append(wall, mustLocation(t, 5, y))
+	}
+	graph, err := NewRoadGraph(DefaultMapBounds(), true, nil, wall)
+	assert.NoError(t, err)
+
+	// Act
+	_, ok := graph.Route(mustLocation(t, 1, 1), mustLocation(t, 10, 10))
+
+	// Assert
+	assert.False(t, ok)
+}
+
+func Test_Road_Graph_Nearest_Location_Is_Closest_Node(t *testing.T) {
+	// Arrange
+	from := mustLocation(t, 1, 1)
+	to := mustLocation(t, 10, 1)
+	road, _ := NewRoadEdge(from, to, 9, false)
+	graph, err := NewRoadGraph(DefaultMapBounds(), false, []RoadEdge{road}, nil)
+	assert.NoError(t, err)
+
+	// Act
+	nearestToOffRoad := graph.NearestLocation(mustLocation(t, 8, 5))
+	nearestToNode := graph.NearestLocation(from)
+
+	// Assert
+	assert.Equal(t, to, nearestToOffRoad)
+	assert.Equal(t, from, nearestToNode)
+}
+
+func Test_Grid_Road_Network_Distance_Is_Manhattan(t *testing.T) {
+	// Arrange
+	from := mustLocation(t, 4, 9)
+	to := mustLocation(t, 2, 6)
+
+	// Act
+	distance, _ := NewGridRoadNetwork().Distance(from, to)
+	route, _ := NewGridRoadNetwork().Route(from, to)
+
+	// Assert
+	assert.Equal(t, from.DistanceTo(to), distance)
+	assert.Len(t, route, 5)
+	assert.Equal(t, to, route[len(route)-1].Location())
+}
+
+func mustLocation(t *testing.T, x, y int64) Location {
+	t.Helper()
+
+	location, err := NewLocation(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location
+}
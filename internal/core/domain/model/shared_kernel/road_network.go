@@ -0,0 +1,73 @@
+package shared_kernel
+
+// RoadNetwork - дорожная сеть, по которой считаются расстояния и строятся маршруты курьеров
+type RoadNetwork interface {
+	// Route - шаги кратчайшего маршрута от from до to без начальной точки. false, если to недостижима.
+	Route(from, to Location) ([]RouteStep, bool)
+	// Distance - стоимость кратчайшего маршрута от from до to
+	Distance(from, to Location) (int64, bool)
+	// NearestLocation - ближайшая к location точка, через которую проходят дороги
+	NearestLocation(location Location) Location
+}
+
+// RouteStep - очередная точка маршрута и стоимость перехода в нее из предыдущей
+type RouteStep struct {
+	location Location
+	cost     int64
+}
+
+func NewRouteStep(location Location, cost int64) RouteStep {
+	return RouteStep{location: location, cost: cost}
+}
+
+func (s RouteStep) Location() Location {
+	return s.location
+}
+
+func (s RouteStep) Cost() int64 {
+	return s.cost
+}
+
+// GridRoadNetwork - сеть без препятствий: можно пройти в любую соседнюю клетку,
+// маршрут идет сначала по X, потом по Y
+type GridRoadNetwork struct{}
+
+func NewGridRoadNetwork() *GridRoadNetwork {
+	return &GridRoadNetwork{}
+}
+
+func (n *GridRoadNetwork) Route(from, to Location) ([]RouteStep, bool) {
+	steps := make([]RouteStep, 0, from.DistanceTo(to))
+
+	x, y := from.x, from.y
+	for x != to.x {
+		x += sign(to.x - x)
+		steps = append(steps, NewRouteStep(Location{x: x, y: y, isSet: true}, 1))
+	}
+	for y != to.y {
+		y += sign(to.y - y)
+		steps = append(steps, NewRouteStep(Location{x: x, y: y, isSet: true}, 1))
+	}
+
+	return steps, true
+}
+
+func (n *GridRoadNetwork) Distance(from, to Location) (int64, bool) {
+	return from.DistanceTo(to), true
+}
+
+// NearestLocation - в сети без препятствий дорога есть в любой клетке
+func (n *GridRoadNetwork) NearestLocation(location Location) Location {
+	return location
+}
+
+func sign(x int64) int64 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	default:
+		return 0
+	}
+}
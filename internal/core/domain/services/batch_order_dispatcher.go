@@ -0,0 +1,139 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	aggCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
+	aggOrder "delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/pkg/errs"
+)
+
+type BatchDispatcher interface {
+	DispatchAll(orders []*aggOrder.Order, couriers []*aggCourier.Courier) ([]*aggOrder.Order, []*dispatch.Decision, error)
+}
+
+var _ BatchDispatcher = (*BatchCourierDispatcher)(nil)
+
+// BatchCourierDispatcher - распределяет сразу все заказы между курьерами так,
+// чтобы суммарное время до заказов было минимальным (задача о назначениях).
+// Каждый курьер за один проход получает не больше одного заказа.
+type BatchCourierDispatcher struct {
+	now         func() time.Time
+	roadNetwork kernel.RoadNetwork
+}
+
+func NewBatchCourierDispatcher(roadNetwork kernel.RoadNetwork) *BatchCourierDispatcher {
+	return &BatchCourierDispatcher{now: time.Now, roadNetwork: roadNetwork}
+}
+
+// DispatchAll - назначает заказы курьерам и возвращает назначенные заказы.
+// Заказы, для которых не нашлось подходящего курьера, остаются в статусе Created.
+func (d *BatchCourierDispatcher) DispatchAll(orders []*aggOrder.Order, couriers []*aggCourier.Courier) ([]*aggOrder.Order, []*dispatch.Decision, error) {
+	for _, order := range orders {
+		if order == nil {
+			return nil, nil, errs.NewValueIsInvalidErrorWithCause("orders", errors.New("impossible to dispatch nil order"))
+		}
+
+		if !aggOrder.StatusCreated.Equals(order.Status()) {
+			return nil, nil, errs.NewValueIsInvalidErrorWithCause("orders", errors.New("impossible to dispatch order in status other than created"))
+		}
+	}
+
+	for _, courier := range couriers {
+		if courier == nil {
+			return nil, nil, errs.NewValueIsInvalidErrorWithCause("couriers", errors.New("impossible to dispatch order to nil courier"))
+		}
+	}
+
+	if len(orders) == 0 || len(couriers) == 0 {
+		return []*aggOrder.Order{}, []*dispatch.Decision{}, nil
+	}
+
+	// Расстояния из матрицы стоимостей понадобятся еще раз при взятии заказа
+	network := kernel.NewCachedRoadNetwork(d.roadNetwork)
+
+	cost, feasible, decisions, err := d.buildCostMatrix(network, orders, couriers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orderToCourier := solveAssignment(cost)
+
+	assignedOrders := make([]*aggOrder.Order, 0, len(orderToCourier))
+	for i, j := range orderToCourier {
+		if j < 0 || !feasible[i][j] {
+			// Подходящие курьеры этого заказа достались другим заказам
+			decisions[i].RejectEligible(dispatch.RejectionReasonAssignedToAnotherOrder)
+			continue
+		}
+
+		order, courier := orders[i], couriers[j]
+
+		if err := decisions[i].Select(courier.ID()); err != nil {
+			return nil, nil, err
+		}
+
+		if err := courier.TakeOrder(network, order); err != nil {
+			return nil, nil, err
+		}
+
+		if err := order.Assign(courier.ID()); err != nil {
+			return nil, nil, err
+		}
+
+		assignedOrders = append(assignedOrders, order)
+	}
+
+	return assignedOrders, decisions, nil
+}
+
+// buildCostMatrix - стоимость назначения - время курьера до заказа.
+// Недопустимые пары получают штраф, больший любой суммы допустимых стоимостей,
+// поэтому алгоритм в первую очередь максимизирует число допустимых назначений.
+// Заодно для каждого заказа собирается запись о рассмотренных курьерах.
+func (d *BatchCourierDispatcher) buildCostMatrix(network kernel.RoadNetwork, orders []*aggOrder.Order, couriers []*aggCourier.Courier) ([][]float64, [][]bool, []*dispatch.Decision, error) {
+	now := d.now()
+
+	cost := make([][]float64, len(orders))
+	feasible := make([][]bool, len(orders))
+	decisions := make([]*dispatch.Decision, len(orders))
+	maxCost := 0.0
+
+	for i, order := range orders {
+		cost[i] = make([]float64, len(couriers))
+		feasible[i] = make([]bool, len(couriers))
+
+		decision, err := dispatch.NewDecision(order.ID(), StrategyOptimal, now)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		decisions[i] = decision
+
+		for j, courier := range couriers {
+			candidate := evaluateCandidate(network, order, courier, now)
+			decision.AddCandidate(candidate)
+
+			if !candidate.IsEligible() {
+				continue
+			}
+
+			cost[i][j] = candidate.TimeToLocation()
+			feasible[i][j] = true
+			maxCost = max(maxCost, cost[i][j])
+		}
+	}
+
+	penalty := (maxCost + 1) * float64(min(len(orders), len(couriers))+1)
+	for i := range cost {
+		for j := range cost[i] {
+			if !feasible[i][j] {
+				cost[i][j] = penalty
+			}
+		}
+	}
+
+	return cost, feasible, decisions, nil
+}
@@ -0,0 +1,239 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	aggCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
+	aggOrder "delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchCourierDispatcher_NothingToDispatchWithoutCouriers(t *testing.T) {
+	// Arrange
+	dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+	orders := []*aggOrder.Order{getRandomOrder(t)}
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll(orders, []*aggCourier.Courier{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, assignedOrders)
+	assert.True(t, orders[0].Status().Equals(aggOrder.StatusCreated))
+}
+
+func TestBatchCourierDispatcher_ImpossibleToDispatchOrderNotInCreationState(t *testing.T) {
+	// Arrange
+	dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+	orders := []*aggOrder.Order{getRandomOrder(t), getRandomAssignedOrder(t)}
+
+	// Act
+	_, _, err := dispatcher.DispatchAll(orders, getRandomCouriers(t))
+
+	// Assert
+	assert.Error(t, err)
+	assert.True(t, orders[0].Status().Equals(aggOrder.StatusCreated))
+}
+
+func TestBatchCourierDispatcher_ImpossibleToDispatchMissingOrder(t *testing.T) {
+	// Arrange
+	dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+
+	// Act
+	_, _, err := dispatcher.DispatchAll([]*aggOrder.Order{nil}, getRandomCouriers(t))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestBatchCourierDispatcher_FindsGloballyOptimalAssignment(t *testing.T) {
+	// Arrange
+	dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+
+	// Жадный выбор отдал бы первый заказ ближайшему курьеру-1 (1 шаг),
+	// и второму заказу достался бы курьер-2 (8 шагов), итого 9.
+	// Оптимально: курьер-2 везет первый заказ (1 шаг), курьер-1 - второй (6 шагов), итого 7.
+	firstOrderLocation, _ := kernel.NewLocation(2, 1)
+	secondOrderLocation, _ := kernel.NewLocation(9, 1)
+	firstCourierLocation, _ := kernel.NewLocation(3, 1)
+	secondCourierLocation, _ := kernel.NewLocation(1, 1)
+
+	firstOrder := getOrderWithLocation(t, firstOrderLocation)
+	secondOrder := getOrderWithLocation(t, secondOrderLocation)
+	firstCourier := getCourierWithLocationAndSpeed(t, "courier-1", firstCourierLocation, 1)
+	secondCourier := getCourierWithLocationAndSpeed(t, "courier-2", secondCourierLocation, 1)
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll(
+		[]*aggOrder.Order{firstOrder, secondOrder},
+		[]*aggCourier.Courier{firstCourier, secondCourier},
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, assignedOrders, 2)
+	assert.Equal(t, secondCourier.ID(), *firstOrder.CourierID())
+	assert.Equal(t, firstCourier.ID(), *secondOrder.CourierID())
+	assert.True(t, firstOrder.Status().Equals(aggOrder.StatusAssigned))
+	assert.True(t, secondOrder.Status().Equals(aggOrder.StatusAssigned))
+}
+
+func TestBatchCourierDispatcher_LeavesOrdersWithoutSuitableCourierInCreatedStatus(t *testing.T) {
+	// Arrange
+	dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+	location, _ := kernel.NewLocation(1, 1)
+
+	smallOrder := getOrderWithLocation(t, location)
+	hugeOrder, _ := aggOrder.NewOrder(smallOrder.ID(), location, 100)
+	courier := getCourierWithLocation(t, "courier-1", location)
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll(
+		[]*aggOrder.Order{hugeOrder, smallOrder},
+		[]*aggCourier.Courier{courier},
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*aggOrder.Order{smallOrder}, assignedOrders)
+	assert.True(t, hugeOrder.Status().Equals(aggOrder.StatusCreated))
+	assert.Equal(t, courier.ID(), *smallOrder.CourierID())
+}
+
+func TestBatchCourierDispatcher_SkipsCourierWhoCannotMeetDeliveryPeriod(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	dispatcher := &BatchCourierDispatcher{now: func() time.Time { return now }, roadNetwork: kernel.NewGridRoadNetwork()}
+
+	orderLocation, _ := kernel.NewLocation(10, 10)
+	courierLocation, _ := kernel.NewLocation(1, 1)
+
+	order := getOrderWithLocationAndDeliveryPeriod(t, orderLocation, now, now.Add(5*aggCourier.MoveInterval))
+	slowCourier := getCourierWithLocationAndSpeed(t, "slow-courier", courierLocation, 1)
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll([]*aggOrder.Order{order}, []*aggCourier.Courier{slowCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, assignedOrders)
+	assert.True(t, order.Status().Equals(aggOrder.StatusCreated))
+}
+
+func TestBatchCourierDispatcher_EachCourierTakesOneOrderPerBatch(t *testing.T) {
+	// Arrange
+	dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+	location, _ := kernel.NewLocation(1, 1)
+
+	courier := getCourierWithLocation(t, "courier-1", location)
+	_ = courier.AddStoragePlace("Багажник", 100)
+	orders := []*aggOrder.Order{getOrderWithLocation(t, location), getOrderWithLocation(t, location)}
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll(orders, []*aggCourier.Courier{courier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, assignedOrders, 1)
+}
+
+func TestBatchCourierDispatcher_ReturnsDecisionForEveryOrder(t *testing.T) {
+	// Arrange
+	dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+	location, _ := kernel.NewLocation(1, 1)
+
+	courier := getCourierWithLocation(t, "courier-1", location)
+	_ = courier.AddStoragePlace("Багажник", 100)
+	orders := []*aggOrder.Order{getOrderWithLocation(t, location), getOrderWithLocation(t, location)}
+
+	// Act
+	assignedOrders, decisions, err := dispatcher.DispatchAll(orders, []*aggCourier.Courier{courier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, decisions, 2)
+
+	for i, decision := range decisions {
+		assert.Equal(t, orders[i].ID(), decision.OrderID())
+		assert.Equal(t, StrategyOptimal, decision.Strategy())
+		assert.Len(t, decision.Candidates(), 1)
+
+		if orders[i].Status().Equals(aggOrder.StatusAssigned) {
+			assert.Equal(t, assignedOrders[0].ID(), decision.OrderID())
+			assert.Equal(t, courier.ID(), *decision.CourierID())
+			assert.Equal(t, dispatch.RejectionReasonNone, decision.Candidates()[0].RejectionReason())
+		} else {
+			assert.Nil(t, decision.CourierID())
+			assert.Equal(t, dispatch.RejectionReasonAssignedToAnotherOrder, decision.Candidates()[0].RejectionReason())
+		}
+	}
+}
+
+func TestBatchCourierDispatcher_SearchesEveryRouteOncePerBatch(t *testing.T) {
+	// Arrange
+	network := &recordingRoadNetwork{RoadNetwork: kernel.NewGridRoadNetwork(), requests: map[[2]kernel.Location]int{}}
+	dispatcher := NewBatchCourierDispatcher(network)
+	orders := []*aggOrder.Order{getRandomOrder(t), getRandomOrder(t), getRandomOrder(t)}
+	couriers := getRandomCouriers(t)
+
+	// Act
+	_, _, err := dispatcher.DispatchAll(orders, couriers)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, network.requests)
+	for route, count := range network.requests {
+		assert.Equal(t, 1, count, "route %v searched more than once", route)
+	}
+}
+
+func BenchmarkBatchCourierDispatcher_DispatchAll_1000x1000(b *testing.B) {
+	for range b.N {
+		b.StopTimer()
+		orders := make([]*aggOrder.Order, 0, 1000)
+		couriers := make([]*aggCourier.Courier, 0, 1000)
+		for i := range 1000 {
+			orders = append(orders, getRandomOrder(b))
+			couriers = append(couriers, getRandomCourier(b, fmt.Sprintf("courier-%d", i)))
+		}
+		dispatcher := NewBatchCourierDispatcher(kernel.NewGridRoadNetwork())
+		b.StartTimer()
+
+		_, _, err := dispatcher.DispatchAll(orders, couriers)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func getCourierWithLocationAndSpeed(t *testing.T, name string, location kernel.Location, speed int64) *aggCourier.Courier {
+	t.Helper()
+
+	courier, err := aggCourier.NewCourier(name, speed, location)
+	if err != nil {
+		t.Fatalf("failed to create courier: %v", err)
+	}
+
+	return courier
+}
+
+// recordingRoadNetwork - считает, сколько раз у сети спросили каждый маршрут
+type recordingRoadNetwork struct {
+	kernel.RoadNetwork
+	requests map[[2]kernel.Location]int
+}
+
+func (n *recordingRoadNetwork) Route(from, to kernel.Location) ([]kernel.RouteStep, bool) {
+	n.requests[[2]kernel.Location{from, to}]++
+	return n.RoadNetwork.Route(from, to)
+}
+
+func (n *recordingRoadNetwork) Distance(from, to kernel.Location) (int64, bool) {
+	n.requests[[2]kernel.Location{from, to}]++
+	return n.RoadNetwork.Distance(from, to)
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"math"
+	"sync"
+
+	aggCourier "delivery/internal/core/domain/model/courier"
+	aggOrder "delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+
+	"github.com/google/uuid"
+)
+
+// CourierSelectionStrategy - выбирает курьера для заказа среди кандидатов.
+// Все кандидаты уже способны взять заказ, список кандидатов не пуст.
+type CourierSelectionStrategy interface {
+	Name() string
+	SelectCourier(network kernel.RoadNetwork, order *aggOrder.Order, candidates []*aggCourier.Courier) *aggCourier.Courier
+}
+
+var _ CourierSelectionStrategy = (*NearestCourierStrategy)(nil)
+var _ CourierSelectionStrategy = (*LeastLoadedCourierStrategy)(nil)
+var _ CourierSelectionStrategy = (*RoundRobinCourierStrategy)(nil)
+var _ CourierSelectionStrategy = (*WeightedCourierStrategy)(nil)
+
+// NearestCourierStrategy - курьер, который быстрее всех доберется до заказа
+type NearestCourierStrategy struct{}
+
+func NewNearestCourierStrategy() *NearestCourierStrategy {
+	return &NearestCourierStrategy{}
+}
+
+func (s *NearestCourierStrategy) Name() string {
+	return StrategyNearest
+}
+
+func (s *NearestCourierStrategy) SelectCourier(network kernel.RoadNetwork, order *aggOrder.Order, candidates []*aggCourier.Courier) *aggCourier.Courier {
+	var bestCourier *aggCourier.Courier
+	minTime := math.MaxFloat64
+
+	for _, courier := range candidates {
+		timeToLocation := courier.CalculateTimeToDeliver(network, order)
+
+		if timeToLocation < minTime {
+			minTime = timeToLocation
+			bestCourier = courier
+		}
+	}
+
+	return bestCourier
+}
+
+// LeastLoadedCourierStrategy - курьер с наименьшим числом занятых мест хранения,
+// при равной загрузке - тот, кто быстрее доберется до заказа
+type LeastLoadedCourierStrategy struct{}
+
+func NewLeastLoadedCourierStrategy() *LeastLoadedCourierStrategy {
+	return &LeastLoadedCourierStrategy{}
+}
+
+func (s *LeastLoadedCourierStrategy) Name() string {
+	return StrategyLeastLoaded
+}
+
+func (s *LeastLoadedCourierStrategy) SelectCourier(network kernel.RoadNetwork, order *aggOrder.Order, candidates []*aggCourier.Courier) *aggCourier.Courier {
+	var bestCourier *aggCourier.Courier
+	minLoad := math.MaxInt
+	minTime := math.MaxFloat64
+
+	for _, courier := range candidates {
+		load := occupiedStoragePlaces(courier)
+		timeToLocation := courier.CalculateTimeToDeliver(network, order)
+
+		if load < minLoad || (load == minLoad && timeToLocation < minTime) {
+			minLoad = load
+			minTime = timeToLocation
+			bestCourier = courier
+		}
+	}
+
+	return bestCourier
+}
+
+// RoundRobinCourierStrategy - заказы достаются курьерам по очереди:
+// выбирается тот, кто дольше всех не получал заказ через эту стратегию
+type RoundRobinCourierStrategy struct {
+	mu           sync.Mutex
+	turn         uint64
+	lastAssigned map[uuid.UUID]uint64
+}
+
+func NewRoundRobinCourierStrategy() *RoundRobinCourierStrategy {
+	return &RoundRobinCourierStrategy{lastAssigned: make(map[uuid.UUID]uint64)}
+}
+
+func (s *RoundRobinCourierStrategy) Name() string {
+	return StrategyRoundRobin
+}
+
+func (s *RoundRobinCourierStrategy) SelectCourier(_ kernel.RoadNetwork, _ *aggOrder.Order, candidates []*aggCourier.Courier) *aggCourier.Courier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var bestCourier *aggCourier.Courier
+	var bestTurn uint64
+
+	for _, courier := range candidates {
+		courierTurn := s.lastAssigned[courier.ID()]
+
+		// При равенстве сравниваем идентификаторы, чтобы очередь не зависела от порядка кандидатов
+		if bestCourier == nil || courierTurn < bestTurn ||
+			(courierTurn == bestTurn && courier.ID().String() < bestCourier.ID().String()) {
+			bestCourier = courier
+			bestTurn = courierTurn
+		}
+	}
+
+	if bestCourier != nil {
+		s.turn++
+		s.lastAssigned[bestCourier.ID()] = s.turn
+	}
+
+	return bestCourier
+}
+
+// WeightedCourierStrategy - курьер с наименьшей взвешенной оценкой.
+// Оценка складывается из времени до заказа (относительно самого долгого кандидата)
+// и доли занятых мест хранения.
+type WeightedCourierStrategy struct {
+	timeWeight float64
+	loadWeight float64
+}
+
+func NewWeightedCourierStrategy(timeWeight float64, loadWeight float64) *WeightedCourierStrategy {
+	return &WeightedCourierStrategy{timeWeight: timeWeight, loadWeight: loadWeight}
+}
+
+func (s *WeightedCourierStrategy) Name() string {
+	return StrategyWeighted
+}
+
+func (s *WeightedCourierStrategy) SelectCourier(network kernel.RoadNetwork, order *aggOrder.Order, candidates []*aggCourier.Courier) *aggCourier.Courier {
+	maxTime := 0.0
+	for _, courier := range candidates {
+		maxTime = max(maxTime, courier.CalculateTimeToDeliver(network, order))
+	}
+
+	var bestCourier *aggCourier.Courier
+	minScore := math.MaxFloat64
+
+	for _, courier := range candidates {
+		timeScore := 0.0
+		if maxTime > 0 {
+			timeScore = courier.CalculateTimeToDeliver(network, order) / maxTime
+		}
+
+		loadScore := float64(occupiedStoragePlaces(courier)) / float64(len(courier.StoragePlaces()))
+
+		score := s.timeWeight*timeScore + s.loadWeight*loadScore
+		if score < minScore {
+			minScore = score
+			bestCourier = courier
+		}
+	}
+
+	return bestCourier
+}
+
+func occupiedStoragePlaces(courier *aggCourier.Courier) int {
+	occupied := 0
+	for _, storagePlace := range courier.StoragePlaces() {
+		if storagePlace.IsOccupied() {
+			occupied++
+		}
+	}
+
+	return occupied
+}
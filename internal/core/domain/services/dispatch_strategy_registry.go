@@ -0,0 +1,85 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+)
+
+const (
+	StrategyNearest     = "nearest"
+	StrategyLeastLoaded = "least-loaded"
+	StrategyRoundRobin  = "round-robin"
+	StrategyWeighted    = "weighted"
+	// StrategyOptimal - назначение всех заказов тика через венгерский алгоритм (BatchCourierDispatcher)
+	StrategyOptimal = "optimal"
+)
+
+// DispatchStrategyRegistry - реестр стратегий выбора курьера по имени
+type DispatchStrategyRegistry struct {
+	mu         sync.RWMutex
+	strategies map[string]CourierSelectionStrategy
+}
+
+func NewDispatchStrategyRegistry() *DispatchStrategyRegistry {
+	return &DispatchStrategyRegistry{strategies: make(map[string]CourierSelectionStrategy)}
+}
+
+// NewDefaultDispatchStrategyRegistry - реестр со всеми встроенными стратегиями
+func NewDefaultDispatchStrategyRegistry(timeWeight float64, loadWeight float64) *DispatchStrategyRegistry {
+	registry := NewDispatchStrategyRegistry()
+	registry.Register(StrategyNearest, NewNearestCourierStrategy())
+	registry.Register(StrategyLeastLoaded, NewLeastLoadedCourierStrategy())
+	registry.Register(StrategyRoundRobin, NewRoundRobinCourierStrategy())
+	registry.Register(StrategyWeighted, NewWeightedCourierStrategy(timeWeight, loadWeight))
+
+	return registry
+}
+
+func (r *DispatchStrategyRegistry) Register(name string, strategy CourierSelectionStrategy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.strategies[name] = strategy
+}
+
+func (r *DispatchStrategyRegistry) Strategy(name string) (CourierSelectionStrategy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	strategy, ok := r.strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown dispatch strategy %q, available: %v", name, r.namesLocked())
+	}
+
+	return strategy, nil
+}
+
+// Dispatcher - диспетчер, выбирающий курьера стратегией с указанным именем
+func (r *DispatchStrategyRegistry) Dispatcher(name string, roadNetwork kernel.RoadNetwork) (*CourierDispatcher, error) {
+	strategy, err := r.Strategy(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCourierDispatcherWithStrategy(strategy, roadNetwork), nil
+}
+
+func (r *DispatchStrategyRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.namesLocked()
+}
+
+func (r *DispatchStrategyRegistry) namesLocked() []string {
+	names := make([]string, 0, len(r.strategies))
+	for name := range r.strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
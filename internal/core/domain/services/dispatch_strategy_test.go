@@ -0,0 +1,234 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	aggCourier "delivery/internal/core/domain/model/courier"
+	aggOrder "delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
+	"delivery/internal/pkg/errs"
+)
+
+func TestNearestCourierStrategy_SelectsFastestCourier(t *testing.T) {
+	// Arrange
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(1, 1)
+
+	order := getOrderWithLocation(t, orderLocation)
+	nearCourier := getCourierWithLocation(t, "near", nearLocation)
+	farCourier := getCourierWithLocation(t, "far", farLocation)
+	dispatcher := NewCourierDispatcherWithStrategy(NewNearestCourierStrategy(), kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedCourier, _, err := dispatcher.Dispatch(order, []*aggCourier.Courier{farCourier, nearCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, nearCourier.ID(), assignedCourier.ID())
+}
+
+func TestLeastLoadedCourierStrategy_SelectsCourierWithFewestOccupiedStoragePlaces(t *testing.T) {
+	// Arrange
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(1, 1)
+
+	busyCourier := getCourierWithLocation(t, "busy", nearLocation)
+	err := busyCourier.AddStoragePlace("backpack", 10)
+	assert.NoError(t, err)
+	err = busyCourier.TakeOrder(kernel.NewGridRoadNetwork(), getRandomOrder(t))
+	assert.NoError(t, err)
+
+	freeCourier := getCourierWithLocation(t, "free", farLocation)
+	order := getOrderWithLocation(t, orderLocation)
+	dispatcher := NewCourierDispatcherWithStrategy(NewLeastLoadedCourierStrategy(), kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedCourier, _, err := dispatcher.Dispatch(order, []*aggCourier.Courier{busyCourier, freeCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, freeCourier.ID(), assignedCourier.ID())
+}
+
+func TestLeastLoadedCourierStrategy_EqualLoadFallsBackToNearest(t *testing.T) {
+	// Arrange
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(1, 1)
+
+	order := getOrderWithLocation(t, orderLocation)
+	nearCourier := getCourierWithLocation(t, "near", nearLocation)
+	farCourier := getCourierWithLocation(t, "far", farLocation)
+	dispatcher := NewCourierDispatcherWithStrategy(NewLeastLoadedCourierStrategy(), kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedCourier, _, err := dispatcher.Dispatch(order, []*aggCourier.Courier{farCourier, nearCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, nearCourier.ID(), assignedCourier.ID())
+}
+
+func TestRoundRobinCourierStrategy_RotatesCouriers(t *testing.T) {
+	// Arrange
+	couriers := getRandomCouriers(t)
+	strategy := NewRoundRobinCourierStrategy()
+
+	// Act
+	first := strategy.SelectCourier(kernel.NewGridRoadNetwork(), getRandomOrder(t), couriers)
+	second := strategy.SelectCourier(kernel.NewGridRoadNetwork(), getRandomOrder(t), couriers)
+	third := strategy.SelectCourier(kernel.NewGridRoadNetwork(), getRandomOrder(t), couriers)
+
+	// Assert
+	assert.NotEqual(t, first.ID(), second.ID())
+	assert.Equal(t, first.ID(), third.ID())
+}
+
+func TestRoundRobinCourierStrategy_DoesNotDependOnCandidatesOrder(t *testing.T) {
+	// Arrange
+	couriers := getRandomCouriers(t)
+	reversed := []*aggCourier.Courier{couriers[1], couriers[0]}
+
+	// Act
+	first := NewRoundRobinCourierStrategy().SelectCourier(kernel.NewGridRoadNetwork(), getRandomOrder(t), couriers)
+	second := NewRoundRobinCourierStrategy().SelectCourier(kernel.NewGridRoadNetwork(), getRandomOrder(t), reversed)
+
+	// Assert
+	assert.Equal(t, first.ID(), second.ID())
+}
+
+func TestWeightedCourierStrategy_LoadWeightOutweighsDistance(t *testing.T) {
+	// Arrange
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(1, 1)
+
+	busyCourier := getCourierWithLocation(t, "busy", nearLocation)
+	err := busyCourier.AddStoragePlace("backpack", 10)
+	assert.NoError(t, err)
+	err = busyCourier.TakeOrder(kernel.NewGridRoadNetwork(), getRandomOrder(t))
+	assert.NoError(t, err)
+
+	freeCourier := getCourierWithLocation(t, "free", farLocation)
+	order := getOrderWithLocation(t, orderLocation)
+	dispatcher := NewCourierDispatcherWithStrategy(NewWeightedCourierStrategy(1, 10), kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedCourier, _, err := dispatcher.Dispatch(order, []*aggCourier.Courier{busyCourier, freeCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, freeCourier.ID(), assignedCourier.ID())
+}
+
+func TestWeightedCourierStrategy_TimeWeightOutweighsLoad(t *testing.T) {
+	// Arrange
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(1, 1)
+
+	busyCourier := getCourierWithLocation(t, "busy", nearLocation)
+	err := busyCourier.AddStoragePlace("backpack", 10)
+	assert.NoError(t, err)
+	err = busyCourier.TakeOrder(kernel.NewGridRoadNetwork(), getRandomOrder(t))
+	assert.NoError(t, err)
+
+	freeCourier := getCourierWithLocation(t, "free", farLocation)
+	order := getOrderWithLocation(t, orderLocation)
+	dispatcher := NewCourierDispatcherWithStrategy(NewWeightedCourierStrategy(10, 1), kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedCourier, _, err := dispatcher.Dispatch(order, []*aggCourier.Courier{busyCourier, freeCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, busyCourier.ID(), assignedCourier.ID())
+}
+
+func TestCourierDispatcher_DispatchAllAssignsOrdersOneByOne(t *testing.T) {
+	// Arrange
+	location, _ := kernel.NewLocation(1, 1)
+	orders := []*aggOrder.Order{getOrderWithLocation(t, location), getOrderWithLocation(t, location)}
+	couriers := getRandomCouriers(t)
+	dispatcher := NewCourierDispatcherWithStrategy(NewRoundRobinCourierStrategy(), kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll(orders, couriers)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, assignedOrders, 2)
+	assert.NotEqual(t, *assignedOrders[0].CourierID(), *assignedOrders[1].CourierID())
+}
+
+func TestCourierDispatcher_DispatchAllSkipsOrdersWithoutSuitableCourier(t *testing.T) {
+	// Arrange
+	location, _ := kernel.NewLocation(1, 1)
+	orders := []*aggOrder.Order{getOrderWithLocation(t, location), getOrderWithLocation(t, location)}
+	couriers := []*aggCourier.Courier{getCourierWithLocation(t, "courier", location)}
+	dispatcher := NewCourierDispatcher(kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll(orders, couriers)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, assignedOrders, 1)
+	assert.True(t, orders[1].Status().Equals(aggOrder.StatusCreated))
+}
+
+// namelessStrategy - стратегия без имени, решение для нее создать нельзя
+type namelessStrategy struct {
+	*NearestCourierStrategy
+}
+
+func (s namelessStrategy) Name() string {
+	return ""
+}
+
+func TestCourierDispatcher_DispatchAllReturnsErrorsOtherThanNoSuitableCourier(t *testing.T) {
+	// Arrange
+	location, _ := kernel.NewLocation(1, 1)
+	orders := []*aggOrder.Order{getOrderWithLocation(t, location)}
+	couriers := []*aggCourier.Courier{getCourierWithLocation(t, "courier", location)}
+	dispatcher := NewCourierDispatcherWithStrategy(namelessStrategy{NewNearestCourierStrategy()}, kernel.NewGridRoadNetwork())
+
+	// Act
+	assignedOrders, _, err := dispatcher.DispatchAll(orders, couriers)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsRequired)
+	assert.Nil(t, assignedOrders)
+	assert.True(t, orders[0].Status().Equals(aggOrder.StatusCreated))
+}
+
+func TestDispatchStrategyRegistry_ReturnsRegisteredStrategies(t *testing.T) {
+	// Arrange
+	registry := NewDefaultDispatchStrategyRegistry(1, 1)
+
+	// Act
+	names := registry.Names()
+
+	// Assert
+	assert.Equal(t, []string{StrategyLeastLoaded, StrategyNearest, StrategyRoundRobin, StrategyWeighted}, names)
+	for _, name := range names {
+		dispatcher, err := registry.Dispatcher(name, kernel.NewGridRoadNetwork())
+		assert.NoError(t, err)
+		assert.NotNil(t, dispatcher)
+	}
+}
+
+func TestDispatchStrategyRegistry_UnknownStrategy(t *testing.T) {
+	// Arrange
+	registry := NewDefaultDispatchStrategyRegistry(1, 1)
+
+	// Act
+	_, err := registry.Dispatcher("unknown", kernel.NewGridRoadNetwork())
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package services
+
+import "math"
+
+// solveAssignment - решает задачу о назначениях венгерским алгоритмом за O(n^2 * m).
+// cost[i][j] - стоимость назначения строки i на столбец j.
+// Возвращает для каждой строки номер назначенного столбца или -1, если строке столбца не досталось.
+func solveAssignment(cost [][]float64) []int {
+	rows := len(cost)
+	if rows == 0 {
+		return nil
+	}
+	cols := len(cost[0])
+	if cols == 0 {
+		return newUnassigned(rows)
+	}
+
+	// Алгоритм требует, чтобы строк было не больше, чем столбцов
+	if rows > cols {
+		colToRow := solveAssignment(transpose(cost))
+
+		rowToCol := newUnassigned(rows)
+		for col, row := range colToRow {
+			if row >= 0 {
+				rowToCol[row] = col
+			}
+		}
+
+		return rowToCol
+	}
+
+	// Индексация с единицы: нулевой столбец - фиктивный, с него начинается поиск увеличивающей цепи
+	u := make([]float64, rows+1)
+	v := make([]float64, cols+1)
+	p := make([]int, cols+1)
+	way := make([]int, cols+1)
+	minv := make([]float64, cols+1)
+	used := make([]bool, cols+1)
+
+	for i := 1; i <= rows; i++ {
+		p[0] = i
+		j0 := 0
+
+		for j := range minv {
+			minv[j] = math.Inf(1)
+			used[j] = false
+		}
+
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+
+			for j := 1; j <= cols; j++ {
+				if used[j] {
+					continue
+				}
+
+				cur := cost[i0-1][j-1] - u[i0] - v[j]
+				if cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+
+			for j := 0; j <= cols; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	rowToCol := newUnassigned(rows)
+	for j := 1; j <= cols; j++ {
+		if p[j] != 0 {
+			rowToCol[p[j]-1] = j - 1
+		}
+	}
+
+	return rowToCol
+}
+
+func transpose(matrix [][]float64) [][]float64 {
+	rows, cols := len(matrix), len(matrix[0])
+
+	transposed := make([][]float64, cols)
+	for j := range transposed {
+		transposed[j] = make([]float64, rows)
+		for i := range rows {
+			transposed[j][i] = matrix[i][j]
+		}
+	}
+
+	return transposed
+}
+
+func newUnassigned(size int) []int {
+	result := make([]int, size)
+	for i := range result {
+		result[i] = -1
+	}
+
+	return result
+}
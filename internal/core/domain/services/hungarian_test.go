@@ -0,0 +1,149 @@
+package services
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolveAssignment_SquareMatrix(t *testing.T) {
+	// Arrange
+	cost := [][]float64{
+		{4, 1, 3},
+		{2, 0, 5},
+		{3, 2, 2},
+	}
+
+	// Act
+	rowToCol := solveAssignment(cost)
+
+	// Assert
+	assert.Equal(t, []int{1, 0, 2}, rowToCol)
+	assert.Equal(t, 5.0, totalCost(cost, rowToCol))
+}
+
+func TestSolveAssignment_MoreColumnsThanRows(t *testing.T) {
+	// Arrange
+	cost := [][]float64{
+		{10, 1, 10, 10},
+		{10, 2, 10, 3},
+	}
+
+	// Act
+	rowToCol := solveAssignment(cost)
+
+	// Assert
+	assert.Equal(t, []int{1, 3}, rowToCol)
+}
+
+func TestSolveAssignment_MoreRowsThanColumns(t *testing.T) {
+	// Arrange
+	cost := [][]float64{
+		{5},
+		{1},
+		{3},
+	}
+
+	// Act
+	rowToCol := solveAssignment(cost)
+
+	// Assert
+	assert.Equal(t, []int{-1, 0, -1}, rowToCol)
+}
+
+func TestSolveAssignment_EmptyMatrix(t *testing.T) {
+	// Act
+	rowToCol := solveAssignment(nil)
+
+	// Assert
+	assert.Empty(t, rowToCol)
+}
+
+func TestSolveAssignment_MatchesBruteForce(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	for range 50 {
+		// Arrange
+		rows, cols := 1+random.IntN(5), 1+random.IntN(5)
+		cost := randomCostMatrix(random, rows, cols)
+
+		// Act
+		rowToCol := solveAssignment(cost)
+
+		// Assert
+		assert.InDelta(t, bruteForceAssignmentCost(cost), totalCost(cost, rowToCol), 1e-9)
+	}
+}
+
+func BenchmarkSolveAssignment_1000x1000(b *testing.B) {
+	random := rand.New(rand.NewPCG(1, 2))
+	cost := randomCostMatrix(random, 1000, 1000)
+
+	b.ResetTimer()
+	for range b.N {
+		solveAssignment(cost)
+	}
+}
+
+// Helper functions
+
+func randomCostMatrix(random *rand.Rand, rows int, cols int) [][]float64 {
+	cost := make([][]float64, rows)
+	for i := range cost {
+		cost[i] = make([]float64, cols)
+		for j := range cost[i] {
+			cost[i][j] = float64(random.IntN(100))
+		}
+	}
+
+	return cost
+}
+
+func totalCost(cost [][]float64, rowToCol []int) float64 {
+	total := 0.0
+	for i, j := range rowToCol {
+		if j >= 0 {
+			total += cost[i][j]
+		}
+	}
+
+	return total
+}
+
+// bruteForceAssignmentCost - перебирает все назначения, в которых занято min(rows, cols) пар
+func bruteForceAssignmentCost(cost [][]float64) float64 {
+	rows, cols := len(cost), len(cost[0])
+	pairs := min(rows, cols)
+	best := math.Inf(1)
+	usedCols := make([]bool, cols)
+
+	var search func(row int, assigned int, total float64)
+	search = func(row int, assigned int, total float64) {
+		if assigned == pairs {
+			best = min(best, total)
+			return
+		}
+		if rows-row < pairs-assigned {
+			return
+		}
+
+		// Строка может остаться без столбца, только если строк больше, чем столбцов
+		if rows > cols {
+			search(row+1, assigned, total)
+		}
+
+		for col := range cols {
+			if usedCols[col] {
+				continue
+			}
+			usedCols[col] = true
+			search(row+1, assigned+1, total+cost[row][col])
+			usedCols[col] = false
+		}
+	}
+	search(0, 0, 0)
+
+	return best
+}
@@ -2,76 +2,179 @@ package services
 
 import (
 	"errors"
-	"math"
+	"time"
 
 	aggCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
 	aggOrder "delivery/internal/core/domain/model/order"
+	kernel "delivery/internal/core/domain/model/shared_kernel"
 	"delivery/internal/pkg/errs"
 )
 
 type Dispatcher interface {
-	Dispatch(order *aggOrder.Order, couriers []*aggCourier.Courier) (*aggCourier.Courier, error)
+	Dispatch(order *aggOrder.Order, couriers []*aggCourier.Courier) (*aggCourier.Courier, *dispatch.Decision, error)
 }
 
 var _ Dispatcher = (*CourierDispatcher)(nil)
+var _ BatchDispatcher = (*CourierDispatcher)(nil)
+
+// CourierDispatcher - назначает заказ курьеру, которого выбирает стратегия.
+// Стратегии достаются только курьеры, способные взять заказ и успеть в окно доставки.
+type CourierDispatcher struct {
+	now         func() time.Time
+	strategy    CourierSelectionStrategy
+	roadNetwork kernel.RoadNetwork
+}
 
-type CourierDispatcher struct{}
+func NewCourierDispatcher(roadNetwork kernel.RoadNetwork) *CourierDispatcher {
+	return NewCourierDispatcherWithStrategy(NewNearestCourierStrategy(), roadNetwork)
+}
 
-func NewCourierDispatcher() *CourierDispatcher {
-	return &CourierDispatcher{}
+func NewCourierDispatcherWithStrategy(strategy CourierSelectionStrategy, roadNetwork kernel.RoadNetwork) *CourierDispatcher {
+	return &CourierDispatcher{now: time.Now, strategy: strategy, roadNetwork: roadNetwork}
 }
 
-func (c *CourierDispatcher) Dispatch(order *aggOrder.Order, couriers []*aggCourier.Courier) (*aggCourier.Courier, error) {
+// Dispatch - назначает заказ курьеру. Решение возвращается и тогда,
+// когда подходящего курьера не нашлось, чтобы было видно, почему.
+func (c *CourierDispatcher) Dispatch(order *aggOrder.Order, couriers []*aggCourier.Courier) (*aggCourier.Courier, *dispatch.Decision, error) {
 	if order == nil {
-		return nil, errs.NewValueIsInvalidErrorWithCause("order", errors.New("impossible to dispatch order without order"))
+		return nil, nil, errs.NewValueIsInvalidErrorWithCause("order", errors.New("impossible to dispatch order without order"))
 	}
 
 	if !aggOrder.StatusCreated.Equals(order.Status()) {
-		return nil, errs.NewValueIsInvalidErrorWithCause("order", errors.New("impossible to dispatch order in status other than created"))
+		return nil, nil, errs.NewValueIsInvalidErrorWithCause("order", errors.New("impossible to dispatch order in status other than created"))
 	}
 
 	if len(couriers) == 0 {
-		return nil, errs.NewValueIsInvalidErrorWithCause("couriers", errors.New("impossible to dispatch order without couriers"))
+		return nil, nil, errs.NewValueIsInvalidErrorWithCause("couriers", errors.New("impossible to dispatch order without couriers"))
 	}
 
-	bestCourier, err := c.selectBestCourier(order, couriers)
+	network := kernel.NewCachedRoadNetwork(c.roadNetwork)
+
+	bestCourier, decision, err := c.selectBestCourier(network, order, couriers)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if bestCourier == nil {
+		return nil, decision, errs.NewValueIsInvalidErrorWithCause("couriers", errors.New("can't find a courier to take the order"))
 	}
 
-	err = bestCourier.TakeOrder(order)
-	if err != nil {
-		return nil, err
+	if err := c.assign(network, order, bestCourier); err != nil {
+		return nil, nil, err
 	}
 
-	err = order.Assign(bestCourier.ID())
-	if err != nil {
-		return nil, err
+	return bestCourier, decision, nil
+}
+
+// DispatchAll - назначает заказы по очереди, каждый - курьеру, которого выбрала стратегия.
+// Заказы, для которых не нашлось подходящего курьера, остаются в статусе Created.
+func (c *CourierDispatcher) DispatchAll(orders []*aggOrder.Order, couriers []*aggCourier.Courier) ([]*aggOrder.Order, []*dispatch.Decision, error) {
+	for _, order := range orders {
+		if order == nil {
+			return nil, nil, errs.NewValueIsInvalidErrorWithCause("orders", errors.New("impossible to dispatch nil order"))
+		}
+
+		if !aggOrder.StatusCreated.Equals(order.Status()) {
+			return nil, nil, errs.NewValueIsInvalidErrorWithCause("orders", errors.New("impossible to dispatch order in status other than created"))
+		}
 	}
 
-	return bestCourier, nil
+	if len(couriers) == 0 {
+		return []*aggOrder.Order{}, []*dispatch.Decision{}, nil
+	}
+
+	// Расстояния от курьеров до заказов за проход считаются один раз
+	network := kernel.NewCachedRoadNetwork(c.roadNetwork)
+
+	assignedOrders := make([]*aggOrder.Order, 0, len(orders))
+	decisions := make([]*dispatch.Decision, 0, len(orders))
+	for _, order := range orders {
+		bestCourier, decision, err := c.selectBestCourier(network, order, couriers)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		decisions = append(decisions, decision)
+		if bestCourier == nil {
+			continue
+		}
+
+		if err := c.assign(network, order, bestCourier); err != nil {
+			return nil, nil, err
+		}
+
+		assignedOrders = append(assignedOrders, order)
+	}
+
+	return assignedOrders, decisions, nil
 }
 
-func (c *CourierDispatcher) selectBestCourier(order *aggOrder.Order, couriers []*aggCourier.Courier) (*aggCourier.Courier, error) {
-	var bestCourier *aggCourier.Courier
-	minTime := math.MaxFloat64
+func (c *CourierDispatcher) assign(network kernel.RoadNetwork, order *aggOrder.Order, courier *aggCourier.Courier) error {
+	if err := courier.TakeOrder(network, order); err != nil {
+		return err
+	}
+
+	return order.Assign(courier.ID())
+}
+
+// selectBestCourier - без ошибки возвращает пустого курьера, если подходящего не нашлось.
+// Решение в этом случае все равно возвращается, чтобы было видно, почему.
+func (c *CourierDispatcher) selectBestCourier(network kernel.RoadNetwork, order *aggOrder.Order, couriers []*aggCourier.Courier) (*aggCourier.Courier, *dispatch.Decision, error) {
+	now := c.now()
 
+	decision, err := dispatch.NewDecision(order.ID(), c.strategy.Name(), now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidates := make([]*aggCourier.Courier, 0, len(couriers))
 	for _, courier := range couriers {
-		if !courier.CanTakeOrder(order) {
+		if courier == nil {
 			continue
 		}
 
-		timeToLocation := courier.CalculateTimeToLocation(order.Location())
+		candidate := evaluateCandidate(network, order, courier, now)
+		decision.AddCandidate(candidate)
 
-		if timeToLocation < minTime {
-			minTime = timeToLocation
-			bestCourier = courier
+		if candidate.IsEligible() {
+			candidates = append(candidates, courier)
 		}
 	}
 
+	var bestCourier *aggCourier.Courier
+	if len(candidates) > 0 {
+		bestCourier = c.strategy.SelectCourier(network, order, candidates)
+	}
+
 	if bestCourier == nil {
-		return nil, errs.NewValueIsInvalidErrorWithCause("couriers", errors.New("can't find a courier to take the order"))
+		return nil, decision, nil
+	}
+
+	if err := decision.Select(bestCourier.ID()); err != nil {
+		return nil, nil, err
+	}
+
+	return bestCourier, decision, nil
+}
+
+// evaluateCandidate - оценивает курьера для заказа и объясняет, почему он не подходит
+func evaluateCandidate(network kernel.RoadNetwork, order *aggOrder.Order, courier *aggCourier.Courier, now time.Time) dispatch.Candidate {
+	// Время до недостижимой точки бесконечно, в решении оно не сохраняется
+	if !courier.CanDeliver(network, order) {
+		return dispatch.NewCandidate(courier.ID(), 0, dispatch.RejectionReasonUnreachable)
+	}
+
+	timeToLocation := courier.CalculateTimeToDeliver(network, order)
+
+	if !courier.CanTakeOrder(order) {
+		return dispatch.NewCandidate(courier.ID(), timeToLocation, dispatch.RejectionReasonNoStoragePlace)
+	}
+
+	// Курьер, который не успевает в окно доставки, заказ не получает
+	arrivalTime := courier.EstimateDeliveryTime(network, order, now)
+	if !order.DeliveryPeriod().CanBeMetAt(arrivalTime) {
+		return dispatch.NewCandidate(courier.ID(), timeToLocation, dispatch.RejectionReasonDeliveryPeriod)
 	}
 
-	return bestCourier, nil
+	return dispatch.NewCandidate(courier.ID(), timeToLocation, dispatch.RejectionReasonNone)
 }
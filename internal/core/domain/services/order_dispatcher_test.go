@@ -3,23 +3,25 @@ package services
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	aggCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
 	aggOrder "delivery/internal/core/domain/model/order"
 	kernel "delivery/internal/core/domain/model/shared_kernel"
 )
 
 func TestCourierDispatcher_ImpossibleToDispatchOrderWithoutCouriers(t *testing.T) {
 	// Arrange
-	dispatcher := NewCourierDispatcher()
+	dispatcher := NewCourierDispatcher(kernel.NewGridRoadNetwork())
 	order := getRandomOrder(t)
 	couriers := []*aggCourier.Courier{}
 
 	// Act
-	_, err := dispatcher.Dispatch(order, couriers)
+	_, _, err := dispatcher.Dispatch(order, couriers)
 
 	// Assert
 	assert.Error(t, err)
@@ -27,11 +29,11 @@ func TestCourierDispatcher_ImpossibleToDispatchOrderWithoutCouriers(t *testing.T
 
 func TestCourierDispatcher_ImpossibleToDispatchMissingOrder(t *testing.T) {
 	// Arrange
-	dispatcher := NewCourierDispatcher()
+	dispatcher := NewCourierDispatcher(kernel.NewGridRoadNetwork())
 	couriers := getRandomCouriers(t)
 
 	// Act
-	_, err := dispatcher.Dispatch(nil, couriers)
+	_, _, err := dispatcher.Dispatch(nil, couriers)
 
 	// Assert
 	assert.Error(t, err)
@@ -39,11 +41,11 @@ func TestCourierDispatcher_ImpossibleToDispatchMissingOrder(t *testing.T) {
 
 func TestCourierDispatcher_ImpossibleToDispatchOrderNotInCreationState(t *testing.T) {
 	// Arrange
-	dispatcher := NewCourierDispatcher()
+	dispatcher := NewCourierDispatcher(kernel.NewGridRoadNetwork())
 	order := getRandomAssignedOrder(t)
 	couriers := getRandomCouriers(t)
 	// Act
-	_, err := dispatcher.Dispatch(order, couriers)
+	_, _, err := dispatcher.Dispatch(order, couriers)
 
 	// Assert
 	assert.Error(t, err)
@@ -51,7 +53,7 @@ func TestCourierDispatcher_ImpossibleToDispatchOrderNotInCreationState(t *testin
 
 func TestCourierDispatcher_SelectTheBestCourier(t *testing.T) {
 	// Arrange
-	dispatcher := NewCourierDispatcher()
+	dispatcher := NewCourierDispatcher(kernel.NewGridRoadNetwork())
 
 	locationForCourierAndOrder, _ := kernel.NewLocation(1, 1)
 	locationForCourierWhichIsFarFromOrder, _ := kernel.NewLocation(5, 5)
@@ -63,7 +65,7 @@ func TestCourierDispatcher_SelectTheBestCourier(t *testing.T) {
 	couriers := []*aggCourier.Courier{courierWhichIsFarFromOrder, expectedCourier}
 
 	// Act
-	assignedCourier, err := dispatcher.Dispatch(order, couriers)
+	assignedCourier, _, err := dispatcher.Dispatch(order, couriers)
 
 	// Assert
 	assert.NoError(t, err)
@@ -73,7 +75,130 @@ func TestCourierDispatcher_SelectTheBestCourier(t *testing.T) {
 	assert.Equal(t, *order.CourierID(), assignedCourier.ID())
 }
 
-func getRandomOrder(t *testing.T) *aggOrder.Order {
+func TestCourierDispatcher_SkipCourierWhoCannotMeetDeliveryPeriod(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	dispatcher := &CourierDispatcher{now: func() time.Time { return now }, strategy: NewNearestCourierStrategy(), roadNetwork: kernel.NewGridRoadNetwork()}
+
+	orderLocation, _ := kernel.NewLocation(10, 10)
+	courierLocation, _ := kernel.NewLocation(1, 1)
+
+	order := getOrderWithLocationAndDeliveryPeriod(t, orderLocation, now, now.Add(5*aggCourier.MoveInterval))
+	slowCourier, _ := aggCourier.NewCourier("slow-courier", 1, courierLocation)
+
+	// Act
+	_, _, err := dispatcher.Dispatch(order, []*aggCourier.Courier{slowCourier})
+
+	// Assert
+	assert.Error(t, err)
+	assert.True(t, order.Status().Equals(aggOrder.StatusCreated))
+	assert.False(t, slowCourier.StoragePlaces()[0].IsOccupied())
+}
+
+func TestCourierDispatcher_SelectCourierWhoCanMeetDeliveryPeriod(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	dispatcher := &CourierDispatcher{now: func() time.Time { return now }, strategy: NewNearestCourierStrategy(), roadNetwork: kernel.NewGridRoadNetwork()}
+
+	orderLocation, _ := kernel.NewLocation(10, 10)
+	courierLocation, _ := kernel.NewLocation(1, 1)
+
+	order := getOrderWithLocationAndDeliveryPeriod(t, orderLocation, now, now.Add(5*aggCourier.MoveInterval))
+	slowCourier, _ := aggCourier.NewCourier("slow-courier", 1, courierLocation)
+	fastCourier, _ := aggCourier.NewCourier("fast-courier", 10, courierLocation)
+
+	// Act
+	assignedCourier, _, err := dispatcher.Dispatch(order, []*aggCourier.Courier{slowCourier, fastCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fastCourier.ID(), assignedCourier.ID())
+	assert.True(t, order.Status().Equals(aggOrder.StatusAssigned))
+}
+
+func TestCourierDispatcher_DecisionExplainsEveryCandidate(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	dispatcher := &CourierDispatcher{now: func() time.Time { return now }, strategy: NewNearestCourierStrategy(), roadNetwork: kernel.NewGridRoadNetwork()}
+
+	orderLocation, _ := kernel.NewLocation(10, 10)
+	courierLocation, _ := kernel.NewLocation(1, 1)
+	nearLocation, _ := kernel.NewLocation(9, 10)
+
+	order := getOrderWithLocationAndDeliveryPeriod(t, orderLocation, now, now.Add(5*aggCourier.MoveInterval))
+	busyCourier := getCourierWithLocation(t, "busy-courier", nearLocation)
+	err := busyCourier.TakeOrder(kernel.NewGridRoadNetwork(), getRandomOrder(t))
+	assert.NoError(t, err)
+	slowCourier, _ := aggCourier.NewCourier("slow-courier", 1, courierLocation)
+	fastCourier, _ := aggCourier.NewCourier("fast-courier", 10, courierLocation)
+	fasterCourier, _ := aggCourier.NewCourier("faster-courier", 20, courierLocation)
+
+	// Act
+	assignedCourier, decision, err := dispatcher.Dispatch(order, []*aggCourier.Courier{busyCourier, slowCourier, fastCourier, fasterCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, order.ID(), decision.OrderID())
+	assert.Equal(t, StrategyNearest, decision.Strategy())
+	assert.Equal(t, now, decision.DecidedAt())
+	assert.Equal(t, assignedCourier.ID(), *decision.CourierID())
+
+	candidates := decision.Candidates()
+	assert.Len(t, candidates, 4)
+	assert.Equal(t, dispatch.RejectionReasonNoStoragePlace, candidates[0].RejectionReason())
+	assert.Equal(t, dispatch.RejectionReasonDeliveryPeriod, candidates[1].RejectionReason())
+	assert.Equal(t, dispatch.RejectionReasonNotSelected, candidates[2].RejectionReason())
+	assert.Equal(t, dispatch.RejectionReasonNone, candidates[3].RejectionReason())
+	assert.Equal(t, fasterCourier.CalculateTimeToLocation(kernel.NewGridRoadNetwork(), orderLocation), candidates[3].TimeToLocation())
+}
+
+func TestCourierDispatcher_ReturnsDecisionWhenNoCourierFound(t *testing.T) {
+	// Arrange
+	location, _ := kernel.NewLocation(1, 1)
+	order := getOrderWithLocation(t, location)
+	busyCourier := getCourierWithLocation(t, "busy-courier", location)
+	err := busyCourier.TakeOrder(kernel.NewGridRoadNetwork(), getRandomOrder(t))
+	assert.NoError(t, err)
+	dispatcher := NewCourierDispatcher(kernel.NewGridRoadNetwork())
+
+	// Act
+	_, decision, err := dispatcher.Dispatch(order, []*aggCourier.Courier{busyCourier})
+
+	// Assert
+	assert.Error(t, err)
+	assert.NotNil(t, decision)
+	assert.Nil(t, decision.CourierID())
+	assert.Equal(t, dispatch.RejectionReasonNoStoragePlace, decision.Candidates()[0].RejectionReason())
+}
+
+func TestCourierDispatcher_SkipCourierWithoutRoadToOrder(t *testing.T) {
+	// Arrange
+	wall := make([]kernel.Location, 0, 10)
+	for y := int64(1); y <= 10; y++ {
+		location, _ := kernel.NewLocation(5, y)
+		wall = append(wall, location)
+	}
+	graph, err := kernel.NewRoadGraph(kernel.DefaultMapBounds(), true, nil, wall)
+	assert.NoError(t, err)
+
+	orderLocation, _ := kernel.NewLocation(10, 10)
+	behindWallLocation, _ := kernel.NewLocation(1, 1)
+	sameSideLocation, _ := kernel.NewLocation(6, 1)
+
+	order := getOrderWithLocation(t, orderLocation)
+	blockedCourier := getCourierWithLocation(t, "blocked-courier", behindWallLocation)
+	reachableCourier := getCourierWithLocation(t, "reachable-courier", sameSideLocation)
+
+	// Act
+	assignedCourier, decision, err := NewCourierDispatcher(graph).Dispatch(order, []*aggCourier.Courier{blockedCourier, reachableCourier})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, reachableCourier.ID(), assignedCourier.ID())
+	assert.Equal(t, dispatch.RejectionReasonUnreachable, decision.Candidates()[0].RejectionReason())
+}
+
+func getRandomOrder(t testing.TB) *aggOrder.Order {
 	t.Helper()
 
 	location, err := kernel.NewRandomLocation()
@@ -114,7 +239,7 @@ func getRandomCouriers(t *testing.T) []*aggCourier.Courier {
 	return couriers
 }
 
-func getRandomCourier(t *testing.T, name string) *aggCourier.Courier {
+func getRandomCourier(t testing.TB, name string) *aggCourier.Courier {
 	t.Helper()
 
 	location, err := kernel.NewRandomLocation()
@@ -151,3 +276,20 @@ func getOrderWithLocation(t *testing.T, location kernel.Location) *aggOrder.Orde
 
 	return order
 }
+
+func getOrderWithLocationAndDeliveryPeriod(t *testing.T, location kernel.Location, from time.Time, to time.Time) *aggOrder.Order {
+	t.Helper()
+
+	order := getOrderWithLocation(t, location)
+
+	deliveryPeriod, err := aggOrder.NewDeliveryPeriod(from, to)
+	if err != nil {
+		t.Fatalf("failed to create delivery period: %v", err)
+	}
+
+	if err := order.SetDeliveryPeriod(deliveryPeriod); err != nil {
+		t.Fatalf("failed to set delivery period: %v", err)
+	}
+
+	return order
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/dispatch"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockery --name DispatchDecisionRepo --with-expecter --exported
+type DispatchDecisionRepo interface {
+	Add(ctx context.Context, decision *dispatch.Decision) error
+	// GetLatestByOrderIDs - последнее решение по каждому заказу, заказы без решений в результат не попадают
+	GetLatestByOrderIDs(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]*dispatch.Decision, error)
+}
@@ -1,9 +1,12 @@
 package ports
 
-import "delivery/internal/core/domain/model/shared_kernel"
+import (
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/shared_kernel"
+)
 
 //go:generate mockery --name=GeoClient --output=mocks --outpkg=mocks
 
 type GeoClient interface {
-	GetGeolocation(street string) (shared_kernel.Location, error)
+	GetGeolocation(address order.Address) (shared_kernel.Location, error)
 }
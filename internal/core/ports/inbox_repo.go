@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/pkg/inbox"
+)
+
+//go:generate mockery --name InboxRepo --with-expecter --exported
+type InboxRepo interface {
+	Add(ctx context.Context, message *inbox.Message) error
+	Exists(ctx context.Context, eventID string) (bool, error)
+}
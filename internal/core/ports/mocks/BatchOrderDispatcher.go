@@ -0,0 +1,107 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	courier "delivery/internal/core/domain/model/courier"
+	dispatch "delivery/internal/core/domain/model/dispatch"
+
+	mock "github.com/stretchr/testify/mock"
+
+	order "delivery/internal/core/domain/model/order"
+)
+
+// BatchOrderDispatcher is an autogenerated mock type for the BatchOrderDispatcher type
+type BatchOrderDispatcher struct {
+	mock.Mock
+}
+
+type BatchOrderDispatcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BatchOrderDispatcher) EXPECT() *BatchOrderDispatcher_Expecter {
+	return &BatchOrderDispatcher_Expecter{mock: &_m.Mock}
+}
+
+// DispatchAll provides a mock function with given fields: orders, couriers
+func (_m *BatchOrderDispatcher) DispatchAll(orders []*order.Order, couriers []*courier.Courier) ([]*order.Order, []*dispatch.Decision, error) {
+	ret := _m.Called(orders, couriers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchAll")
+	}
+
+	var r0 []*order.Order
+	var r1 []*dispatch.Decision
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]*order.Order, []*courier.Courier) ([]*order.Order, []*dispatch.Decision, error)); ok {
+		return rf(orders, couriers)
+	}
+	if rf, ok := ret.Get(0).(func([]*order.Order, []*courier.Courier) []*order.Order); ok {
+		r0 = rf(orders, couriers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*order.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]*order.Order, []*courier.Courier) []*dispatch.Decision); ok {
+		r1 = rf(orders, couriers)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*dispatch.Decision)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func([]*order.Order, []*courier.Courier) error); ok {
+		r2 = rf(orders, couriers)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// BatchOrderDispatcher_DispatchAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchAll'
+type BatchOrderDispatcher_DispatchAll_Call struct {
+	*mock.Call
+}
+
+// DispatchAll is a helper method to define mock.On call
+//   - orders []*order.Order
+//   - couriers []*courier.Courier
+func (_e *BatchOrderDispatcher_Expecter) DispatchAll(orders interface{}, couriers interface{}) *BatchOrderDispatcher_DispatchAll_Call {
+	return &BatchOrderDispatcher_DispatchAll_Call{Call: _e.mock.On("DispatchAll", orders, couriers)}
+}
+
+func (_c *BatchOrderDispatcher_DispatchAll_Call) Run(run func(orders []*order.Order, couriers []*courier.Courier)) *BatchOrderDispatcher_DispatchAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*order.Order), args[1].([]*courier.Courier))
+	})
+	return _c
+}
+
+func (_c *BatchOrderDispatcher_DispatchAll_Call) Return(_a0 []*order.Order, _a1 []*dispatch.Decision, _a2 error) *BatchOrderDispatcher_DispatchAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *BatchOrderDispatcher_DispatchAll_Call) RunAndReturn(run func([]*order.Order, []*courier.Courier) ([]*order.Order, []*dispatch.Decision, error)) *BatchOrderDispatcher_DispatchAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBatchOrderDispatcher creates a new instance of BatchOrderDispatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBatchOrderDispatcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BatchOrderDispatcher {
+	mock := &BatchOrderDispatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
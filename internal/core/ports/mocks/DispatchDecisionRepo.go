@@ -0,0 +1,145 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dispatch "delivery/internal/core/domain/model/dispatch"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DispatchDecisionRepo is an autogenerated mock type for the DispatchDecisionRepo type
+type DispatchDecisionRepo struct {
+	mock.Mock
+}
+
+type DispatchDecisionRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DispatchDecisionRepo) EXPECT() *DispatchDecisionRepo_Expecter {
+	return &DispatchDecisionRepo_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, decision
+func (_m *DispatchDecisionRepo) Add(ctx context.Context, decision *dispatch.Decision) error {
+	ret := _m.Called(ctx, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dispatch.Decision) error); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DispatchDecisionRepo_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type DispatchDecisionRepo_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - decision *dispatch.Decision
+func (_e *DispatchDecisionRepo_Expecter) Add(ctx interface{}, decision interface{}) *DispatchDecisionRepo_Add_Call {
+	return &DispatchDecisionRepo_Add_Call{Call: _e.mock.On("Add", ctx, decision)}
+}
+
+func (_c *DispatchDecisionRepo_Add_Call) Run(run func(ctx context.Context, decision *dispatch.Decision)) *DispatchDecisionRepo_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dispatch.Decision))
+	})
+	return _c
+}
+
+func (_c *DispatchDecisionRepo_Add_Call) Return(_a0 error) *DispatchDecisionRepo_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DispatchDecisionRepo_Add_Call) RunAndReturn(run func(context.Context, *dispatch.Decision) error) *DispatchDecisionRepo_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestByOrderIDs provides a mock function with given fields: ctx, orderIDs
+func (_m *DispatchDecisionRepo) GetLatestByOrderIDs(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]*dispatch.Decision, error) {
+	ret := _m.Called(ctx, orderIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestByOrderIDs")
+	}
+
+	var r0 map[uuid.UUID]*dispatch.Decision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]*dispatch.Decision, error)); ok {
+		return rf(ctx, orderIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]*dispatch.Decision); ok {
+		r0 = rf(ctx, orderIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]*dispatch.Decision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, orderIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DispatchDecisionRepo_GetLatestByOrderIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestByOrderIDs'
+type DispatchDecisionRepo_GetLatestByOrderIDs_Call struct {
+	*mock.Call
+}
+
+// GetLatestByOrderIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderIDs []uuid.UUID
+func (_e *DispatchDecisionRepo_Expecter) GetLatestByOrderIDs(ctx interface{}, orderIDs interface{}) *DispatchDecisionRepo_GetLatestByOrderIDs_Call {
+	return &DispatchDecisionRepo_GetLatestByOrderIDs_Call{Call: _e.mock.On("GetLatestByOrderIDs", ctx, orderIDs)}
+}
+
+func (_c *DispatchDecisionRepo_GetLatestByOrderIDs_Call) Run(run func(ctx context.Context, orderIDs []uuid.UUID)) *DispatchDecisionRepo_GetLatestByOrderIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DispatchDecisionRepo_GetLatestByOrderIDs_Call) Return(_a0 map[uuid.UUID]*dispatch.Decision, _a1 error) *DispatchDecisionRepo_GetLatestByOrderIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DispatchDecisionRepo_GetLatestByOrderIDs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID]*dispatch.Decision, error)) *DispatchDecisionRepo_GetLatestByOrderIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDispatchDecisionRepo creates a new instance of DispatchDecisionRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDispatchDecisionRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DispatchDecisionRepo {
+	mock := &DispatchDecisionRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
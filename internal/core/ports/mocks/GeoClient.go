@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	order "delivery/internal/core/domain/model/order"
+
 	mock "github.com/stretchr/testify/mock"
 
 	shared_kernel "delivery/internal/core/domain/model/shared_kernel"
@@ -13,9 +15,17 @@ type GeoClient struct {
 	mock.Mock
 }
 
-// GetGeolocation provides a mock function with given fields: street
-func (_m *GeoClient) GetGeolocation(street string) (shared_kernel.Location, error) {
-	ret := _m.Called(street)
+type GeoClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GeoClient) EXPECT() *GeoClient_Expecter {
+	return &GeoClient_Expecter{mock: &_m.Mock}
+}
+
+// GetGeolocation provides a mock function with given fields: address
+func (_m *GeoClient) GetGeolocation(address order.Address) (shared_kernel.Location, error) {
+	ret := _m.Called(address)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetGeolocation")
@@ -23,17 +33,17 @@ func (_m *GeoClient) GetGeolocation(street string) (shared_kernel.Location, erro
 
 	var r0 shared_kernel.Location
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (shared_kernel.Location, error)); ok {
-		return rf(street)
+	if rf, ok := ret.Get(0).(func(order.Address) (shared_kernel.Location, error)); ok {
+		return rf(address)
 	}
-	if rf, ok := ret.Get(0).(func(string) shared_kernel.Location); ok {
-		r0 = rf(street)
+	if rf, ok := ret.Get(0).(func(order.Address) shared_kernel.Location); ok {
+		r0 = rf(address)
 	} else {
 		r0 = ret.Get(0).(shared_kernel.Location)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(street)
+	if rf, ok := ret.Get(1).(func(order.Address) error); ok {
+		r1 = rf(address)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,6 +51,34 @@ func (_m *GeoClient) GetGeolocation(street string) (shared_kernel.Location, erro
 	return r0, r1
 }
 
+// GeoClient_GetGeolocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGeolocation'
+type GeoClient_GetGeolocation_Call struct {
+	*mock.Call
+}
+
+// GetGeolocation is a helper method to define mock.On call
+//   - address order.Address
+func (_e *GeoClient_Expecter) GetGeolocation(address interface{}) *GeoClient_GetGeolocation_Call {
+	return &GeoClient_GetGeolocation_Call{Call: _e.mock.On("GetGeolocation", address)}
+}
+
+func (_c *GeoClient_GetGeolocation_Call) Run(run func(address order.Address)) *GeoClient_GetGeolocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(order.Address))
+	})
+	return _c
+}
+
+func (_c *GeoClient_GetGeolocation_Call) Return(_a0 shared_kernel.Location, _a1 error) *GeoClient_GetGeolocation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GeoClient_GetGeolocation_Call) RunAndReturn(run func(order.Address) (shared_kernel.Location, error)) *GeoClient_GetGeolocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewGeoClient creates a new instance of GeoClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGeoClient(t interface {
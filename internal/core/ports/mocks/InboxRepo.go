@@ -0,0 +1,141 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	inbox "delivery/internal/pkg/inbox"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InboxRepo is an autogenerated mock type for the InboxRepo type
+type InboxRepo struct {
+	mock.Mock
+}
+
+type InboxRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InboxRepo) EXPECT() *InboxRepo_Expecter {
+	return &InboxRepo_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, message
+func (_m *InboxRepo) Add(ctx context.Context, message *inbox.Message) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inbox.Message) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InboxRepo_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type InboxRepo_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *inbox.Message
+func (_e *InboxRepo_Expecter) Add(ctx interface{}, message interface{}) *InboxRepo_Add_Call {
+	return &InboxRepo_Add_Call{Call: _e.mock.On("Add", ctx, message)}
+}
+
+func (_c *InboxRepo_Add_Call) Run(run func(ctx context.Context, message *inbox.Message)) *InboxRepo_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*inbox.Message))
+	})
+	return _c
+}
+
+func (_c *InboxRepo_Add_Call) Return(_a0 error) *InboxRepo_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InboxRepo_Add_Call) RunAndReturn(run func(context.Context, *inbox.Message) error) *InboxRepo_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function with given fields: ctx, eventID
+func (_m *InboxRepo) Exists(ctx context.Context, eventID string) (bool, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InboxRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type InboxRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *InboxRepo_Expecter) Exists(ctx interface{}, eventID interface{}) *InboxRepo_Exists_Call {
+	return &InboxRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, eventID)}
+}
+
+func (_c *InboxRepo_Exists_Call) Run(run func(ctx context.Context, eventID string)) *InboxRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InboxRepo_Exists_Call) Return(_a0 bool, _a1 error) *InboxRepo_Exists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InboxRepo_Exists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *InboxRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInboxRepo creates a new instance of InboxRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInboxRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InboxRepo {
+	mock := &InboxRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
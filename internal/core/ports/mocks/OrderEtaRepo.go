@@ -0,0 +1,133 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	eta "delivery/internal/core/domain/model/eta"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// OrderEtaRepo is an autogenerated mock type for the OrderEtaRepo type
+type OrderEtaRepo struct {
+	mock.Mock
+}
+
+type OrderEtaRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderEtaRepo) EXPECT() *OrderEtaRepo_Expecter {
+	return &OrderEtaRepo_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, orderID
+func (_m *OrderEtaRepo) Delete(ctx context.Context, orderID uuid.UUID) error {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderEtaRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type OrderEtaRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+func (_e *OrderEtaRepo_Expecter) Delete(ctx interface{}, orderID interface{}) *OrderEtaRepo_Delete_Call {
+	return &OrderEtaRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, orderID)}
+}
+
+func (_c *OrderEtaRepo_Delete_Call) Run(run func(ctx context.Context, orderID uuid.UUID)) *OrderEtaRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *OrderEtaRepo_Delete_Call) Return(_a0 error) *OrderEtaRepo_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderEtaRepo_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *OrderEtaRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, estimate
+func (_m *OrderEtaRepo) Save(ctx context.Context, estimate *eta.Estimate) error {
+	ret := _m.Called(ctx, estimate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *eta.Estimate) error); ok {
+		r0 = rf(ctx, estimate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderEtaRepo_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type OrderEtaRepo_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - estimate *eta.Estimate
+func (_e *OrderEtaRepo_Expecter) Save(ctx interface{}, estimate interface{}) *OrderEtaRepo_Save_Call {
+	return &OrderEtaRepo_Save_Call{Call: _e.mock.On("Save", ctx, estimate)}
+}
+
+func (_c *OrderEtaRepo_Save_Call) Run(run func(ctx context.Context, estimate *eta.Estimate)) *OrderEtaRepo_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*eta.Estimate))
+	})
+	return _c
+}
+
+func (_c *OrderEtaRepo_Save_Call) Return(_a0 error) *OrderEtaRepo_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderEtaRepo_Save_Call) RunAndReturn(run func(context.Context, *eta.Estimate) error) *OrderEtaRepo_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderEtaRepo creates a new instance of OrderEtaRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderEtaRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderEtaRepo {
+	mock := &OrderEtaRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
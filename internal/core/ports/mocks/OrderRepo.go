@@ -188,6 +188,181 @@ func (_c *OrderRepo_GetAllInAssignedStatus_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetAllInCreatedStatus provides a mock function with given fields: ctx, limit
+func (_m *OrderRepo) GetAllInCreatedStatus(ctx context.Context, limit uint64) ([]*order.Order, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInCreatedStatus")
+	}
+
+	var r0 []*order.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]*order.Order, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*order.Order); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*order.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepo_GetAllInCreatedStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInCreatedStatus'
+type OrderRepo_GetAllInCreatedStatus_Call struct {
+	*mock.Call
+}
+
+// GetAllInCreatedStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit uint64
+func (_e *OrderRepo_Expecter) GetAllInCreatedStatus(ctx interface{}, limit interface{}) *OrderRepo_GetAllInCreatedStatus_Call {
+	return &OrderRepo_GetAllInCreatedStatus_Call{Call: _e.mock.On("GetAllInCreatedStatus", ctx, limit)}
+}
+
+func (_c *OrderRepo_GetAllInCreatedStatus_Call) Run(run func(ctx context.Context, limit uint64)) *OrderRepo_GetAllInCreatedStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *OrderRepo_GetAllInCreatedStatus_Call) Return(_a0 []*order.Order, _a1 error) *OrderRepo_GetAllInCreatedStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepo_GetAllInCreatedStatus_Call) RunAndReturn(run func(context.Context, uint64) ([]*order.Order, error)) *OrderRepo_GetAllInCreatedStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllInDelivery provides a mock function with given fields: ctx
+func (_m *OrderRepo) GetAllInDelivery(ctx context.Context) ([]*order.Order, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInDelivery")
+	}
+
+	var r0 []*order.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*order.Order, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*order.Order); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*order.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepo_GetAllInDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInDelivery'
+type OrderRepo_GetAllInDelivery_Call struct {
+	*mock.Call
+}
+
+// GetAllInDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderRepo_Expecter) GetAllInDelivery(ctx interface{}) *OrderRepo_GetAllInDelivery_Call {
+	return &OrderRepo_GetAllInDelivery_Call{Call: _e.mock.On("GetAllInDelivery", ctx)}
+}
+
+func (_c *OrderRepo_GetAllInDelivery_Call) Run(run func(ctx context.Context)) *OrderRepo_GetAllInDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderRepo_GetAllInDelivery_Call) Return(_a0 []*order.Order, _a1 error) *OrderRepo_GetAllInDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepo_GetAllInDelivery_Call) RunAndReturn(run func(context.Context) ([]*order.Order, error)) *OrderRepo_GetAllInDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllWithDeliveryPeriodNotAtRisk provides a mock function with given fields: ctx
+func (_m *OrderRepo) GetAllWithDeliveryPeriodNotAtRisk(ctx context.Context) ([]*order.Order, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWithDeliveryPeriodNotAtRisk")
+	}
+
+	var r0 []*order.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*order.Order, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*order.Order); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*order.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWithDeliveryPeriodNotAtRisk'
+type OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call struct {
+	*mock.Call
+}
+
+// GetAllWithDeliveryPeriodNotAtRisk is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderRepo_Expecter) GetAllWithDeliveryPeriodNotAtRisk(ctx interface{}) *OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call {
+	return &OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call{Call: _e.mock.On("GetAllWithDeliveryPeriodNotAtRisk", ctx)}
+}
+
+func (_c *OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call) Run(run func(ctx context.Context)) *OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call) Return(_a0 []*order.Order, _a1 error) *OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call) RunAndReturn(run func(context.Context) ([]*order.Order, error)) *OrderRepo_GetAllWithDeliveryPeriodNotAtRisk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFirstInCreatedStatus provides a mock function with given fields: ctx
 func (_m *OrderRepo) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
 	ret := _m.Called(ctx)
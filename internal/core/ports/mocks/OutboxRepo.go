@@ -0,0 +1,250 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	outbox "delivery/internal/pkg/outbox"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// OutboxRepo is an autogenerated mock type for the OutboxRepo type
+type OutboxRepo struct {
+	mock.Mock
+}
+
+type OutboxRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxRepo) EXPECT() *OutboxRepo_Expecter {
+	return &OutboxRepo_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, message
+func (_m *OutboxRepo) Add(ctx context.Context, message *outbox.Message) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *outbox.Message) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxRepo_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type OutboxRepo_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *outbox.Message
+func (_e *OutboxRepo_Expecter) Add(ctx interface{}, message interface{}) *OutboxRepo_Add_Call {
+	return &OutboxRepo_Add_Call{Call: _e.mock.On("Add", ctx, message)}
+}
+
+func (_c *OutboxRepo_Add_Call) Run(run func(ctx context.Context, message *outbox.Message)) *OutboxRepo_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*outbox.Message))
+	})
+	return _c
+}
+
+func (_c *OutboxRepo_Add_Call) Return(_a0 error) *OutboxRepo_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxRepo_Add_Call) RunAndReturn(run func(context.Context, *outbox.Message) error) *OutboxRepo_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProcessedMessages provides a mock function with given fields: ctx, processedBefore, limit
+func (_m *OutboxRepo) DeleteProcessedMessages(ctx context.Context, processedBefore time.Time, limit uint64) (int64, error) {
+	ret := _m.Called(ctx, processedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProcessedMessages")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64) (int64, error)); ok {
+		return rf(ctx, processedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64) int64); ok {
+		r0 = rf(ctx, processedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint64) error); ok {
+		r1 = rf(ctx, processedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxRepo_DeleteProcessedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProcessedMessages'
+type OutboxRepo_DeleteProcessedMessages_Call struct {
+	*mock.Call
+}
+
+// DeleteProcessedMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - processedBefore time.Time
+//   - limit uint64
+func (_e *OutboxRepo_Expecter) DeleteProcessedMessages(ctx interface{}, processedBefore interface{}, limit interface{}) *OutboxRepo_DeleteProcessedMessages_Call {
+	return &OutboxRepo_DeleteProcessedMessages_Call{Call: _e.mock.On("DeleteProcessedMessages", ctx, processedBefore, limit)}
+}
+
+func (_c *OutboxRepo_DeleteProcessedMessages_Call) Run(run func(ctx context.Context, processedBefore time.Time, limit uint64)) *OutboxRepo_DeleteProcessedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *OutboxRepo_DeleteProcessedMessages_Call) Return(_a0 int64, _a1 error) *OutboxRepo_DeleteProcessedMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxRepo_DeleteProcessedMessages_Call) RunAndReturn(run func(context.Context, time.Time, uint64) (int64, error)) *OutboxRepo_DeleteProcessedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotProcessedMessages provides a mock function with given fields: ctx, limit
+func (_m *OutboxRepo) GetNotProcessedMessages(ctx context.Context, limit uint64) ([]*outbox.Message, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotProcessedMessages")
+	}
+
+	var r0 []*outbox.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]*outbox.Message, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*outbox.Message); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*outbox.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxRepo_GetNotProcessedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotProcessedMessages'
+type OutboxRepo_GetNotProcessedMessages_Call struct {
+	*mock.Call
+}
+
+// GetNotProcessedMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit uint64
+func (_e *OutboxRepo_Expecter) GetNotProcessedMessages(ctx interface{}, limit interface{}) *OutboxRepo_GetNotProcessedMessages_Call {
+	return &OutboxRepo_GetNotProcessedMessages_Call{Call: _e.mock.On("GetNotProcessedMessages", ctx, limit)}
+}
+
+func (_c *OutboxRepo_GetNotProcessedMessages_Call) Run(run func(ctx context.Context, limit uint64)) *OutboxRepo_GetNotProcessedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *OutboxRepo_GetNotProcessedMessages_Call) Return(_a0 []*outbox.Message, _a1 error) *OutboxRepo_GetNotProcessedMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxRepo_GetNotProcessedMessages_Call) RunAndReturn(run func(context.Context, uint64) ([]*outbox.Message, error)) *OutboxRepo_GetNotProcessedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, message
+func (_m *OutboxRepo) Update(ctx context.Context, message *outbox.Message) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *outbox.Message) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type OutboxRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *outbox.Message
+func (_e *OutboxRepo_Expecter) Update(ctx interface{}, message interface{}) *OutboxRepo_Update_Call {
+	return &OutboxRepo_Update_Call{Call: _e.mock.On("Update", ctx, message)}
+}
+
+func (_c *OutboxRepo_Update_Call) Run(run func(ctx context.Context, message *outbox.Message)) *OutboxRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*outbox.Message))
+	})
+	return _c
+}
+
+func (_c *OutboxRepo_Update_Call) Return(_a0 error) *OutboxRepo_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxRepo_Update_Call) RunAndReturn(run func(context.Context, *outbox.Message) error) *OutboxRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxRepo creates a new instance of OutboxRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepo {
+	mock := &OutboxRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
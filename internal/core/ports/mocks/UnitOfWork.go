@@ -120,6 +120,53 @@ func (_c *UnitOfWork_DefaultTrOrDB_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DispatchDecisionRepo provides a mock function with no fields
+func (_m *UnitOfWork) DispatchDecisionRepo() ports.DispatchDecisionRepo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchDecisionRepo")
+	}
+
+	var r0 ports.DispatchDecisionRepo
+	if rf, ok := ret.Get(0).(func() ports.DispatchDecisionRepo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ports.DispatchDecisionRepo)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_DispatchDecisionRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchDecisionRepo'
+type UnitOfWork_DispatchDecisionRepo_Call struct {
+	*mock.Call
+}
+
+// DispatchDecisionRepo is a helper method to define mock.On call
+func (_e *UnitOfWork_Expecter) DispatchDecisionRepo() *UnitOfWork_DispatchDecisionRepo_Call {
+	return &UnitOfWork_DispatchDecisionRepo_Call{Call: _e.mock.On("DispatchDecisionRepo")}
+}
+
+func (_c *UnitOfWork_DispatchDecisionRepo_Call) Run(run func()) *UnitOfWork_DispatchDecisionRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_DispatchDecisionRepo_Call) Return(_a0 ports.DispatchDecisionRepo) *UnitOfWork_DispatchDecisionRepo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_DispatchDecisionRepo_Call) RunAndReturn(run func() ports.DispatchDecisionRepo) *UnitOfWork_DispatchDecisionRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Do provides a mock function with given fields: ctx, fn
 func (_m *UnitOfWork) Do(ctx context.Context, fn func(context.Context) error) error {
 	ret := _m.Called(ctx, fn)
@@ -167,6 +214,100 @@ func (_c *UnitOfWork_Do_Call) RunAndReturn(run func(context.Context, func(contex
 	return _c
 }
 
+// InboxRepo provides a mock function with no fields
+func (_m *UnitOfWork) InboxRepo() ports.InboxRepo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InboxRepo")
+	}
+
+	var r0 ports.InboxRepo
+	if rf, ok := ret.Get(0).(func() ports.InboxRepo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ports.InboxRepo)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_InboxRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InboxRepo'
+type UnitOfWork_InboxRepo_Call struct {
+	*mock.Call
+}
+
+// InboxRepo is a helper method to define mock.On call
+func (_e *UnitOfWork_Expecter) InboxRepo() *UnitOfWork_InboxRepo_Call {
+	return &UnitOfWork_InboxRepo_Call{Call: _e.mock.On("InboxRepo")}
+}
+
+func (_c *UnitOfWork_InboxRepo_Call) Run(run func()) *UnitOfWork_InboxRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_InboxRepo_Call) Return(_a0 ports.InboxRepo) *UnitOfWork_InboxRepo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_InboxRepo_Call) RunAndReturn(run func() ports.InboxRepo) *UnitOfWork_InboxRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrderEtaRepo provides a mock function with no fields
+func (_m *UnitOfWork) OrderEtaRepo() ports.OrderEtaRepo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderEtaRepo")
+	}
+
+	var r0 ports.OrderEtaRepo
+	if rf, ok := ret.Get(0).(func() ports.OrderEtaRepo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ports.OrderEtaRepo)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_OrderEtaRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderEtaRepo'
+type UnitOfWork_OrderEtaRepo_Call struct {
+	*mock.Call
+}
+
+// OrderEtaRepo is a helper method to define mock.On call
+func (_e *UnitOfWork_Expecter) OrderEtaRepo() *UnitOfWork_OrderEtaRepo_Call {
+	return &UnitOfWork_OrderEtaRepo_Call{Call: _e.mock.On("OrderEtaRepo")}
+}
+
+func (_c *UnitOfWork_OrderEtaRepo_Call) Run(run func()) *UnitOfWork_OrderEtaRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_OrderEtaRepo_Call) Return(_a0 ports.OrderEtaRepo) *UnitOfWork_OrderEtaRepo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_OrderEtaRepo_Call) RunAndReturn(run func() ports.OrderEtaRepo) *UnitOfWork_OrderEtaRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OrderRepo provides a mock function with no fields
 func (_m *UnitOfWork) OrderRepo() ports.OrderRepo {
 	ret := _m.Called()
@@ -214,6 +355,53 @@ func (_c *UnitOfWork_OrderRepo_Call) RunAndReturn(run func() ports.OrderRepo) *U
 	return _c
 }
 
+// OutboxRepo provides a mock function with no fields
+func (_m *UnitOfWork) OutboxRepo() ports.OutboxRepo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OutboxRepo")
+	}
+
+	var r0 ports.OutboxRepo
+	if rf, ok := ret.Get(0).(func() ports.OutboxRepo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ports.OutboxRepo)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_OutboxRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OutboxRepo'
+type UnitOfWork_OutboxRepo_Call struct {
+	*mock.Call
+}
+
+// OutboxRepo is a helper method to define mock.On call
+func (_e *UnitOfWork_Expecter) OutboxRepo() *UnitOfWork_OutboxRepo_Call {
+	return &UnitOfWork_OutboxRepo_Call{Call: _e.mock.On("OutboxRepo")}
+}
+
+func (_c *UnitOfWork_OutboxRepo_Call) Run(run func()) *UnitOfWork_OutboxRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_OutboxRepo_Call) Return(_a0 ports.OutboxRepo) *UnitOfWork_OutboxRepo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_OutboxRepo_Call) RunAndReturn(run func() ports.OutboxRepo) *UnitOfWork_OutboxRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUnitOfWork creates a new instance of UnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUnitOfWork(t interface {
@@ -2,10 +2,11 @@ package ports
 
 import (
 	aggCourier "delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/dispatch"
 	aggOrder "delivery/internal/core/domain/model/order"
 )
 
-//go:generate mockery --name OrderDispatcher --with-expecter --exported
-type OrderDispatcher interface {
-	Dispatch(order *aggOrder.Order, couriers []*aggCourier.Courier) (*aggCourier.Courier, error)
+//go:generate mockery --name BatchOrderDispatcher --with-expecter --exported
+type BatchOrderDispatcher interface {
+	DispatchAll(orders []*aggOrder.Order, couriers []*aggCourier.Courier) ([]*aggOrder.Order, []*dispatch.Decision, error)
 }
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/eta"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockery --name OrderEtaRepo --with-expecter --exported
+type OrderEtaRepo interface {
+	Save(ctx context.Context, estimate *eta.Estimate) error
+	Delete(ctx context.Context, orderID uuid.UUID) error
+}
@@ -14,5 +14,9 @@ type OrderRepo interface {
 	Update(ctx context.Context, order *modelOrder.Order) error
 	Get(ctx context.Context, id uuid.UUID) (*modelOrder.Order, error)
 	GetFirstInCreatedStatus(ctx context.Context) (*modelOrder.Order, error)
+	GetAllInCreatedStatus(ctx context.Context, limit uint64) ([]*modelOrder.Order, error)
 	GetAllInAssignedStatus(ctx context.Context) ([]*modelOrder.Order, error)
+	// GetAllInDelivery - заказы, которые сейчас у курьеров: назначенные и уже забранные
+	GetAllInDelivery(ctx context.Context) ([]*modelOrder.Order, error)
+	GetAllWithDeliveryPeriodNotAtRisk(ctx context.Context) ([]*modelOrder.Order, error)
 }
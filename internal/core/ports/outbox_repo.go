@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/pkg/outbox"
+)
+
+//go:generate mockery --name OutboxRepo --with-expecter --exported
+type OutboxRepo interface {
+	Add(ctx context.Context, message *outbox.Message) error
+	Update(ctx context.Context, message *outbox.Message) error
+	GetNotProcessedMessages(ctx context.Context, limit uint64) ([]*outbox.Message, error)
+	DeleteProcessedMessages(ctx context.Context, processedBefore time.Time, limit uint64) (int64, error)
+}
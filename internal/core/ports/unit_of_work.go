@@ -12,4 +12,8 @@ type UnitOfWork interface {
 	DefaultTrOrDB(ctx context.Context, db trmsqlx.Tr) trmsqlx.Tr
 	OrderRepo() OrderRepo
 	CourierRepo() CourierRepo
+	OutboxRepo() OutboxRepo
+	InboxRepo() InboxRepo
+	DispatchDecisionRepo() DispatchDecisionRepo
+	OrderEtaRepo() OrderEtaRepo
 }
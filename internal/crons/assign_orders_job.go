@@ -4,7 +4,7 @@ import (
 	"context"
 	"log"
 
-	"delivery/internal/core/application/usecases/commands/assign_order"
+	"delivery/internal/core/application/usecases/commands/batch_assign_orders"
 	"delivery/internal/pkg/errs"
 
 	"github.com/robfig/cron/v3"
@@ -13,24 +13,24 @@ import (
 var _ cron.Job = &AssignOrdersJob{}
 
 type AssignOrdersJob struct {
-	assignOrderCommandHandler assign_order.AssignedOrderHandler
+	assignOrdersCommandHandler batch_assign_orders.BatchAssignOrdersHandler
 }
 
 func NewAssignOrdersJob(
-	assignOrderCommandHandler assign_order.AssignedOrderHandler) (cron.Job, error) {
-	if assignOrderCommandHandler == nil {
-		return nil, errs.NewValueIsRequiredError("assignOrderCommandHandler")
+	assignOrdersCommandHandler batch_assign_orders.BatchAssignOrdersHandler) (cron.Job, error) {
+	if assignOrdersCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("assignOrdersCommandHandler")
 	}
 
 	return &AssignOrdersJob{
-		assignOrderCommandHandler: assignOrderCommandHandler}, nil
+		assignOrdersCommandHandler: assignOrdersCommandHandler}, nil
 }
 
 func (j *AssignOrdersJob) Run() {
 	ctx := context.Background()
-	command := assign_order.NewAssignedOrderCommand()
+	command := batch_assign_orders.NewBatchAssignOrdersCommand()
 
-	err := j.assignOrderCommandHandler.Handle(ctx, command)
+	err := j.assignOrdersCommandHandler.Handle(ctx, command)
 	if err != nil {
 		log.Printf("AssignOrdersJob error: %v", err)
 	}
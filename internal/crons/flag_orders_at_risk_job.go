@@ -0,0 +1,37 @@
+package crons
+
+import (
+	"context"
+	"log"
+
+	"delivery/internal/core/application/usecases/commands/flag_orders_at_risk"
+	"delivery/internal/pkg/errs"
+
+	"github.com/robfig/cron/v3"
+)
+
+var _ cron.Job = &FlagOrdersAtRiskJob{}
+
+type FlagOrdersAtRiskJob struct {
+	flagOrdersAtRiskCommandHandler flag_orders_at_risk.FlagOrdersAtRiskHandler
+}
+
+func NewFlagOrdersAtRiskJob(
+	flagOrdersAtRiskCommandHandler flag_orders_at_risk.FlagOrdersAtRiskHandler) (cron.Job, error) {
+	if flagOrdersAtRiskCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("flagOrdersAtRiskCommandHandler")
+	}
+
+	return &FlagOrdersAtRiskJob{
+		flagOrdersAtRiskCommandHandler: flagOrdersAtRiskCommandHandler}, nil
+}
+
+func (j *FlagOrdersAtRiskJob) Run() {
+	ctx := context.Background()
+	command := flag_orders_at_risk.NewFlagOrdersAtRiskCommand()
+
+	err := j.flagOrdersAtRiskCommandHandler.Handle(ctx, command)
+	if err != nil {
+		log.Printf("FlagOrdersAtRiskJob error: %v", err)
+	}
+}
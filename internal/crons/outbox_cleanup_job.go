@@ -0,0 +1,52 @@
+package crons
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	outboxRetention        = 24 * time.Hour
+	outboxCleanupBatchSize = 1000
+)
+
+var _ cron.Job = &OutboxCleanupJob{}
+
+// OutboxCleanupJob - удаляет из outbox сообщения, обработанные больше outboxRetention назад.
+// Отложенные сообщения не удаляются, их разбирают вручную.
+type OutboxCleanupJob struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewOutboxCleanupJob(uowFactory ports.UnitOfWorkFactory) (cron.Job, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsRequiredError("uowFactory")
+	}
+
+	return &OutboxCleanupJob{
+		uowFactory: uowFactory}, nil
+}
+
+func (j *OutboxCleanupJob) Run() {
+	ctx := context.Background()
+	processedBefore := time.Now().UTC().Add(-outboxRetention)
+
+	// Удаляем пачками, чтобы не держать долгую блокировку на большой таблице
+	for {
+		deleted, err := j.uowFactory.NewUOW().OutboxRepo().DeleteProcessedMessages(ctx, processedBefore, outboxCleanupBatchSize)
+		if err != nil {
+			log.Printf("OutboxCleanupJob error: %v", err)
+			return
+		}
+
+		if deleted < outboxCleanupBatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package crons
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	eventPublisher "delivery/internal/pkg/event_publisher"
+	"delivery/internal/pkg/outbox"
+	"delivery/internal/pkg/retry"
+
+	"github.com/robfig/cron/v3"
+)
+
+const outboxBatchSize = 100
+
+var _ cron.Job = &OutboxJob{}
+
+// OutboxJob - отправляет доменные события, сохраненные в outbox, и помечает их обработанными.
+// Сообщения, которые не удалось отправить, повторяются с растущей паузой, а после retryPolicy.MaxAttempts
+// неудач откладываются (failed_at_utc), чтобы не блокировать остальные.
+// Обработчики, подписанные через SubscribeAfterCommit, вызываются после фиксации пачки.
+type OutboxJob struct {
+	uowFactory     ports.UnitOfWorkFactory
+	eventRegistry  outbox.EventRegistry
+	eventPublisher eventPublisher.EventPublisher
+	retryPolicy    retry.Policy
+}
+
+func NewOutboxJob(
+	uowFactory ports.UnitOfWorkFactory,
+	eventRegistry outbox.EventRegistry,
+	eventPublisher eventPublisher.EventPublisher) (cron.Job, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsRequiredError("uowFactory")
+	}
+	if eventRegistry == nil {
+		return nil, errs.NewValueIsRequiredError("eventRegistry")
+	}
+	if eventPublisher == nil {
+		return nil, errs.NewValueIsRequiredError("eventPublisher")
+	}
+
+	return &OutboxJob{
+		uowFactory:     uowFactory,
+		eventRegistry:  eventRegistry,
+		eventPublisher: eventPublisher,
+		retryPolicy:    outbox.DefaultRetryPolicy()}, nil
+}
+
+func (j *OutboxJob) Run() {
+	ctx := context.Background()
+
+	err := j.processMessages(ctx)
+	if err != nil {
+		log.Printf("OutboxJob error: %v", err)
+	}
+}
+
+func (j *OutboxJob) processMessages(ctx context.Context) error {
+	uow := j.uowFactory.NewUOW()
+	txCtx, runAfterCommit := eventPublisher.WithAfterCommit(ctx)
+
+	err := uow.Do(txCtx, func(ctx context.Context) error {
+		messages, uowErr := uow.OutboxRepo().GetNotProcessedMessages(ctx, outboxBatchSize)
+		if uowErr != nil {
+			return uowErr
+		}
+
+		for _, message := range messages {
+			now := time.Now().UTC()
+
+			// Пока первое сообщение ждет повтора, остальные тоже ждут, чтобы не нарушить порядок событий
+			if !message.ReadyToSend(now) {
+				return nil
+			}
+
+			domainEvent, err := j.eventRegistry.DecodeDomainEvent(message)
+			if err != nil {
+				// Повтор не поможет раскодировать сообщение, поэтому оно сразу откладывается
+				log.Printf("OutboxJob: parking message %s (%s) that cannot be decoded: %v", message.ID, message.Name, err)
+				message.Park(err, now)
+			} else if err := j.eventPublisher.Publish(ctx, domainEvent); err != nil {
+				message.MarkAsFailed(err, j.retryPolicy, now)
+				log.Printf("OutboxJob: failed to publish message %s (%s), attempt %d: %v", message.ID, message.Name, message.Attempts, err)
+			} else {
+				message.MarkAsProcessed()
+			}
+
+			if uowErr := uow.OutboxRepo().Update(ctx, message); uowErr != nil {
+				return uowErr
+			}
+
+			// При ошибке прерываем пачку. Уже отправленные сообщения и счетчик попыток фиксируются.
+			if message.ProcessedAtUtc == nil && !message.IsParked() {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	runAfterCommit(ctx)
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package crons
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/event"
+	"delivery/internal/core/ports/mocks"
+	"delivery/internal/pkg/ddd"
+	eventPublisher "delivery/internal/pkg/event_publisher"
+	"delivery/internal/pkg/outbox"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeEventPublisher struct {
+	published []ddd.DomainEvent
+	failOn    map[uuid.UUID]error
+}
+
+func (f *fakeEventPublisher) Publish(_ context.Context, domainEvent ddd.DomainEvent) error {
+	if err, ok := f.failOn[domainEvent.GetID()]; ok {
+		return err
+	}
+
+	f.published = append(f.published, domainEvent)
+	return nil
+}
+
+func TestOutboxJob_Run_PublishesAndMarksMessagesAsProcessed(t *testing.T) {
+	// Arrange
+	messages := newOutboxMessages(t, 2)
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.EXPECT().GetNotProcessedMessages(mock.Anything, uint64(outboxBatchSize)).Return(messages, nil)
+	mockOutboxRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Times(2)
+	publisher := &fakeEventPublisher{}
+	job := newOutboxJob(t, mockOutboxRepo, publisher)
+
+	// Act
+	job.Run()
+
+	// Assert
+	assert.Len(t, publisher.published, 2)
+	assert.NotNil(t, messages[0].ProcessedAtUtc)
+	assert.NotNil(t, messages[1].ProcessedAtUtc)
+}
+
+func TestOutboxJob_Run_StopsOnPublishErrorAndLeavesMessageForRetry(t *testing.T) {
+	// Arrange
+	messages := newOutboxMessages(t, 3)
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.EXPECT().GetNotProcessedMessages(mock.Anything, uint64(outboxBatchSize)).Return(messages, nil)
+	mockOutboxRepo.EXPECT().Update(mock.Anything, messages[0]).Return(nil).Once()
+	mockOutboxRepo.EXPECT().Update(mock.Anything, messages[1]).Return(nil).Once()
+	publisher := &fakeEventPublisher{failOn: map[uuid.UUID]error{messages[1].ID: errors.New("kafka is unavailable")}}
+	job := newOutboxJob(t, mockOutboxRepo, publisher)
+
+	// Act
+	job.Run()
+
+	// Assert
+	assert.Len(t, publisher.published, 1)
+	assert.NotNil(t, messages[0].ProcessedAtUtc)
+	assert.Nil(t, messages[1].ProcessedAtUtc)
+	assert.Equal(t, 1, messages[1].Attempts)
+	assert.Equal(t, "kafka is unavailable", messages[1].LastError)
+	assert.NotNil(t, messages[1].NextAttemptAtUtc)
+	assert.False(t, messages[1].IsParked())
+	assert.Nil(t, messages[2].ProcessedAtUtc)
+}
+
+func TestOutboxJob_Run_ParksMessageAfterMaxAttemptsAndContinuesBatch(t *testing.T) {
+	// Arrange
+	messages := newOutboxMessages(t, 2)
+	messages[0].Attempts = outbox.DefaultRetryPolicy().MaxAttempts - 1
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.EXPECT().GetNotProcessedMessages(mock.Anything, uint64(outboxBatchSize)).Return(messages, nil)
+	mockOutboxRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Times(2)
+	publisher := &fakeEventPublisher{failOn: map[uuid.UUID]error{messages[0].ID: errors.New("bad payload")}}
+	job := newOutboxJob(t, mockOutboxRepo, publisher)
+
+	// Act
+	job.Run()
+
+	// Assert
+	assert.True(t, messages[0].IsParked())
+	assert.Nil(t, messages[0].ProcessedAtUtc)
+	assert.Len(t, publisher.published, 1)
+	assert.NotNil(t, messages[1].ProcessedAtUtc)
+}
+
+func TestOutboxJob_Run_ParksUndecodableMessageImmediately(t *testing.T) {
+	// Arrange
+	messages := newOutboxMessages(t, 2)
+	messages[0].Name = "UnknownEvent"
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.EXPECT().GetNotProcessedMessages(mock.Anything, uint64(outboxBatchSize)).Return(messages, nil)
+	mockOutboxRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Times(2)
+	publisher := &fakeEventPublisher{}
+	job := newOutboxJob(t, mockOutboxRepo, publisher)
+
+	// Act
+	job.Run()
+
+	// Assert
+	assert.True(t, messages[0].IsParked())
+	assert.Equal(t, 0, messages[0].Attempts)
+	assert.Len(t, publisher.published, 1)
+	assert.NotNil(t, messages[1].ProcessedAtUtc)
+}
+
+func TestOutboxJob_Run_WaitsForBackoffOfFirstMessage(t *testing.T) {
+	// Arrange
+	messages := newOutboxMessages(t, 2)
+	nextAttemptAt := time.Now().UTC().Add(time.Minute)
+	messages[0].Attempts = 1
+	messages[0].NextAttemptAtUtc = &nextAttemptAt
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.EXPECT().GetNotProcessedMessages(mock.Anything, uint64(outboxBatchSize)).Return(messages, nil)
+	publisher := &fakeEventPublisher{}
+	job := newOutboxJob(t, mockOutboxRepo, publisher)
+
+	// Act
+	job.Run()
+
+	// Assert
+	assert.Empty(t, publisher.published)
+	assert.Nil(t, messages[1].ProcessedAtUtc)
+}
+
+func TestOutboxJob_Run_DoesNotPublishWhenMessagesCannotBeLoaded(t *testing.T) {
+	// Arrange
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.EXPECT().GetNotProcessedMessages(mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+	publisher := &fakeEventPublisher{}
+	job := newOutboxJob(t, mockOutboxRepo, publisher)
+
+	// Act
+	job.Run()
+
+	// Assert
+	assert.Empty(t, publisher.published)
+}
+
+func TestOutboxJob_Run_RunsAfterCommitHandlersOnlyAfterTransaction(t *testing.T) {
+	// Arrange
+	messages := newOutboxMessages(t, 1)
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.EXPECT().GetNotProcessedMessages(mock.Anything, uint64(outboxBatchSize)).Return(messages, nil)
+	mockOutboxRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+
+	var calls []string
+	handler := &afterCommitHandler{calls: &calls}
+	dispatcher := eventPublisher.NewEventDispatcher()
+	_ = eventPublisher.SubscribeAfterCommit[*event.OrderCreated](dispatcher, handler)
+
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().OutboxRepo().Return(mockOutboxRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		err := fn(ctx)
+		calls = append(calls, "commit")
+		return err
+	})
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(mockUoW)
+	job, _ := NewOutboxJob(mockUoWFactory, newEventRegistry(t), dispatcher)
+
+	// Act
+	job.Run()
+
+	// Assert
+	assert.Equal(t, []string{"commit", "handled"}, calls)
+}
+
+// Helper functions
+
+type afterCommitHandler struct {
+	calls *[]string
+}
+
+func (h *afterCommitHandler) Handle(_ context.Context, _ *event.OrderCreated) error {
+	*h.calls = append(*h.calls, "handled")
+	return nil
+}
+
+func newEventRegistry(t *testing.T) outbox.EventRegistry {
+	t.Helper()
+
+	registry, err := outbox.NewEventRegistry()
+	if err != nil {
+		t.Fatalf("failed to create event registry: %v", err)
+	}
+	if err := registry.RegisterDomainEvent(reflect.TypeOf(&event.OrderCreated{})); err != nil {
+		t.Fatalf("failed to register event: %v", err)
+	}
+
+	return registry
+}
+
+func newOutboxJob(t *testing.T, outboxRepo *mocks.OutboxRepo, publisher *fakeEventPublisher) *OutboxJob {
+	t.Helper()
+
+	mockUoW := mocks.NewUnitOfWork(t)
+	mockUoW.EXPECT().OutboxRepo().Return(outboxRepo)
+	mockUoW.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	mockUoWFactory := mocks.NewUnitOfWorkFactory(t)
+	mockUoWFactory.EXPECT().NewUOW().Return(mockUoW)
+
+	job, err := NewOutboxJob(mockUoWFactory, newEventRegistry(t), publisher)
+	if err != nil {
+		t.Fatalf("failed to create outbox job: %v", err)
+	}
+
+	return job.(*OutboxJob)
+}
+
+func newOutboxMessages(t *testing.T, count int) []*outbox.Message {
+	t.Helper()
+
+	messages := make([]*outbox.Message, 0, count)
+	for i := 0; i < count; i++ {
+		message, err := outbox.EncodeDomainEvent(event.NewOrderCreated(uuid.New()))
+		if err != nil {
+			t.Fatalf("failed to encode event: %v", err)
+		}
+		messages = append(messages, &message)
+	}
+
+	return messages
+}
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: configs/couriers_events.proto
+
+package courierpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int64                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int64                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_configs_couriers_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_couriers_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_configs_couriers_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Location) GetX() int64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Location) GetY() int64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type CourierLocationChangedIntegrationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Payload
+	CourierId     string    `protobuf:"bytes,4,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Location      *Location `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourierLocationChangedIntegrationEvent) Reset() {
+	*x = CourierLocationChangedIntegrationEvent{}
+	mi := &file_configs_couriers_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourierLocationChangedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourierLocationChangedIntegrationEvent) ProtoMessage() {}
+
+func (x *CourierLocationChangedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_couriers_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourierLocationChangedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*CourierLocationChangedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_configs_couriers_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CourierLocationChangedIntegrationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CourierLocationChangedIntegrationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *CourierLocationChangedIntegrationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *CourierLocationChangedIntegrationEvent) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *CourierLocationChangedIntegrationEvent) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+var File_configs_couriers_events_proto protoreflect.FileDescriptor
+
+const file_configs_couriers_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1dconfigs/couriers_events.proto\x12\rcourier_event\x1a\x1fgoogle/protobuf/timestamp.proto\"&\n" +
+	"\bLocation\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x03R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x03R\x01y\"\xf3\x01\n" +
+	"&CourierLocationChangedIntegrationEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x04 \x01(\tR\tcourierId\x123\n" +
+	"\blocation\x18\x05 \x01(\v2\x17.courier_event.LocationR\blocationBG\n" +
+	"\x0equeues.courierB\x12CourierEventsProtoZ\x10queues/courierpb\xaa\x02\x0eQueues.Courierb\x06proto3"
+
+var (
+	file_configs_couriers_events_proto_rawDescOnce sync.Once
+	file_configs_couriers_events_proto_rawDescData []byte
+)
+
+func file_configs_couriers_events_proto_rawDescGZIP() []byte {
+	file_configs_couriers_events_proto_rawDescOnce.Do(func() {
+		file_configs_couriers_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_configs_couriers_events_proto_rawDesc), len(file_configs_couriers_events_proto_rawDesc)))
+	})
+	return file_configs_couriers_events_proto_rawDescData
+}
+
+var file_configs_couriers_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_configs_couriers_events_proto_goTypes = []any{
+	(*Location)(nil), // 0: courier_event.Location
+	(*CourierLocationChangedIntegrationEvent)(nil), // 1: courier_event.CourierLocationChangedIntegrationEvent
+	(*timestamppb.Timestamp)(nil),                  // 2: google.protobuf.Timestamp
+}
+var file_configs_couriers_events_proto_depIdxs = []int32{
+	2, // 0: courier_event.CourierLocationChangedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	0, // 1: courier_event.CourierLocationChangedIntegrationEvent.location:type_name -> courier_event.Location
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_configs_couriers_events_proto_init() }
+func file_configs_couriers_events_proto_init() {
+	if File_configs_couriers_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_configs_couriers_events_proto_rawDesc), len(file_configs_couriers_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_configs_couriers_events_proto_goTypes,
+		DependencyIndexes: file_configs_couriers_events_proto_depIdxs,
+		MessageInfos:      file_configs_couriers_events_proto_msgTypes,
+	}.Build()
+	File_configs_couriers_events_proto = out.File
+	file_configs_couriers_events_proto_goTypes = nil
+	file_configs_couriers_events_proto_depIdxs = nil
+}
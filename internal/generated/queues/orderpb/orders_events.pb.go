@@ -7,13 +7,12 @@
 package orderpb
 
 import (
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -171,6 +170,84 @@ func (x *OrderCompletedIntegrationEvent) GetCourierId() string {
 	return ""
 }
 
+type OrderAssignedIntegrationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Payload
+	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId     string `protobuf:"bytes,5,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAssignedIntegrationEvent) Reset() {
+	*x = OrderAssignedIntegrationEvent{}
+	mi := &file_configs_orders_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAssignedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAssignedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderAssignedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_configs_orders_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAssignedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderAssignedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_configs_orders_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderAssignedIntegrationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderAssignedIntegrationEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderAssignedIntegrationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderAssignedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderAssignedIntegrationEvent) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
 var File_configs_orders_events_proto protoreflect.FileDescriptor
 
 const file_configs_orders_events_proto_rawDesc = "" +
@@ -191,6 +268,15 @@ const file_configs_orders_events_proto_rawDesc = "" +
 	"occurredAt\x12\x19\n" +
 	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1d\n" +
 	"\n" +
+	"courier_id\x18\x05 \x01(\tR\tcourierId\"\xd0\x01\n" +
+	"\x1dOrderAssignedIntegrationEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
 	"courier_id\x18\x05 \x01(\tR\tcourierIdB?\n" +
 	"\fqueues.orderB\x10OrderEventsProtoZ\x0equeues/orderpb\xaa\x02\fQueues.Orderb\x06proto3"
 
@@ -206,20 +292,22 @@ func file_configs_orders_events_proto_rawDescGZIP() []byte {
 	return file_configs_orders_events_proto_rawDescData
 }
 
-var file_configs_orders_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_configs_orders_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_configs_orders_events_proto_goTypes = []any{
 	(*OrderCreatedIntegrationEvent)(nil),   // 0: order_event.OrderCreatedIntegrationEvent
 	(*OrderCompletedIntegrationEvent)(nil), // 1: order_event.OrderCompletedIntegrationEvent
-	(*timestamppb.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(*OrderAssignedIntegrationEvent)(nil),  // 2: order_event.OrderAssignedIntegrationEvent
+	(*timestamppb.Timestamp)(nil),          // 3: google.protobuf.Timestamp
 }
 var file_configs_orders_events_proto_depIdxs = []int32{
-	2, // 0: order_event.OrderCreatedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	2, // 1: order_event.OrderCompletedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: order_event.OrderCreatedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	3, // 1: order_event.OrderCompletedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	3, // 2: order_event.OrderAssignedIntegrationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_configs_orders_events_proto_init() }
@@ -233,7 +321,7 @@ func file_configs_orders_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_configs_orders_events_proto_rawDesc), len(file_configs_orders_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
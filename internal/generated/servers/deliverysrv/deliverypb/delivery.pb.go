@@ -0,0 +1,1174 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: api/proto/delivery.proto
+
+package deliverypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int64                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int64                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_api_proto_delivery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Location) GetX() int64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Location) GetY() int64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type CreateCourierRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Скорость, по умолчанию берется из типа транспорта
+	Speed int64 `protobuf:"varint,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Тип транспорта: Foot, Bicycle, Scooter, Car. По умолчанию Foot
+	TransportType string `protobuf:"bytes,3,opt,name=transport_type,json=transportType,proto3" json:"transport_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourierRequest) Reset() {
+	*x = CreateCourierRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourierRequest) ProtoMessage() {}
+
+func (x *CreateCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourierRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourierRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCourierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCourierRequest) GetSpeed() int64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *CreateCourierRequest) GetTransportType() string {
+	if x != nil {
+		return x.TransportType
+	}
+	return ""
+}
+
+type CreateCourierReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourierReply) Reset() {
+	*x = CreateCourierReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourierReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourierReply) ProtoMessage() {}
+
+func (x *CreateCourierReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourierReply.ProtoReflect.Descriptor instead.
+func (*CreateCourierReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+type GetCouriersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouriersRequest) Reset() {
+	*x = GetCouriersRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouriersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouriersRequest) ProtoMessage() {}
+
+func (x *GetCouriersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouriersRequest.ProtoReflect.Descriptor instead.
+func (*GetCouriersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{3}
+}
+
+type Courier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	TransportType string                 `protobuf:"bytes,4,opt,name=transport_type,json=transportType,proto3" json:"transport_type,omitempty"`
+	WorkStatus    string                 `protobuf:"bytes,5,opt,name=work_status,json=workStatus,proto3" json:"work_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courier) Reset() {
+	*x = Courier{}
+	mi := &file_api_proto_delivery_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courier) ProtoMessage() {}
+
+func (x *Courier) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courier.ProtoReflect.Descriptor instead.
+func (*Courier) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Courier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Courier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Courier) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Courier) GetTransportType() string {
+	if x != nil {
+		return x.TransportType
+	}
+	return ""
+}
+
+func (x *Courier) GetWorkStatus() string {
+	if x != nil {
+		return x.WorkStatus
+	}
+	return ""
+}
+
+type GetCouriersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Couriers      []*Courier             `protobuf:"bytes,1,rep,name=couriers,proto3" json:"couriers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouriersReply) Reset() {
+	*x = GetCouriersReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouriersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouriersReply) ProtoMessage() {}
+
+func (x *GetCouriersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouriersReply.ProtoReflect.Descriptor instead.
+func (*GetCouriersReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCouriersReply) GetCouriers() []*Courier {
+	if x != nil {
+		return x.Couriers
+	}
+	return nil
+}
+
+type AddStoragePlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TotalVolume   int64                  `protobuf:"varint,3,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStoragePlaceRequest) Reset() {
+	*x = AddStoragePlaceRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStoragePlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStoragePlaceRequest) ProtoMessage() {}
+
+func (x *AddStoragePlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStoragePlaceRequest.ProtoReflect.Descriptor instead.
+func (*AddStoragePlaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddStoragePlaceRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *AddStoragePlaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddStoragePlaceRequest) GetTotalVolume() int64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+type AddStoragePlaceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStoragePlaceReply) Reset() {
+	*x = AddStoragePlaceReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStoragePlaceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStoragePlaceReply) ProtoMessage() {}
+
+func (x *AddStoragePlaceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStoragePlaceReply.ProtoReflect.Descriptor instead.
+func (*AddStoragePlaceReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{7}
+}
+
+// Адрес доставки, как в Address корзины
+type Address struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Country string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	City    string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Street  string                 `protobuf:"bytes,3,opt,name=street,proto3" json:"street,omitempty"`
+	House   string                 `protobuf:"bytes,4,opt,name=house,proto3" json:"house,omitempty"`
+	// Необязательна
+	Apartment     string `protobuf:"bytes,5,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_api_proto_delivery_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *Address) GetApartment() string {
+	if x != nil {
+		return x.Apartment
+	}
+	return ""
+}
+
+type CreateOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Идентификатор заказа, если не указан - генерируется
+	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Volume        int64    `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Address       *Address `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type CreateOrderReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Геолокация, определенная по адресу
+	Location      *Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderReply) Reset() {
+	*x = CreateOrderReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderReply) ProtoMessage() {}
+
+func (x *CreateOrderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderReply.ProtoReflect.Descriptor instead.
+func (*CreateOrderReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateOrderReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateOrderReply) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersRequest) Reset() {
+	*x = GetOrdersRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersRequest) ProtoMessage() {}
+
+func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{11}
+}
+
+type OrderEta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         int64                  `protobuf:"varint,1,opt,name=moves,proto3" json:"moves,omitempty"`
+	Seconds       int64                  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	ArrivalAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrival_at,json=arrivalAt,proto3" json:"arrival_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEta) Reset() {
+	*x = OrderEta{}
+	mi := &file_api_proto_delivery_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEta) ProtoMessage() {}
+
+func (x *OrderEta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEta.ProtoReflect.Descriptor instead.
+func (*OrderEta) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderEta) GetMoves() int64 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *OrderEta) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *OrderEta) GetArrivalAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalAt
+	}
+	return nil
+}
+
+type Order struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// Есть только у заказов, которые везет курьер
+	Eta           *OrderEta `protobuf:"bytes,3,opt,name=eta,proto3" json:"eta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_api_proto_delivery_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Order) GetEta() *OrderEta {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+type GetOrdersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersReply) Reset() {
+	*x = GetOrdersReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersReply) ProtoMessage() {}
+
+func (x *GetOrdersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersReply.ProtoReflect.Descriptor instead.
+func (*GetOrdersReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOrdersReply) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_api_proto_delivery_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AssignedCourier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignedCourier) Reset() {
+	*x = AssignedCourier{}
+	mi := &file_api_proto_delivery_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignedCourier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignedCourier) ProtoMessage() {}
+
+func (x *AssignedCourier) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignedCourier.ProtoReflect.Descriptor instead.
+func (*AssignedCourier) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AssignedCourier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignedCourier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssignedCourier) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetOrderReply struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status   string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Location *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Отсутствует если курьер везет заказ сразу клиенту
+	PickupLocation *Location `protobuf:"bytes,4,opt,name=pickup_location,json=pickupLocation,proto3" json:"pickup_location,omitempty"`
+	// Отсутствует у неназначенного заказа
+	Courier       *AssignedCourier `protobuf:"bytes,5,opt,name=courier,proto3" json:"courier,omitempty"`
+	Eta           *OrderEta        `protobuf:"bytes,6,opt,name=eta,proto3" json:"eta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderReply) Reset() {
+	*x = GetOrderReply{}
+	mi := &file_api_proto_delivery_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReply) ProtoMessage() {}
+
+func (x *GetOrderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_delivery_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderReply.ProtoReflect.Descriptor instead.
+func (*GetOrderReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_delivery_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetOrderReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetOrderReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOrderReply) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetOrderReply) GetPickupLocation() *Location {
+	if x != nil {
+		return x.PickupLocation
+	}
+	return nil
+}
+
+func (x *GetOrderReply) GetCourier() *AssignedCourier {
+	if x != nil {
+		return x.Courier
+	}
+	return nil
+}
+
+func (x *GetOrderReply) GetEta() *OrderEta {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+var File_api_proto_delivery_proto protoreflect.FileDescriptor
+
+const file_api_proto_delivery_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/proto/delivery.proto\x12\bdelivery\x1a\x1fgoogle/protobuf/timestamp.proto\"&\n" +
+	"\bLocation\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x03R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x03R\x01y\"g\n" +
+	"\x14CreateCourierRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05speed\x18\x02 \x01(\x03R\x05speed\x12%\n" +
+	"\x0etransport_type\x18\x03 \x01(\tR\rtransportType\"\x14\n" +
+	"\x12CreateCourierReply\"\x14\n" +
+	"\x12GetCouriersRequest\"\xa5\x01\n" +
+	"\aCourier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.delivery.LocationR\blocation\x12%\n" +
+	"\x0etransport_type\x18\x04 \x01(\tR\rtransportType\x12\x1f\n" +
+	"\vwork_status\x18\x05 \x01(\tR\n" +
+	"workStatus\"A\n" +
+	"\x10GetCouriersReply\x12-\n" +
+	"\bcouriers\x18\x01 \x03(\v2\x11.delivery.CourierR\bcouriers\"n\n" +
+	"\x16AddStoragePlaceRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\ftotal_volume\x18\x03 \x01(\x03R\vtotalVolume\"\x16\n" +
+	"\x14AddStoragePlaceReply\"\x83\x01\n" +
+	"\aAddress\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x16\n" +
+	"\x06street\x18\x03 \x01(\tR\x06street\x12\x14\n" +
+	"\x05house\x18\x04 \x01(\tR\x05house\x12\x1c\n" +
+	"\tapartment\x18\x05 \x01(\tR\tapartment\"w\n" +
+	"\x12CreateOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06volume\x18\x03 \x01(\x03R\x06volume\x12+\n" +
+	"\aaddress\x18\x04 \x01(\v2\x11.delivery.AddressR\aaddressJ\x04\b\x02\x10\x03R\x06street\"R\n" +
+	"\x10CreateOrderReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\blocation\x18\x02 \x01(\v2\x12.delivery.LocationR\blocation\"\x12\n" +
+	"\x10GetOrdersRequest\"u\n" +
+	"\bOrderEta\x12\x14\n" +
+	"\x05moves\x18\x01 \x01(\x03R\x05moves\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x03R\aseconds\x129\n" +
+	"\n" +
+	"arrival_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tarrivalAt\"m\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\blocation\x18\x02 \x01(\v2\x12.delivery.LocationR\blocation\x12$\n" +
+	"\x03eta\x18\x03 \x01(\v2\x12.delivery.OrderEtaR\x03eta\"9\n" +
+	"\x0eGetOrdersReply\x12'\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0f.delivery.OrderR\x06orders\"!\n" +
+	"\x0fGetOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
+	"\x0fAssignedCourier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.delivery.LocationR\blocation\"\xff\x01\n" +
+	"\rGetOrderReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12.\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.delivery.LocationR\blocation\x12;\n" +
+	"\x0fpickup_location\x18\x04 \x01(\v2\x12.delivery.LocationR\x0epickupLocation\x123\n" +
+	"\acourier\x18\x05 \x01(\v2\x19.delivery.AssignedCourierR\acourier\x12$\n" +
+	"\x03eta\x18\x06 \x01(\v2\x12.delivery.OrderEtaR\x03eta2\xc3\x03\n" +
+	"\bDelivery\x12M\n" +
+	"\rCreateCourier\x12\x1e.delivery.CreateCourierRequest\x1a\x1c.delivery.CreateCourierReply\x12G\n" +
+	"\vGetCouriers\x12\x1c.delivery.GetCouriersRequest\x1a\x1a.delivery.GetCouriersReply\x12S\n" +
+	"\x0fAddStoragePlace\x12 .delivery.AddStoragePlaceRequest\x1a\x1e.delivery.AddStoragePlaceReply\x12G\n" +
+	"\vCreateOrder\x12\x1c.delivery.CreateOrderRequest\x1a\x1a.delivery.CreateOrderReply\x12A\n" +
+	"\tGetOrders\x12\x1a.delivery.GetOrdersRequest\x1a\x18.delivery.GetOrdersReply\x12>\n" +
+	"\bGetOrder\x12\x19.delivery.GetOrderRequest\x1a\x17.delivery.GetOrderReplyB\x18Z\x16deliverysrv/deliverypbb\x06proto3"
+
+var (
+	file_api_proto_delivery_proto_rawDescOnce sync.Once
+	file_api_proto_delivery_proto_rawDescData []byte
+)
+
+func file_api_proto_delivery_proto_rawDescGZIP() []byte {
+	file_api_proto_delivery_proto_rawDescOnce.Do(func() {
+		file_api_proto_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_delivery_proto_rawDesc), len(file_api_proto_delivery_proto_rawDesc)))
+	})
+	return file_api_proto_delivery_proto_rawDescData
+}
+
+var file_api_proto_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_proto_delivery_proto_goTypes = []any{
+	(*Location)(nil),               // 0: delivery.Location
+	(*CreateCourierRequest)(nil),   // 1: delivery.CreateCourierRequest
+	(*CreateCourierReply)(nil),     // 2: delivery.CreateCourierReply
+	(*GetCouriersRequest)(nil),     // 3: delivery.GetCouriersRequest
+	(*Courier)(nil),                // 4: delivery.Courier
+	(*GetCouriersReply)(nil),       // 5: delivery.GetCouriersReply
+	(*AddStoragePlaceRequest)(nil), // 6: delivery.AddStoragePlaceRequest
+	(*AddStoragePlaceReply)(nil),   // 7: delivery.AddStoragePlaceReply
+	(*Address)(nil),                // 8: delivery.Address
+	(*CreateOrderRequest)(nil),     // 9: delivery.CreateOrderRequest
+	(*CreateOrderReply)(nil),       // 10: delivery.CreateOrderReply
+	(*GetOrdersRequest)(nil),       // 11: delivery.GetOrdersRequest
+	(*OrderEta)(nil),               // 12: delivery.OrderEta
+	(*Order)(nil),                  // 13: delivery.Order
+	(*GetOrdersReply)(nil),         // 14: delivery.GetOrdersReply
+	(*GetOrderRequest)(nil),        // 15: delivery.GetOrderRequest
+	(*AssignedCourier)(nil),        // 16: delivery.AssignedCourier
+	(*GetOrderReply)(nil),          // 17: delivery.GetOrderReply
+	(*timestamppb.Timestamp)(nil),  // 18: google.protobuf.Timestamp
+}
+var file_api_proto_delivery_proto_depIdxs = []int32{
+	0,  // 0: delivery.Courier.location:type_name -> delivery.Location
+	4,  // 1: delivery.GetCouriersReply.couriers:type_name -> delivery.Courier
+	8,  // 2: delivery.CreateOrderRequest.address:type_name -> delivery.Address
+	0,  // 3: delivery.CreateOrderReply.location:type_name -> delivery.Location
+	18, // 4: delivery.OrderEta.arrival_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: delivery.Order.location:type_name -> delivery.Location
+	12, // 6: delivery.Order.eta:type_name -> delivery.OrderEta
+	13, // 7: delivery.GetOrdersReply.orders:type_name -> delivery.Order
+	0,  // 8: delivery.AssignedCourier.location:type_name -> delivery.Location
+	0,  // 9: delivery.GetOrderReply.location:type_name -> delivery.Location
+	0,  // 10: delivery.GetOrderReply.pickup_location:type_name -> delivery.Location
+	16, // 11: delivery.GetOrderReply.courier:type_name -> delivery.AssignedCourier
+	12, // 12: delivery.GetOrderReply.eta:type_name -> delivery.OrderEta
+	1,  // 13: delivery.Delivery.CreateCourier:input_type -> delivery.CreateCourierRequest
+	3,  // 14: delivery.Delivery.GetCouriers:input_type -> delivery.GetCouriersRequest
+	6,  // 15: delivery.Delivery.AddStoragePlace:input_type -> delivery.AddStoragePlaceRequest
+	9,  // 16: delivery.Delivery.CreateOrder:input_type -> delivery.CreateOrderRequest
+	11, // 17: delivery.Delivery.GetOrders:input_type -> delivery.GetOrdersRequest
+	15, // 18: delivery.Delivery.GetOrder:input_type -> delivery.GetOrderRequest
+	2,  // 19: delivery.Delivery.CreateCourier:output_type -> delivery.CreateCourierReply
+	5,  // 20: delivery.Delivery.GetCouriers:output_type -> delivery.GetCouriersReply
+	7,  // 21: delivery.Delivery.AddStoragePlace:output_type -> delivery.AddStoragePlaceReply
+	10, // 22: delivery.Delivery.CreateOrder:output_type -> delivery.CreateOrderReply
+	14, // 23: delivery.Delivery.GetOrders:output_type -> delivery.GetOrdersReply
+	17, // 24: delivery.Delivery.GetOrder:output_type -> delivery.GetOrderReply
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_delivery_proto_init() }
+func file_api_proto_delivery_proto_init() {
+	if File_api_proto_delivery_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_delivery_proto_rawDesc), len(file_api_proto_delivery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_delivery_proto_goTypes,
+		DependencyIndexes: file_api_proto_delivery_proto_depIdxs,
+		MessageInfos:      file_api_proto_delivery_proto_msgTypes,
+	}.Build()
+	File_api_proto_delivery_proto = out.File
+	file_api_proto_delivery_proto_goTypes = nil
+	file_api_proto_delivery_proto_depIdxs = nil
+}
@@ -10,17 +10,76 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CourierWorkStatus.
+const (
+	CourierWorkStatusOffline CourierWorkStatus = "Offline"
+	CourierWorkStatusOnBreak CourierWorkStatus = "OnBreak"
+	CourierWorkStatusOnline  CourierWorkStatus = "Online"
+)
+
+// Defines values for CourierDetailsWorkStatus.
+const (
+	CourierDetailsWorkStatusOffline CourierDetailsWorkStatus = "Offline"
+	CourierDetailsWorkStatusOnBreak CourierDetailsWorkStatus = "OnBreak"
+	CourierDetailsWorkStatusOnline  CourierDetailsWorkStatus = "Online"
+)
+
+// Defines values for TrackingUpdateType.
+const (
+	CourierLocation TrackingUpdateType = "courier_location"
+	OrderStatus     TrackingUpdateType = "order_status"
+)
+
+// Defines values for TransportType.
+const (
+	Bicycle TransportType = "Bicycle"
+	Car     TransportType = "Car"
+	Foot    TransportType = "Foot"
+	Scooter TransportType = "Scooter"
+)
+
+// Address Адрес доставки, как в Address корзины
+type Address struct {
+	// Apartment Квартира
+	Apartment *string `json:"apartment,omitempty"`
+
+	// City Город
+	City string `json:"city"`
+
+	// Country Страна
+	Country string `json:"country"`
+
+	// House Дом
+	House string `json:"house"`
+
+	// Street Улица
+	Street string `json:"street"`
+}
+
+// AssignedCourier Курьер, который везет заказ, отсутствует у неназначенного заказа
+type AssignedCourier struct {
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// Name Имя
+	Name string `json:"name"`
+}
+
 // Courier defines model for Courier.
 type Courier struct {
 	// Id Идентификатор
@@ -29,6 +88,80 @@ type Courier struct {
 
 	// Name Имя
 	Name string `json:"name"`
+
+	// TransportType Тип транспорта, по умолчанию Foot
+	TransportType TransportType `json:"transportType"`
+
+	// WorkStatus Статус смены курьера
+	WorkStatus CourierWorkStatus `json:"workStatus"`
+}
+
+// CourierWorkStatus Статус смены курьера
+type CourierWorkStatus string
+
+// CourierDetails defines model for CourierDetails.
+type CourierDetails struct {
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// Name Имя
+	Name string `json:"name"`
+
+	// Speed Скорость
+	Speed int64 `json:"speed"`
+
+	// StoragePlaces Места хранения
+	StoragePlaces []StoragePlace `json:"storagePlaces"`
+
+	// TransportType Тип транспорта, по умолчанию Foot
+	TransportType TransportType `json:"transportType"`
+
+	// WorkStatus Статус смены курьера
+	WorkStatus CourierDetailsWorkStatus `json:"workStatus"`
+}
+
+// CourierDetailsWorkStatus Статус смены курьера
+type CourierDetailsWorkStatus string
+
+// CreatedOrder defines model for CreatedOrder.
+type CreatedOrder struct {
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+}
+
+// DispatchCandidate defines model for DispatchCandidate.
+type DispatchCandidate struct {
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// RejectionReason Почему курьер не получил заказ, пусто у выбранного курьера
+	RejectionReason *string `json:"rejectionReason,omitempty"`
+
+	// TimeToLocation Время курьера до заказа в тиках
+	TimeToLocation float64 `json:"timeToLocation"`
+}
+
+// DispatchDecision defines model for DispatchDecision.
+type DispatchDecision struct {
+	// Candidates Рассмотренные курьеры
+	Candidates []DispatchCandidate `json:"candidates"`
+
+	// CourierId Выбранный курьер, отсутствует если заказ не назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// DecidedAt Время принятия решения (UTC)
+	DecidedAt time.Time `json:"decidedAt"`
+
+	// Id Идентификатор решения
+	Id openapi_types.UUID `json:"id"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// Strategy Стратегия назначения
+	Strategy string `json:"strategy"`
 }
 
 // Error defines model for Error.
@@ -54,20 +187,155 @@ type NewCourier struct {
 	// Name Имя
 	Name string `json:"name"`
 
-	// Speed Скорость
-	Speed int `json:"speed"`
+	// Speed Скорость, по умолчанию берется из типа транспорта
+	Speed *int `json:"speed,omitempty"`
+
+	// TransportType Тип транспорта, по умолчанию Foot
+	TransportType *TransportType `json:"transportType,omitempty"`
+}
+
+// NewOrder defines model for NewOrder.
+type NewOrder struct {
+	// Address Адрес доставки, как в Address корзины
+	Address Address `json:"address"`
+
+	// Id Идентификатор, по умолчанию генерируется
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Volume Объем
+	Volume int64 `json:"volume"`
+}
+
+// NewStoragePlace defines model for NewStoragePlace.
+type NewStoragePlace struct {
+	// Name Название
+	Name string `json:"name"`
+
+	// TotalVolume Вместимость
+	TotalVolume int64 `json:"totalVolume"`
 }
 
 // Order defines model for Order.
 type Order struct {
+	// Eta Ожидаемое время доставки, есть только у заказов, которые везет курьер
+	Eta *OrderEta `json:"eta,omitempty"`
+
 	// Id Идентификатор
 	Id       openapi_types.UUID `json:"id"`
 	Location Location           `json:"location"`
 }
 
+// OrderDetails defines model for OrderDetails.
+type OrderDetails struct {
+	// Courier Курьер, который везет заказ, отсутствует у неназначенного заказа
+	Courier *AssignedCourier `json:"courier,omitempty"`
+
+	// Eta Ожидаемое время доставки, есть только у заказов, которые везет курьер
+	Eta *OrderEta `json:"eta,omitempty"`
+
+	// Id Идентификатор
+	Id             openapi_types.UUID `json:"id"`
+	Location       Location           `json:"location"`
+	PickupLocation *Location          `json:"pickupLocation,omitempty"`
+
+	// Status Статус заказа
+	Status string `json:"status"`
+}
+
+// OrderEta Ожидаемое время доставки, есть только у заказов, которые везет курьер
+type OrderEta struct {
+	// ArrivalAt Ожидаемое время доставки (UTC)
+	ArrivalAt time.Time `json:"arrivalAt"`
+
+	// Moves Сколько перемещений курьера осталось до доставки
+	Moves int64 `json:"moves"`
+
+	// Seconds Сколько секунд осталось до доставки
+	Seconds int64 `json:"seconds"`
+}
+
+// OrderStatusChange defines model for OrderStatusChange.
+type OrderStatusChange struct {
+	// ChangedAt Время перехода (UTC)
+	ChangedAt time.Time `json:"changedAt"`
+
+	// Command Команда, вызвавшая переход
+	Command string `json:"command"`
+
+	// CourierId Курьер, к которому относится переход, отсутствует если заказ не был назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// FromStatus Статус до перехода, пустой у создания заказа
+	FromStatus string `json:"fromStatus"`
+
+	// ToStatus Статус после перехода
+	ToStatus string `json:"toStatus"`
+}
+
+// StoragePlace defines model for StoragePlace.
+type StoragePlace struct {
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// OccupiedVolume Занятый объем, равен объему лежащего в месте заказа
+	OccupiedVolume int64 `json:"occupiedVolume"`
+
+	// OrderId Заказ в месте хранения, отсутствует у пустого места
+	OrderId *openapi_types.UUID `json:"orderId,omitempty"`
+
+	// TotalVolume Вместимость
+	TotalVolume int64 `json:"totalVolume"`
+}
+
+// TrackingUpdate defines model for TrackingUpdate.
+type TrackingUpdate struct {
+	// CourierId Курьер, для order_status отсутствует если заказ не назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// FromStatus Статус до перехода, пустой у создания заказа, есть только у order_status
+	FromStatus *string   `json:"fromStatus,omitempty"`
+	Location   *Location `json:"location,omitempty"`
+
+	// OccurredAt Время изменения (UTC)
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// OrderId Заказ, есть только у order_status
+	OrderId *openapi_types.UUID `json:"orderId,omitempty"`
+
+	// ToStatus Статус после перехода, есть только у order_status
+	ToStatus *string `json:"toStatus,omitempty"`
+
+	// Type Тип изменения
+	Type TrackingUpdateType `json:"type"`
+}
+
+// TrackingUpdateType Тип изменения
+type TrackingUpdateType string
+
+// TransportType Тип транспорта, по умолчанию Foot
+type TransportType string
+
+// StreamTrackingParams defines parameters for StreamTracking.
+type StreamTrackingParams struct {
+	// OrderId Идентификатор заказа
+	OrderId *openapi_types.UUID `form:"orderId,omitempty" json:"orderId,omitempty"`
+
+	// CourierId Идентификатор курьера
+	CourierId *openapi_types.UUID `form:"courierId,omitempty" json:"courierId,omitempty"`
+}
+
 // CreateCourierJSONRequestBody defines body for CreateCourier for application/json ContentType.
 type CreateCourierJSONRequestBody = NewCourier
 
+// AddStoragePlaceJSONRequestBody defines body for AddStoragePlace for application/json ContentType.
+type AddStoragePlaceJSONRequestBody = NewStoragePlace
+
+// CreateOrderJSONRequestBody defines body for CreateOrder for application/json ContentType.
+type CreateOrderJSONRequestBody = NewOrder
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Получить всех курьеров
@@ -76,12 +344,39 @@ type ServerInterface interface {
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx echo.Context) error
+	// Получить курьера
+	// (GET /api/v1/couriers/{id})
+	GetCourier(ctx echo.Context, id openapi_types.UUID) error
+	// Уйти на перерыв
+	// (POST /api/v1/couriers/{id}/break)
+	TakeCourierBreak(ctx echo.Context, id openapi_types.UUID) error
+	// Завершить смену
+	// (POST /api/v1/couriers/{id}/shift/end)
+	EndCourierShift(ctx echo.Context, id openapi_types.UUID) error
+	// Начать смену
+	// (POST /api/v1/couriers/{id}/shift/start)
+	StartCourierShift(ctx echo.Context, id openapi_types.UUID) error
+	// Добавить место хранения
+	// (POST /api/v1/couriers/{id}/storage-places)
+	AddStoragePlace(ctx echo.Context, id openapi_types.UUID) error
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx echo.Context) error
 	// Получить все незавершенные заказы
 	// (GET /api/v1/orders/active)
 	GetOrders(ctx echo.Context) error
+	// Получить заказ
+	// (GET /api/v1/orders/{id})
+	GetOrder(ctx echo.Context, id openapi_types.UUID) error
+	// Получить решения о назначении заказа
+	// (GET /api/v1/orders/{id}/dispatch)
+	GetOrderDispatchDecisions(ctx echo.Context, id openapi_types.UUID) error
+	// Получить историю статусов заказа
+	// (GET /api/v1/orders/{id}/history)
+	GetOrderStatusHistory(ctx echo.Context, id openapi_types.UUID) error
+	// Подписаться на трекинг
+	// (GET /api/v1/tracking/stream)
+	StreamTracking(ctx echo.Context, params StreamTrackingParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -107,6 +402,86 @@ func (w *ServerInterfaceWrapper) CreateCourier(ctx echo.Context) error {
 	return err
 }
 
+// GetCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourier(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourier(ctx, id)
+	return err
+}
+
+// TakeCourierBreak converts echo context to params.
+func (w *ServerInterfaceWrapper) TakeCourierBreak(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TakeCourierBreak(ctx, id)
+	return err
+}
+
+// EndCourierShift converts echo context to params.
+func (w *ServerInterfaceWrapper) EndCourierShift(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EndCourierShift(ctx, id)
+	return err
+}
+
+// StartCourierShift converts echo context to params.
+func (w *ServerInterfaceWrapper) StartCourierShift(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartCourierShift(ctx, id)
+	return err
+}
+
+// AddStoragePlace converts echo context to params.
+func (w *ServerInterfaceWrapper) AddStoragePlace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddStoragePlace(ctx, id)
+	return err
+}
+
 // CreateOrder converts echo context to params.
 func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
 	var err error
@@ -125,6 +500,79 @@ func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
 	return err
 }
 
+// GetOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrder(ctx, id)
+	return err
+}
+
+// GetOrderDispatchDecisions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderDispatchDecisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderDispatchDecisions(ctx, id)
+	return err
+}
+
+// GetOrderStatusHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderStatusHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderStatusHistory(ctx, id)
+	return err
+}
+
+// StreamTracking converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamTracking(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamTrackingParams
+	// ------------- Optional query parameter "orderId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderId", ctx.QueryParams(), &params.OrderId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// ------------- Optional query parameter "courierId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "courierId", ctx.QueryParams(), &params.CourierId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamTracking(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -155,8 +603,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
 	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
+	router.GET(baseURL+"/api/v1/couriers/:id", wrapper.GetCourier)
+	router.POST(baseURL+"/api/v1/couriers/:id/break", wrapper.TakeCourierBreak)
+	router.POST(baseURL+"/api/v1/couriers/:id/shift/end", wrapper.EndCourierShift)
+	router.POST(baseURL+"/api/v1/couriers/:id/shift/start", wrapper.StartCourierShift)
+	router.POST(baseURL+"/api/v1/couriers/:id/storage-places", wrapper.AddStoragePlace)
 	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
 	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	router.GET(baseURL+"/api/v1/orders/:id", wrapper.GetOrder)
+	router.GET(baseURL+"/api/v1/orders/:id/dispatch", wrapper.GetOrderDispatchDecisions)
+	router.GET(baseURL+"/api/v1/orders/:id/history", wrapper.GetOrderStatusHistory)
+	router.GET(baseURL+"/api/v1/tracking/stream", wrapper.StreamTracking)
 
 }
 
@@ -234,96 +691,555 @@ func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w ht
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateOrderRequestObject struct {
+type GetCourierRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type CreateOrderResponseObject interface {
-	VisitCreateOrderResponse(w http.ResponseWriter) error
+type GetCourierResponseObject interface {
+	VisitGetCourierResponse(w http.ResponseWriter) error
 }
 
-type CreateOrder201Response struct {
+type GetCourier200JSONResponse CourierDetails
+
+func (response GetCourier200JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+type GetCourier400JSONResponse Error
+
+func (response GetCourier400JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOrderdefaultJSONResponse struct {
+type GetCourier404JSONResponse Error
+
+func (response GetCourier404JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+func (response GetCourierdefaultJSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetOrdersRequestObject struct {
+type TakeCourierBreakRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type GetOrdersResponseObject interface {
-	VisitGetOrdersResponse(w http.ResponseWriter) error
+type TakeCourierBreakResponseObject interface {
+	VisitTakeCourierBreakResponse(w http.ResponseWriter) error
 }
 
-type GetOrders200JSONResponse []Order
+type TakeCourierBreak204Response struct {
+}
 
-func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response TakeCourierBreak204Response) VisitTakeCourierBreakResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type TakeCourierBreak400JSONResponse Error
+
+func (response TakeCourierBreak400JSONResponse) VisitTakeCourierBreakResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOrdersdefaultJSONResponse struct {
+type TakeCourierBreakdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response TakeCourierBreakdefaultJSONResponse) VisitTakeCourierBreakResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получить всех курьеров
-	// (GET /api/v1/couriers)
-	GetCouriers(ctx context.Context, request GetCouriersRequestObject) (GetCouriersResponseObject, error)
-	// Добавить курьера
-	// (POST /api/v1/couriers)
-	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
-	// Создать заказ
-	// (POST /api/v1/orders)
-	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
-	// Получить все незавершенные заказы
-	// (GET /api/v1/orders/active)
-	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+type EndCourierShiftRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+type EndCourierShiftResponseObject interface {
+	VisitEndCourierShiftResponse(w http.ResponseWriter) error
+}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+type EndCourierShift204Response struct {
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+func (response EndCourierShift204Response) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// GetCouriers operation middleware
-func (sh *strictHandler) GetCouriers(ctx echo.Context) error {
-	var request GetCouriersRequestObject
+type EndCourierShift400JSONResponse Error
 
-	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCouriers(ctx.Request().Context(), request.(GetCouriersRequestObject))
-	}
+func (response EndCourierShift400JSONResponse) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndCourierShiftdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response EndCourierShiftdefaultJSONResponse) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StartCourierShiftRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type StartCourierShiftResponseObject interface {
+	VisitStartCourierShiftResponse(w http.ResponseWriter) error
+}
+
+type StartCourierShift204Response struct {
+}
+
+func (response StartCourierShift204Response) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type StartCourierShift400JSONResponse Error
+
+func (response StartCourierShift400JSONResponse) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierShiftdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StartCourierShiftdefaultJSONResponse) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddStoragePlaceRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *AddStoragePlaceJSONRequestBody
+}
+
+type AddStoragePlaceResponseObject interface {
+	VisitAddStoragePlaceResponse(w http.ResponseWriter) error
+}
+
+type AddStoragePlace201Response struct {
+}
+
+func (response AddStoragePlace201Response) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type AddStoragePlace400JSONResponse Error
+
+func (response AddStoragePlace400JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlace404JSONResponse Error
+
+func (response AddStoragePlace404JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlace409JSONResponse Error
+
+func (response AddStoragePlace409JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlacedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AddStoragePlacedefaultJSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrderRequestObject struct {
+	Body *CreateOrderJSONRequestBody
+}
+
+type CreateOrderResponseObject interface {
+	VisitCreateOrderResponse(w http.ResponseWriter) error
+}
+
+type CreateOrder201JSONResponse CreatedOrder
+
+func (response CreateOrder201JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder400JSONResponse Error
+
+func (response CreateOrder400JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder409JSONResponse Error
+
+func (response CreateOrder409JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrdersRequestObject struct {
+}
+
+type GetOrdersResponseObject interface {
+	VisitGetOrdersResponse(w http.ResponseWriter) error
+}
+
+type GetOrders200JSONResponse []Order
+
+func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetOrderResponseObject interface {
+	VisitGetOrderResponse(w http.ResponseWriter) error
+}
+
+type GetOrder200JSONResponse OrderDetails
+
+func (response GetOrder200JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder400JSONResponse Error
+
+func (response GetOrder400JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder404JSONResponse Error
+
+func (response GetOrder404JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderdefaultJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderDispatchDecisionsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetOrderDispatchDecisionsResponseObject interface {
+	VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error
+}
+
+type GetOrderDispatchDecisions200JSONResponse []DispatchDecision
+
+func (response GetOrderDispatchDecisions200JSONResponse) VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderDispatchDecisions400JSONResponse Error
+
+func (response GetOrderDispatchDecisions400JSONResponse) VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderDispatchDecisions404JSONResponse Error
+
+func (response GetOrderDispatchDecisions404JSONResponse) VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderDispatchDecisionsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderDispatchDecisionsdefaultJSONResponse) VisitGetOrderDispatchDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderStatusHistoryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetOrderStatusHistoryResponseObject interface {
+	VisitGetOrderStatusHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetOrderStatusHistory200JSONResponse []OrderStatusChange
+
+func (response GetOrderStatusHistory200JSONResponse) VisitGetOrderStatusHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderStatusHistory400JSONResponse Error
+
+func (response GetOrderStatusHistory400JSONResponse) VisitGetOrderStatusHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderStatusHistory404JSONResponse Error
+
+func (response GetOrderStatusHistory404JSONResponse) VisitGetOrderStatusHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderStatusHistorydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderStatusHistorydefaultJSONResponse) VisitGetOrderStatusHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StreamTrackingRequestObject struct {
+	Params StreamTrackingParams
+}
+
+type StreamTrackingResponseObject interface {
+	VisitStreamTrackingResponse(w http.ResponseWriter) error
+}
+
+type StreamTracking200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamTracking200TexteventStreamResponse) VisitStreamTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamTracking400JSONResponse Error
+
+func (response StreamTracking400JSONResponse) VisitStreamTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamTracking404JSONResponse Error
+
+func (response StreamTracking404JSONResponse) VisitStreamTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamTrackingdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StreamTrackingdefaultJSONResponse) VisitStreamTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить всех курьеров
+	// (GET /api/v1/couriers)
+	GetCouriers(ctx context.Context, request GetCouriersRequestObject) (GetCouriersResponseObject, error)
+	// Добавить курьера
+	// (POST /api/v1/couriers)
+	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
+	// Получить курьера
+	// (GET /api/v1/couriers/{id})
+	GetCourier(ctx context.Context, request GetCourierRequestObject) (GetCourierResponseObject, error)
+	// Уйти на перерыв
+	// (POST /api/v1/couriers/{id}/break)
+	TakeCourierBreak(ctx context.Context, request TakeCourierBreakRequestObject) (TakeCourierBreakResponseObject, error)
+	// Завершить смену
+	// (POST /api/v1/couriers/{id}/shift/end)
+	EndCourierShift(ctx context.Context, request EndCourierShiftRequestObject) (EndCourierShiftResponseObject, error)
+	// Начать смену
+	// (POST /api/v1/couriers/{id}/shift/start)
+	StartCourierShift(ctx context.Context, request StartCourierShiftRequestObject) (StartCourierShiftResponseObject, error)
+	// Добавить место хранения
+	// (POST /api/v1/couriers/{id}/storage-places)
+	AddStoragePlace(ctx context.Context, request AddStoragePlaceRequestObject) (AddStoragePlaceResponseObject, error)
+	// Создать заказ
+	// (POST /api/v1/orders)
+	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
+	// Получить все незавершенные заказы
+	// (GET /api/v1/orders/active)
+	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+	// Получить заказ
+	// (GET /api/v1/orders/{id})
+	GetOrder(ctx context.Context, request GetOrderRequestObject) (GetOrderResponseObject, error)
+	// Получить решения о назначении заказа
+	// (GET /api/v1/orders/{id}/dispatch)
+	GetOrderDispatchDecisions(ctx context.Context, request GetOrderDispatchDecisionsRequestObject) (GetOrderDispatchDecisionsResponseObject, error)
+	// Получить историю статусов заказа
+	// (GET /api/v1/orders/{id}/history)
+	GetOrderStatusHistory(ctx context.Context, request GetOrderStatusHistoryRequestObject) (GetOrderStatusHistoryResponseObject, error)
+	// Подписаться на трекинг
+	// (GET /api/v1/tracking/stream)
+	StreamTracking(ctx context.Context, request StreamTrackingRequestObject) (StreamTrackingResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetCouriers operation middleware
+func (sh *strictHandler) GetCouriers(ctx echo.Context) error {
+	var request GetCouriersRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCouriers(ctx.Request().Context(), request.(GetCouriersRequestObject))
+	}
 	for _, middleware := range sh.middlewares {
 		handler = middleware(handler, "GetCouriers")
 	}
@@ -369,10 +1285,147 @@ func (sh *strictHandler) CreateCourier(ctx echo.Context) error {
 	return nil
 }
 
+// GetCourier operation middleware
+func (sh *strictHandler) GetCourier(ctx echo.Context, id openapi_types.UUID) error {
+	var request GetCourierRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourier(ctx.Request().Context(), request.(GetCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierResponseObject); ok {
+		return validResponse.VisitGetCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TakeCourierBreak operation middleware
+func (sh *strictHandler) TakeCourierBreak(ctx echo.Context, id openapi_types.UUID) error {
+	var request TakeCourierBreakRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TakeCourierBreak(ctx.Request().Context(), request.(TakeCourierBreakRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TakeCourierBreak")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TakeCourierBreakResponseObject); ok {
+		return validResponse.VisitTakeCourierBreakResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EndCourierShift operation middleware
+func (sh *strictHandler) EndCourierShift(ctx echo.Context, id openapi_types.UUID) error {
+	var request EndCourierShiftRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EndCourierShift(ctx.Request().Context(), request.(EndCourierShiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EndCourierShift")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EndCourierShiftResponseObject); ok {
+		return validResponse.VisitEndCourierShiftResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StartCourierShift operation middleware
+func (sh *strictHandler) StartCourierShift(ctx echo.Context, id openapi_types.UUID) error {
+	var request StartCourierShiftRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartCourierShift(ctx.Request().Context(), request.(StartCourierShiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartCourierShift")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StartCourierShiftResponseObject); ok {
+		return validResponse.VisitStartCourierShiftResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddStoragePlace operation middleware
+func (sh *strictHandler) AddStoragePlace(ctx echo.Context, id openapi_types.UUID) error {
+	var request AddStoragePlaceRequestObject
+
+	request.Id = id
+
+	var body AddStoragePlaceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddStoragePlace(ctx.Request().Context(), request.(AddStoragePlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddStoragePlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddStoragePlaceResponseObject); ok {
+		return validResponse.VisitAddStoragePlaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // CreateOrder operation middleware
 func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
 	var request CreateOrderRequestObject
 
+	var body CreateOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.CreateOrder(ctx.Request().Context(), request.(CreateOrderRequestObject))
 	}
@@ -415,24 +1468,164 @@ func (sh *strictHandler) GetOrders(ctx echo.Context) error {
 	return nil
 }
 
+// GetOrder operation middleware
+func (sh *strictHandler) GetOrder(ctx echo.Context, id openapi_types.UUID) error {
+	var request GetOrderRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrder(ctx.Request().Context(), request.(GetOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderResponseObject); ok {
+		return validResponse.VisitGetOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderDispatchDecisions operation middleware
+func (sh *strictHandler) GetOrderDispatchDecisions(ctx echo.Context, id openapi_types.UUID) error {
+	var request GetOrderDispatchDecisionsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderDispatchDecisions(ctx.Request().Context(), request.(GetOrderDispatchDecisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderDispatchDecisions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderDispatchDecisionsResponseObject); ok {
+		return validResponse.VisitGetOrderDispatchDecisionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderStatusHistory operation middleware
+func (sh *strictHandler) GetOrderStatusHistory(ctx echo.Context, id openapi_types.UUID) error {
+	var request GetOrderStatusHistoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderStatusHistory(ctx.Request().Context(), request.(GetOrderStatusHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderStatusHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderStatusHistoryResponseObject); ok {
+		return validResponse.VisitGetOrderStatusHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StreamTracking operation middleware
+func (sh *strictHandler) StreamTracking(ctx echo.Context, params StreamTrackingParams) error {
+	var request StreamTrackingRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamTracking(ctx.Request().Context(), request.(StreamTrackingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamTracking")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StreamTrackingResponseObject); ok {
+		return validResponse.VisitStreamTrackingResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xVT28bRRT/KqsHx1Vtt1zYIwEhpIgeuIAQh8V+caby7iyz47RWtFLsQBspUXPhgHoo",
-	"KnyBjcnKi0Pcr/DeN0IzEyduPHFcEVURF8u7O/Pm9++92YW2TDKZYqpziHYhb29jEtu/G7KvBCrzN1My",
-	"Q6UF2g+iY347mLeVyLSQKURAv9EpVXTOI6r5Z6ppSiWPaMZ7EMKWVEmsIYJ+X3QgBD3IECLItRJpF4oQ",
-	"erIdu0K78LHCLYjgo8YVsMYFqsbmfF0RQhon6MXxDx8vn1GEoPCnvlDYgeh7sDBshYXDf7jcJX98gm1t",
-	"TvlCKemRoC07vsNf0YxOA5rxAdV0QlOqF9mLVD96eAVNpBq7qMwpCeZ53PVV/IMqmvKQR9erruZn8V3V",
-	"9THbXND8XXLPlnF8a4qJVCT9BKKmj8JgedN3t2y6hvkZmCo+qF/j0xvDeEsMEpFuYtrV2xC1PMHLM0Rf",
-	"mt/Q1GSXZkZ6Plok0rqVyEWuXG0fn8eqc1/7ytcnKxrErBfplvQAf80jGlPFL6ikyuR3QmXA+/zCPU15",
-	"n/f4iCqjMo3DwPDkIb01n+2iParNHn5ONb80n60ZVNKYZjQN330z5X0jgNA9A++bp3G3iyr4HHtiB9UA",
-	"QthBlTtkrQfNB02jjswwjTMBETyyr0LIYr1trWjEmWjstBptFzv7rovaQ/N3mtHEQjrjY0ftrX0wTGsT",
-	"noDGPKSKf1kiDRaDsuJ+1YEIvkS9MT/RGJFnMs1dOB42m27wpBpTCyTOsp5wzjSe5M5kZ6gNk8Ykv833",
-	"eVcVl8bGSsUD5+s1on9emHNA53xIf5t55AwegV28Ffd7+r0grkLm5q4Px+vLMVjauOb9JInVYO7FesIX",
-	"IWQyX9PPU5rRiU3ZRdnFauWSiRsKY41zaV0/Ya4/k53BncmzMBF9Gr26AgjFUpBaHtqr3f3kPcP3n50N",
-	"aEwlnVFNp24CUO1wfPrBcfCha2g6N3OYaj4O6MSOpnMzsAI6oxn9ZSdzfW864dfVkTWr5yNOmsvIJmPt",
-	"juChfWWsscUnVBoQNAl4GPBzquiMj/hlwCOjkL26bNtR6QS8oWXctXgHcb0XFry5QSOP+I24rcUO3sEl",
-	"E9iUTqzzFe/xgc2s0ahagMCHvpvnsQvCh7h3nNP/61tnbSeKoij+DQAA//8JDQamhg0AAA==",
+	"H4sIAAAAAAAC/+xc627cxhV+FYLtjxagLDkxClT/HNu9AEZTVErRIggCejmSGGvJDTmrRDAEaLV14lSu",
+	"1aYBUgS51M0LUOtdi9ZqV69w5o2Kc4bcHZJDLtdSDMnWH2FvnDlzLt+5jh6YDb/Z8j3m8dBcfmCGjQ3W",
+	"tOnlTccJWEgvHRY2ArfFXd8zl034J/TFLgxEx4A+jEVH7EEEPTiG2DLgGCI4NqBnJM/jJ2OxC0cQw0js",
+	"m5bZCvwWC7jLaG27ZQe8yTyu2egb6EEkdsUexGIXItMy+XaLmctmyAPXWzd3LLPh8m3Nk/+mPcfQNy2z",
+	"6Xp3mbfON8zl67oV/LbHA90iT8UebgsjiGYvs+G3Q6ZZ5CsYw8nsx0MeMKbjwY8whFh8NpuEHcsM2Mdt",
+	"N2COufz+5FgJiyY7pJR+MFnAv/cRa3Ak4mYYuusec2757cBlgU4ioit2xWMYiF1LSnYPOS324YUBPRjA",
+	"EQzEngFHqAUQwZFl4G9ER3Tp7x70RJd+IroGjGCAzIUj/Cs+p3cjGMMzGCtLQFRQGtfR0PYf6OMKpC1/",
+	"gxgfltSZlrnmB02bm8tmu+06Oj3a9Bu2XOiB+fOArZnL5s8Wp7axmBjG4t30dzuW6dlNpqXjRByYs+RD",
+	"ZNAKyuY6oSjCuPxMsEwe2F7Y8gO+St9Ub7Sa+fGOZX7iB/dXuM3bod5e8bSiKzqG6MAJMWLfgOOp0pIu",
+	"Ma/dRBG86226HvL/Xe+dgNn38dXaGn32wUuJL3+6DL0Vsr3NuO1uhq+LiMMWYzrCnyaugFyGeKyS63r8",
+	"Vzema7keZ+sskMDoB/Y6++Om3WA6oX+LjggFb4iHCVojjMREmstZM5x11BVlB3NnQoMdBPb266+xUliV",
+	"mpsXglaTA2Zz5rwbOBcVqnTsqATe227Ysnlj45btOa5jc1Y8V0Pa7+/nOl5RvDNPGzAkyvW9PzE79D3N",
+	"bj/AmBzoiehm1icna8ApjGEouuJziGGYdc+nqH1IGbnkntiHw8SOJq44T28R1N0mW/XvKjLJkfclBoyI",
+	"F7nFKILM+HoMHZFn+F48VJnj+O17m2y6vddu3kOMKAY+iUwKdFVJ+TZruGFCfE7Iqfx1FvxfiESHTHdM",
+	"0SKFMGIfBpmDiv26YFRUOg0iVandl6oAZVx2nAna9OEYoihGmoosEtXJBWh11NVhDddhzk1erQmnYpeS",
+	"ggOykQMDvxGPUvg2fvHe6q1fZhTA5mwBRarbcz6Iye1V51B+4JSwvHyXXBA7c4+QBzZn6xXJiNiDATwj",
+	"/uQlkxykhhdIj6JsqArNUlVeZzN3gsAPdGjoMG0WN4Y+6t0jiOEQ08Sc53/7La3nb7IwtNd1K/4PBnCM",
+	"6ptfdVZK5DBzuq7uZCqEZQ/3aZGOv8iUzG2ic17SHUEjyL/OeChH86cmrqIj9Q/sk9LUYEbENisXrRe/",
+	"WeRWDNFF8IOh+JwwJxZPDDgkdB8QxhwYEMORxPRTDNOSpFp08HHK7iOVJ9d1jDxLDJbjKPGmhKEl4Ys9",
+	"LYVU7ZtWTOZGowpOPsMniJux2BXdlKd1wGTL32xr1eB7OBR/RxDWReBVcshxMmXLZKsSrmYC7Lq6+h2B",
+	"W09yAgaztZb73N78c9mZv8TgmjxeDCepBp/x/EkwrW6s40CJUjFuz1IoevIOt+fXqAsSQtMBSrPbxhS+",
+	"Ks0qV5XasS4j7yyz5Tbut1t3X+LJsE7+mIs1aoQBYZrezZbhHW5rtv8enkMMfYgQTGCM0WJvGt4Va8MD",
+	"aXboCxDmHsNxknVMSR9DL1dYHGQKi0osWywlB4G7ZW/e5Gcldc7Qs+lvsbDMX07OCafSKRIQfSEDtlx0",
+	"jtlPSsiQXj1OEqQcgTVLJ6zhe85sykSH4qkujGScdk4E5FROcmlKlaXIq1TvZAXi1obtrevSb/p8dp6R",
+	"RCMPMRCFaE7pNvxm0/acksD2hBxUHyJL5s7SZfXEI4gKW+tXL0/lcsV2xSxwY7QbfDtCyUCcxlqZHV8i",
+	"3TsU+zAs5BZ1MHEt8Ju1Kl1So3JSUSsR8AJRQXRgDEf4XZIQViMcOuJ6258Sx4YwKBAxEzeVMyr7TZXE",
+	"UnRSp9TVodD5+6nawVXhSb/RaLdc5pRGVF9DJHN3WWQYp0GlZVB8j5g9Uj5GdR3CAJ5DROhHhaWeMQnL",
+	"BqXJcjnAlafkX0+1OrNHvkZc2Z6a6iMRm65SK5M/p2h0BqaqNV11x4L4dLq4GtiN+663/l5r/uJmFpr6",
+	"MBQHBknjQxlUvJIy0ysFnIrwRT34eQWLKL8gmO3bYjiSbYOXLJvVMKH6J69hFWdF6DOIgSelg0IxKYZT",
+	"DSOVtktiCR8qbZPMZjN7L1z2VBShlhhktsihpVRXPykvIPzG97lykuTtO25ju0G19JWG73MWmJZ5yw50",
+	"B8H0yVvzdSE12jUMaLO06W9QjyEfqSdRfZ+sHwW6Rz/aJaZH4jOiNBNijuHYyn5yLLpU0OebSN7KJ/b6",
+	"OguM22zT3WI05rDFAlm/N69fW7q2RMrdYp7dcs1l8236yDJbNt8g7Vu0W+7i1vXFRLT02bp2AOMHAoUe",
+	"8fVAHk3pppAmQg9DaPGwcGiTaAhIadDIzN8yfivdEVUkbPleKOH2raUliboeT6Zh7FZr05Uat/hR0vaR",
+	"WIGvarUTlJw520TY2bHyB/0xEc6jtG8wTgS8J2v6a3Z7k89FYhVlsoaso+P7SUk3IkMK282mHWynsqjH",
+	"eMy3/bCmPPsYppCWJcvmG15ZIcpeZ8paaeks5O/4zva5sUep7up4pPhfc6egSNd1c0SV0r2xtHRupNeS",
+	"rEHR51Dm5IgAEEs6fv3K6RD70qAVHwqHBE0jOeQ2pAgwprz3oljCV9Uqi7/OQ9ziA9fZORvO5WoVPTWy",
+	"7igRMpxAXIi1DQz7YvFQhlUqy0dpCCyeVCAmwXdgNxknvH7/LB13Fx9AZ5DGz8sylp46bR60maVIcEZs",
+	"s/PBGeG8BoqnhdT5wfvimPeNV0DHN4XxB0wuXkjtuLi+rKYFL96jyZ/lB2UOLnf8aBpDYzm1J/mRmQlJ",
+	"4jec9+jJiusk8cFppDh5oI9oA8/kz7HaZBV70CPN80kQJ56kNSoa88DKw0nB3Fft+6lnTSecLpXR37i0",
+	"rvdCGMWP8AIlqdPbCosIN9w1vsg8p6ZViG6STsZY6enIEngyfFeu00kRK6PaPek0UcHEF6qK96gKhoLH",
+	"E5CODpOa/+CaAU/lOuqgzkm2DXKC2ZxkRo5CskZKfI/EQcGA7nhpo2wFuXJlP2+U/XwtK69iVzxK3MpE",
+	"b7ozDSjkdsDrOpae2FeMiOx1uhWWU6jKl7OPyUiILPKoXikypKaTE4JI454Uwyto/QqSfqX3b6zef0dg",
+	"Hc2h8bIRs9CazJWfT7lAdKdp0Fg3kp7V25uOk2kJXXyt/UlKHdkRfI3ov61iafZEr1c55ELkS1dVmZet",
+	"ylRAgYpN1E2YD4WmzSq5k9JPw1YAROklTdHNFdKTa5pjhA103xTp7lGipkar4mAazeZXLCmJykm3nwwl",
+	"5PI6kUx6VXXR4HzKMuqdl8tclHkV1j3tyONdpz16F2PSE5e5tDQJek7VRdHFsQG1mXxhrP5piSVqTHzR",
+	"bnB3i51Dt0lmgUdKvK8vv+gKqqSxr6YBlRjH69x+qi0JjTrMXZKnsQoqJfRTIqbIv2CIzrSNbimQrXMB",
+	"pTevs1V+fFiO69Ddk2MyRLJc6Z/H8Fz6NCyQYBJ3Z/VmqdKdJcDNjgddrgp+ZhD6qn5f11Vcoup9JepT",
+	"yukkV/vmsXfRTUxU3rc5lm5T0/BOyo2U+0Y0IhKnIkzns7K3LGOyX+XOKETwHPpS+WLJe9qo8v5oqaHn",
+	"r1WGr7Plz3WzM+XIOTjFK1i46LCQu9IKY0l25tZmZhoSolL82HCxaLV9JvhAD99PzF+CyZjuICiT7PRB",
+	"JptUB/DI/E9ojrdPI5PTeCNp++eXVosLdOPXEP8Qe0lwT0U7iull2ReGpYgiJwd/lzDhTUeT4u2IKzh5",
+	"A6KMWFaT0IzEk6z1YRBQiiM8mfVeDHnA7GYlhuAGcGyssGCLBQsrzOPGnS08i9q1UW8xiQNp+PmQRDZ0",
+	"5FBtMhJUQfAYetcM+BemUrhLROWyE+oYyeUWJvlWYVbXQtKSUWZjQVnVkE2oARwlacuLNJnJ/lcE0TEm",
+	"4+64gIS6Uf6+Wb7phLxMx+jPGZI+bsux1gSTlCv7tYHIOnP7IEeE+q81zhMPOfuULzLUsYWpftYzq9wl",
+	"Bp19TVWalO5Q7OP54YU1CXrpPmDmy4GxYGSXposkMuMdGI7N7SvEvKiI2YdTBEoZ9sgC9ij9xwMDKjuO",
+	"4BkuvPP/AQA2KU7w/1AAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,16 @@
+package audit
+
+import "context"
+
+type commandKey struct{}
+
+// WithCommand - запоминает в контексте команду, изменения которой пишутся в аудит
+func WithCommand(ctx context.Context, commandName string) context.Context {
+	return context.WithValue(ctx, commandKey{}, commandName)
+}
+
+// CommandFromContext - команда, выполняемая в контексте. Пустая строка, если команда не задана.
+func CommandFromContext(ctx context.Context) string {
+	commandName, _ := ctx.Value(commandKey{}).(string)
+	return commandName
+}
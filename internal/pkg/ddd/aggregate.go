@@ -28,7 +28,9 @@ func (ba *BaseAggregate[ID]) ClearDomainEvents() {
 }
 
 func (ba *BaseAggregate[ID]) GetDomainEvents() []DomainEvent {
-	return ba.domainEvents
+	events := make([]DomainEvent, len(ba.domainEvents))
+	copy(events, ba.domainEvents)
+	return events
 }
 
 func (ba *BaseAggregate[ID]) RaiseDomainEvent(event DomainEvent) {
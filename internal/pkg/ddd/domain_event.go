@@ -8,3 +8,10 @@ type DomainEvent interface {
 	GetID() uuid.UUID
 	GetName() string
 }
+
+// TransientDomainEvent - событие, которое не сохраняется в outbox. UnitOfWork публикует его в процессе
+// после фиксации транзакции, поэтому при сбое оно теряется. Подходит для частых событий, где важно только последнее.
+type TransientDomainEvent interface {
+	DomainEvent
+	Transient()
+}
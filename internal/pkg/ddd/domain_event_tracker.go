@@ -0,0 +1,144 @@
+package ddd
+
+import (
+	"context"
+	"sync"
+)
+
+type domainEventTrackerKey struct{}
+
+// domainEventTracker - помнит, какие события и другие изменения агрегатов уже сохранены в текущей транзакции.
+// Очищать агрегаты можно только после фиксации: при откате изменения должны остаться в агрегате.
+type domainEventTracker struct {
+	mu        sync.Mutex
+	saved     map[trackedChanges]int
+	clears    map[trackedChanges]func()
+	transient []DomainEvent
+}
+
+// trackedChanges - вид изменений агрегата, например доменные события или история статусов
+type trackedChanges struct {
+	aggregate AggregateRoot
+	kind      string
+}
+
+const domainEventsKind = "domainEvents"
+
+// WithDomainEventTracker - возвращает контекст для транзакции и функцию, которую нужно вызвать после ее фиксации.
+// Функция очищает события и изменения агрегатов, сохраненных в транзакции, и возвращает TransientDomainEvent для публикации.
+// Если транзакция откатилась, функцию не вызывают.
+func WithDomainEventTracker(ctx context.Context) (context.Context, func() []DomainEvent) {
+	tracker := &domainEventTracker{
+		saved:  make(map[trackedChanges]int),
+		clears: make(map[trackedChanges]func()),
+	}
+
+	return context.WithValue(ctx, domainEventTrackerKey{}, tracker), func() []DomainEvent {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+
+		for _, clearChanges := range tracker.clears {
+			clearChanges()
+		}
+		transient := tracker.transient
+		tracker.saved = make(map[trackedChanges]int)
+		tracker.clears = make(map[trackedChanges]func())
+		tracker.transient = nil
+
+		return transient
+	}
+}
+
+// HasDomainEventTracker - true, если контекст уже относится к транзакции, которая отслеживает события.
+func HasDomainEventTracker(ctx context.Context) bool {
+	_, ok := ctx.Value(domainEventTrackerKey{}).(*domainEventTracker)
+	return ok
+}
+
+// SaveDomainEvents - передает в save события агрегата, еще не сохраненные в текущей транзакции.
+// TransientDomainEvent в save не попадают: их возвращает функция из WithDomainEventTracker.
+// Без транзакции события очищаются сразу после сохранения, а TransientDomainEvent отбрасываются.
+func SaveDomainEvents(ctx context.Context, aggregate AggregateRoot, save func(DomainEvent) error) error {
+	tracker, ok := ctx.Value(domainEventTrackerKey{}).(*domainEventTracker)
+	if !ok {
+		for _, e := range aggregate.GetDomainEvents() {
+			if _, transient := e.(TransientDomainEvent); transient {
+				continue
+			}
+			if err := save(e); err != nil {
+				return err
+			}
+		}
+		aggregate.ClearDomainEvents()
+		return nil
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	key := trackedChanges{aggregate: aggregate, kind: domainEventsKind}
+	events := aggregate.GetDomainEvents()
+	saved := tracker.saved[key]
+	if saved > len(events) {
+		saved = 0
+	}
+
+	var transient []DomainEvent
+	for _, e := range events[saved:] {
+		if _, ok := e.(TransientDomainEvent); ok {
+			transient = append(transient, e)
+			continue
+		}
+		if err := save(e); err != nil {
+			return err
+		}
+	}
+	tracker.saved[key] = len(events)
+	tracker.clears[key] = aggregate.ClearDomainEvents
+	tracker.transient = append(tracker.transient, transient...)
+
+	return nil
+}
+
+// SaveChanges - как SaveDomainEvents, но для других накопленных изменений агрегата вида kind.
+// save получает только изменения, еще не сохраненные в текущей транзакции, clearChanges очищает их в агрегате
+// после фиксации. Без транзакции clearChanges вызывается сразу после сохранения.
+func SaveChanges[T any](
+	ctx context.Context,
+	aggregate AggregateRoot,
+	kind string,
+	changes []T,
+	save func([]T) error,
+	clearChanges func(),
+) error {
+	tracker, ok := ctx.Value(domainEventTrackerKey{}).(*domainEventTracker)
+	if !ok {
+		if len(changes) == 0 {
+			return nil
+		}
+		if err := save(changes); err != nil {
+			return err
+		}
+		clearChanges()
+		return nil
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	key := trackedChanges{aggregate: aggregate, kind: kind}
+	saved := tracker.saved[key]
+	if saved > len(changes) {
+		saved = 0
+	}
+
+	if pending := changes[saved:]; len(pending) > 0 {
+		if err := save(pending); err != nil {
+			return err
+		}
+	}
+	tracker.saved[key] = len(changes)
+	tracker.clears[key] = clearChanges
+
+	return nil
+}
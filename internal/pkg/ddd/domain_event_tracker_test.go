@@ -0,0 +1,144 @@
+package ddd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent struct {
+	id uuid.UUID
+}
+
+func (e testEvent) GetID() uuid.UUID { return e.id }
+func (e testEvent) GetName() string  { return "TestEvent" }
+
+type testTransientEvent struct {
+	testEvent
+}
+
+func (e testTransientEvent) Transient() {}
+
+func newAggregateWithEvents(count int) *BaseAggregate[uuid.UUID] {
+	aggregate := NewBaseAggregate(uuid.New())
+	for i := 0; i < count; i++ {
+		aggregate.RaiseDomainEvent(testEvent{id: uuid.New()})
+	}
+	return aggregate
+}
+
+func Test_SaveDomainEvents_Without_Transaction_Clears_Events(t *testing.T) {
+	// Arrange
+	aggregate := newAggregateWithEvents(2)
+	var saved []DomainEvent
+
+	// Act
+	err := SaveDomainEvents(context.Background(), aggregate, func(e DomainEvent) error {
+		saved = append(saved, e)
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, saved, 2)
+	assert.Empty(t, aggregate.GetDomainEvents())
+}
+
+func Test_SaveDomainEvents_Keeps_Events_Until_Commit(t *testing.T) {
+	// Arrange
+	aggregate := newAggregateWithEvents(2)
+	ctx, commit := WithDomainEventTracker(context.Background())
+
+	// Act
+	err := SaveDomainEvents(ctx, aggregate, func(DomainEvent) error { return nil })
+	eventsBeforeCommit := aggregate.GetDomainEvents()
+	commit()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, eventsBeforeCommit, 2)
+	assert.Empty(t, aggregate.GetDomainEvents())
+}
+
+func Test_SaveDomainEvents_Saves_Each_Event_Once_Per_Transaction(t *testing.T) {
+	// Arrange
+	aggregate := newAggregateWithEvents(1)
+	ctx, _ := WithDomainEventTracker(context.Background())
+	var saved []DomainEvent
+	save := func(e DomainEvent) error {
+		saved = append(saved, e)
+		return nil
+	}
+	_ = SaveDomainEvents(ctx, aggregate, save)
+	aggregate.RaiseDomainEvent(testEvent{id: uuid.New()})
+
+	// Act
+	err := SaveDomainEvents(ctx, aggregate, save)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, saved, 2)
+	assert.Equal(t, aggregate.GetDomainEvents(), saved)
+}
+
+func Test_SaveDomainEvents_Returns_Transient_Events_After_Commit(t *testing.T) {
+	// Arrange
+	aggregate := newAggregateWithEvents(1)
+	moved := testTransientEvent{testEvent{id: uuid.New()}}
+	aggregate.RaiseDomainEvent(moved)
+	ctx, commit := WithDomainEventTracker(context.Background())
+	var saved []DomainEvent
+
+	// Act
+	err := SaveDomainEvents(ctx, aggregate, func(e DomainEvent) error {
+		saved = append(saved, e)
+		return nil
+	})
+	transient := commit()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, saved, 1)
+	assert.Equal(t, []DomainEvent{moved}, transient)
+	assert.Empty(t, aggregate.GetDomainEvents())
+}
+
+func Test_SaveChanges_Clears_Changes_Only_After_Commit(t *testing.T) {
+	// Arrange
+	aggregate := newAggregateWithEvents(0)
+	changes := []string{"created"}
+	clearChanges := func() { changes = nil }
+	ctx, commit := WithDomainEventTracker(context.Background())
+	var saved []string
+	save := func(pending []string) error {
+		saved = append(saved, pending...)
+		return nil
+	}
+	_ = SaveChanges(ctx, aggregate, "statusHistory", changes, save, clearChanges)
+	changes = append(changes, "assigned")
+
+	// Act
+	err := SaveChanges(ctx, aggregate, "statusHistory", changes, save, clearChanges)
+	changesBeforeCommit := len(changes)
+	commit()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"created", "assigned"}, saved)
+	assert.Equal(t, 2, changesBeforeCommit)
+	assert.Empty(t, changes)
+}
+
+func Test_Aggregate_Returns_Copy_Of_Domain_Events(t *testing.T) {
+	// Arrange
+	aggregate := newAggregateWithEvents(1)
+	events := aggregate.GetDomainEvents()
+
+	// Act
+	events[0] = testEvent{id: uuid.New()}
+
+	// Assert
+	assert.NotEqual(t, events[0], aggregate.GetDomainEvents()[0])
+}
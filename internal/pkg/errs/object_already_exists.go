@@ -0,0 +1,28 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrObjectAlreadyExists = errors.New("object already exists")
+
+type ObjectAlreadyExistsError struct {
+	ParamName string
+	ID        any
+}
+
+func NewObjectAlreadyExistsError(paramName string, ID any) *ObjectAlreadyExistsError {
+	return &ObjectAlreadyExistsError{
+		ParamName: paramName,
+		ID:        ID,
+	}
+}
+
+func (e *ObjectAlreadyExistsError) Error() string {
+	return fmt.Sprintf("%s: %s %v", ErrObjectAlreadyExists, e.ParamName, e.ID)
+}
+
+func (e *ObjectAlreadyExistsError) Unwrap() error {
+	return ErrObjectAlreadyExists
+}
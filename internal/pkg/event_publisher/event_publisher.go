@@ -2,36 +2,173 @@ package ddd
 
 import (
 	"context"
-	modelEvent "delivery/internal/core/domain/model/event"
-	"delivery/internal/pkg/ddd"
+	"errors"
+	"log"
+	"reflect"
+	"sync"
 
-	"github.com/mehdihadeli/go-mediatr"
+	"delivery/internal/pkg/ddd"
 )
 
 type EventPublisher interface {
 	Publish(ctx context.Context, domainEvent ddd.DomainEvent) error
 }
 
-type eventPublisher struct {
+type EventHandler[TEvent ddd.DomainEvent] interface {
+	Handle(ctx context.Context, event TEvent) error
 }
 
-func NewEventPublisher() EventPublisher {
-	return &eventPublisher{}
+type registeredHandler struct {
+	handle      func(ctx context.Context, domainEvent ddd.DomainEvent) error
+	afterCommit bool
 }
 
-func (e *eventPublisher) Publish(ctx context.Context, domainEvent ddd.DomainEvent) error {
-	switch domainEvent := domainEvent.(type) {
-	case *modelEvent.OrderCreated:
-		err := mediatr.Publish(ctx, domainEvent)
-		if err != nil {
-			return err
+var _ EventPublisher = (*EventDispatcher)(nil)
+
+// EventDispatcher - отправляет доменное событие всем обработчикам, подписанным на его тип.
+// Обработчики регистрируются при старте приложения через Subscribe и SubscribeAfterCommit.
+type EventDispatcher struct {
+	mu       sync.RWMutex
+	handlers map[reflect.Type][]registeredHandler
+
+	// unhandled - типы событий без обработчиков, о которых уже предупредили в логе
+	unhandled sync.Map
+}
+
+func NewEventDispatcher() *EventDispatcher {
+	return &EventDispatcher{
+		handlers: make(map[reflect.Type][]registeredHandler),
+	}
+}
+
+// Subscribe - обработчик вызывается внутри транзакции, в которой публикуется событие.
+// Ошибка обработчика откатывает публикацию, и событие будет отправлено повторно.
+func Subscribe[TEvent ddd.DomainEvent](d *EventDispatcher, handler EventHandler[TEvent]) error {
+	return subscribe(d, handler, false)
+}
+
+// SubscribeAfterCommit - обработчик вызывается только после фиксации транзакции, в которой публикуется событие.
+// Событие к этому моменту уже считается отправленным, поэтому ошибка обработчика только логируется.
+func SubscribeAfterCommit[TEvent ddd.DomainEvent](d *EventDispatcher, handler EventHandler[TEvent]) error {
+	return subscribe(d, handler, true)
+}
+
+func subscribe[TEvent ddd.DomainEvent](d *EventDispatcher, handler EventHandler[TEvent], afterCommit bool) error {
+	if d == nil {
+		return errors.New("event dispatcher is nil")
+	}
+	if isNil(handler) {
+		return errors.New("event handler is nil")
+	}
+
+	eventType := reflect.TypeFor[TEvent]()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.handlers[eventType] = append(d.handlers[eventType], registeredHandler{
+		handle: func(ctx context.Context, domainEvent ddd.DomainEvent) error {
+			return handler.Handle(ctx, domainEvent.(TEvent))
+		},
+		afterCommit: afterCommit,
+	})
+
+	return nil
+}
+
+// Publish - вызывает обработчики в порядке регистрации и останавливается на первой ошибке.
+// Обработчики после фиксации откладываются, только если все обработчики в транзакции отработали успешно.
+func (d *EventDispatcher) Publish(ctx context.Context, domainEvent ddd.DomainEvent) error {
+	if domainEvent == nil {
+		return errors.New("domain event is nil")
+	}
+
+	eventType := reflect.TypeOf(domainEvent)
+
+	d.mu.RLock()
+	handlers := d.handlers[eventType]
+	d.mu.RUnlock()
+
+	if len(handlers) == 0 {
+		if _, warned := d.unhandled.LoadOrStore(eventType, struct{}{}); !warned {
+			log.Printf("EventDispatcher: no handlers registered for %s, events of this type are skipped", eventType)
+		}
+		return nil
+	}
+
+	afterCommitHandlers := make([]registeredHandler, 0, len(handlers))
+	for _, handler := range handlers {
+		if handler.afterCommit {
+			afterCommitHandlers = append(afterCommitHandlers, handler)
+			continue
 		}
-	case *modelEvent.OrderCompleted:
-		err := mediatr.Publish(ctx, domainEvent)
-		if err != nil {
+
+		if err := handler.handle(ctx, domainEvent); err != nil {
 			return err
 		}
 	}
 
+	queue, ok := ctx.Value(afterCommitKey{}).(*afterCommitQueue)
+	for _, handler := range afterCommitHandlers {
+		if !ok {
+			// Транзакции нет - фиксировать нечего, обработчик вызывается сразу
+			runAfterCommit(ctx, handler, domainEvent)
+			continue
+		}
+
+		queue.add(handler, domainEvent)
+	}
+
 	return nil
 }
+
+func isNil(handler any) bool {
+	if handler == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(handler)
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
+
+type afterCommitKey struct{}
+
+type afterCommitCall struct {
+	handler     registeredHandler
+	domainEvent ddd.DomainEvent
+}
+
+type afterCommitQueue struct {
+	mu    sync.Mutex
+	calls []afterCommitCall
+}
+
+func (q *afterCommitQueue) add(handler registeredHandler, domainEvent ddd.DomainEvent) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.calls = append(q.calls, afterCommitCall{handler: handler, domainEvent: domainEvent})
+}
+
+// WithAfterCommit - возвращает контекст для транзакции и функцию, которую нужно вызвать после ее фиксации.
+// Если транзакция не зафиксирована, функцию не вызывают, и отложенные обработчики не выполняются.
+func WithAfterCommit(ctx context.Context) (context.Context, func(ctx context.Context)) {
+	queue := &afterCommitQueue{}
+
+	return context.WithValue(ctx, afterCommitKey{}, queue), func(ctx context.Context) {
+		queue.mu.Lock()
+		calls := queue.calls
+		queue.calls = nil
+		queue.mu.Unlock()
+
+		for _, call := range calls {
+			runAfterCommit(ctx, call.handler, call.domainEvent)
+		}
+	}
+}
+
+func runAfterCommit(ctx context.Context, handler registeredHandler, domainEvent ddd.DomainEvent) {
+	if err := handler.handle(ctx, domainEvent); err != nil {
+		log.Printf("EventDispatcher: after commit handler failed for %s (%s): %v", domainEvent.GetName(), domainEvent.GetID(), err)
+	}
+}
@@ -0,0 +1,115 @@
+package ddd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/domain/model/event"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingHandler[TEvent any] struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h *recordingHandler[TEvent]) Handle(_ context.Context, _ TEvent) error {
+	*h.calls = append(*h.calls, h.name)
+	return h.err
+}
+
+func TestEventDispatcher_Publish_CallsAllHandlersInRegistrationOrder(t *testing.T) {
+	// Arrange
+	var calls []string
+	dispatcher := NewEventDispatcher()
+	_ = Subscribe[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "first", calls: &calls})
+	_ = Subscribe[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "second", calls: &calls})
+
+	// Act
+	err := dispatcher.Publish(context.Background(), event.NewOrderCreated(uuid.New()))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestEventDispatcher_Publish_SkipsEventWithoutHandlers(t *testing.T) {
+	// Arrange
+	var calls []string
+	dispatcher := NewEventDispatcher()
+	_ = Subscribe[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "created", calls: &calls})
+
+	// Act
+	err := dispatcher.Publish(context.Background(), event.NewOrderCancelled(uuid.New()))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, calls)
+}
+
+func TestEventDispatcher_Publish_StopsOnHandlerError(t *testing.T) {
+	// Arrange
+	var calls []string
+	dispatcher := NewEventDispatcher()
+	_ = Subscribe[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "failing", calls: &calls, err: errors.New("kafka is unavailable")})
+	_ = Subscribe[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "next", calls: &calls})
+	_ = SubscribeAfterCommit[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "after commit", calls: &calls})
+	ctx, runAfterCommit := WithAfterCommit(context.Background())
+
+	// Act
+	err := dispatcher.Publish(ctx, event.NewOrderCreated(uuid.New()))
+	runAfterCommit(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, []string{"failing"}, calls)
+}
+
+func TestEventDispatcher_Publish_DefersAfterCommitHandlers(t *testing.T) {
+	// Arrange
+	var calls []string
+	dispatcher := NewEventDispatcher()
+	_ = SubscribeAfterCommit[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "after commit", calls: &calls})
+	_ = Subscribe[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "in transaction", calls: &calls})
+	ctx, runAfterCommit := WithAfterCommit(context.Background())
+
+	// Act
+	err := dispatcher.Publish(ctx, event.NewOrderCreated(uuid.New()))
+	callsBeforeCommit := append([]string(nil), calls...)
+	runAfterCommit(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"in transaction"}, callsBeforeCommit)
+	assert.Equal(t, []string{"in transaction", "after commit"}, calls)
+}
+
+func TestEventDispatcher_Publish_RunsAfterCommitHandlersImmediatelyWithoutTransaction(t *testing.T) {
+	// Arrange
+	var calls []string
+	dispatcher := NewEventDispatcher()
+	_ = SubscribeAfterCommit[*event.OrderCreated](dispatcher, &recordingHandler[*event.OrderCreated]{name: "after commit", calls: &calls, err: errors.New("hub is closed")})
+
+	// Act
+	err := dispatcher.Publish(context.Background(), event.NewOrderCreated(uuid.New()))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"after commit"}, calls)
+}
+
+func TestSubscribe_RejectsNilHandler(t *testing.T) {
+	// Arrange
+	dispatcher := NewEventDispatcher()
+	var handler *recordingHandler[*event.OrderCreated]
+
+	// Act
+	err := Subscribe[*event.OrderCreated](dispatcher, handler)
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package inbox
+
+import (
+	"time"
+)
+
+// Message - запись о входящем интеграционном событии, которое уже было обработано.
+type Message struct {
+	EventID        string
+	EventType      string
+	ProcessedAtUtc time.Time
+}
+
+func NewMessage(eventID string, eventType string) *Message {
+	return &Message{
+		EventID:        eventID,
+		EventType:      eventType,
+		ProcessedAtUtc: time.Now().UTC(),
+	}
+}
+
+func (Message) TableName() string {
+	return "inbox"
+}
@@ -31,8 +31,11 @@ func (r *eventRegistry) RegisterDomainEvent(eventType reflect.Type) error {
 	if eventType == nil {
 		return errs.NewValueIsRequiredError("eventType")
 	}
-	eventName := eventType.Name()
-	r.EventRegistry[eventName] = eventType
+	if eventType.Kind() == reflect.Ptr {
+		eventType = eventType.Elem()
+	}
+
+	r.EventRegistry[eventType.Name()] = eventType
 	return nil
 }
 
@@ -42,9 +45,10 @@ func EncodeDomainEvent(domainEvent ddd.DomainEvent) (Message, error) {
 		return Message{}, fmt.Errorf("failed to marshal event: %w", err)
 	}
 
+	// В Name кладем имя типа события, по нему EventRegistry восстанавливает событие
 	return Message{
 		ID:             domainEvent.GetID(),
-		Name:           domainEvent.GetName(),
+		Name:           domainEventTypeName(domainEvent),
 		Payload:        payload,
 		OccurredAtUtc:  time.Now().UTC(),
 		ProcessedAtUtc: nil,
@@ -72,3 +76,12 @@ func (r *eventRegistry) DecodeDomainEvent(outboxMessage *Message) (ddd.DomainEve
 
 	return domainEvent, nil
 }
+
+func domainEventTypeName(domainEvent ddd.DomainEvent) string {
+	eventType := reflect.TypeOf(domainEvent)
+	if eventType.Kind() == reflect.Ptr {
+		eventType = eventType.Elem()
+	}
+
+	return eventType.Name()
+}
@@ -0,0 +1,53 @@
+package outbox
+
+import (
+	"reflect"
+	"testing"
+
+	"delivery/internal/core/domain/model/event"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EncodedDomainEventCanBeDecoded(t *testing.T) {
+	// Arrange
+	registry, _ := NewEventRegistry()
+	_ = registry.RegisterDomainEvent(reflect.TypeOf(&event.OrderCreated{}))
+	orderCreated := event.NewOrderCreated(uuid.New())
+
+	// Act
+	message, err := EncodeDomainEvent(orderCreated)
+	assert.NoError(t, err)
+	decodedEvent, err := registry.DecodeDomainEvent(&message)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, orderCreated, decodedEvent)
+	assert.Equal(t, orderCreated.GetID(), message.ID)
+	assert.Nil(t, message.ProcessedAtUtc)
+}
+
+func Test_ImpossibleToDecodeNotRegisteredDomainEvent(t *testing.T) {
+	// Arrange
+	registry, _ := NewEventRegistry()
+	message, _ := EncodeDomainEvent(event.NewOrderCompleted(uuid.New(), uuid.New()))
+
+	// Act
+	decodedEvent, err := registry.DecodeDomainEvent(&message)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, decodedEvent)
+}
+
+func Test_ImpossibleToRegisterNilEventType(t *testing.T) {
+	// Arrange
+	registry, _ := NewEventRegistry()
+
+	// Act
+	err := registry.RegisterDomainEvent(nil)
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package outbox
 
 import (
+	"delivery/internal/pkg/retry"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,8 +13,52 @@ type Message struct {
 	Payload        []byte
 	OccurredAtUtc  time.Time
 	ProcessedAtUtc *time.Time
+
+	// Attempts - сколько раз сообщение не удалось отправить, LastError - последняя ошибка
+	Attempts         int
+	LastError        string
+	NextAttemptAtUtc *time.Time
+	// FailedAtUtc - сообщение отложено и больше не отправляется, пока его не вернут вручную
+	FailedAtUtc *time.Time
 }
 
 func (Message) TableName() string {
 	return "outbox"
 }
+
+func (m *Message) MarkAsProcessed() {
+	processedAt := time.Now().UTC()
+	m.ProcessedAtUtc = &processedAt
+}
+
+// MarkAsFailed - запоминает ошибку отправки и назначает следующую попытку.
+// После policy.MaxAttempts неудач сообщение откладывается.
+func (m *Message) MarkAsFailed(err error, policy retry.Policy, now time.Time) {
+	m.Attempts++
+	m.LastError = err.Error()
+
+	if m.Attempts >= policy.MaxAttempts {
+		m.Park(err, now)
+		return
+	}
+
+	nextAttemptAt := now.Add(policy.Backoff(m.Attempts)).UTC()
+	m.NextAttemptAtUtc = &nextAttemptAt
+}
+
+// Park - откладывает сообщение, которое не получится отправить повторной попыткой
+func (m *Message) Park(err error, now time.Time) {
+	failedAt := now.UTC()
+	m.LastError = err.Error()
+	m.FailedAtUtc = &failedAt
+	m.NextAttemptAtUtc = nil
+}
+
+func (m *Message) IsParked() bool {
+	return m.FailedAtUtc != nil
+}
+
+// ReadyToSend - пауза после предыдущей неудачной попытки истекла
+func (m *Message) ReadyToSend(now time.Time) bool {
+	return m.NextAttemptAtUtc == nil || !now.Before(*m.NextAttemptAtUtc)
+}
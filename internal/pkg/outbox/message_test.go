@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"delivery/internal/pkg/retry"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Message_MarkAsFailed_SchedulesRetryThenParks(t *testing.T) {
+	// Arrange
+	policy := retry.Policy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: time.Minute}
+	message := &Message{}
+	now := time.Now().UTC()
+
+	// Act
+	message.MarkAsFailed(errors.New("kafka is unavailable"), policy, now)
+
+	// Assert
+	assert.False(t, message.IsParked())
+	assert.False(t, message.ReadyToSend(now))
+	assert.True(t, message.ReadyToSend(now.Add(time.Second)))
+
+	// Act
+	message.MarkAsFailed(errors.New("kafka is unavailable"), policy, now.Add(time.Second))
+
+	// Assert
+	assert.True(t, message.IsParked())
+	assert.Equal(t, 2, message.Attempts)
+	assert.Equal(t, "kafka is unavailable", message.LastError)
+}
@@ -0,0 +1,17 @@
+package outbox
+
+import (
+	"time"
+
+	"delivery/internal/pkg/retry"
+)
+
+// DefaultRetryPolicy - сообщение откладывается примерно через 15 минут безуспешных попыток
+func DefaultRetryPolicy() retry.Policy {
+	return retry.Policy{
+		MaxAttempts:    10,
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Minute,
+		Multiplier:     2,
+	}
+}
@@ -0,0 +1,47 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_Backoff_GrowsExponentiallyUpToMax(t *testing.T) {
+	// Arrange
+	policy := Policy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	// Act & Assert
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 400*time.Millisecond, policy.Backoff(3))
+	assert.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	assert.Equal(t, time.Second, policy.Backoff(5))
+	assert.Equal(t, time.Second, policy.Backoff(50))
+}
+
+func TestPolicy_Backoff_DoublesWithoutMultiplier(t *testing.T) {
+	// Arrange
+	policy := Policy{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	// Act & Assert
+	assert.Equal(t, time.Second, policy.Backoff(1))
+	assert.Equal(t, 2*time.Second, policy.Backoff(2))
+	assert.Equal(t, 4*time.Second, policy.Backoff(3))
+	assert.Equal(t, 5*time.Second, policy.Backoff(4))
+	assert.Equal(t, 5*time.Second, policy.Backoff(50))
+}
+
+func TestPolicy_Backoff_ZeroWithoutInitialBackoff(t *testing.T) {
+	// Arrange
+	policy := Policy{MaxAttempts: 3}
+
+	// Act & Assert
+	assert.Equal(t, time.Duration(0), policy.Backoff(1))
+	assert.Equal(t, 1, Policy{}.Attempts())
+}